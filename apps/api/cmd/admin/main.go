@@ -0,0 +1,81 @@
+// Command admin grants roles out-of-band, which is the only way to create an
+// administrator; AdminService itself cannot promote anyone to ADMIN:
+//
+//	go run ./cmd/admin -email ops@chefnext.local -role ADMIN
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/config"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	adminUseCase "github.com/chefnext/chefnext/apps/api/internal/usecase/admin"
+	auditLogUseCase "github.com/chefnext/chefnext/apps/api/internal/usecase/auditlog"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "admin: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	email := flag.String("email", "", "email of the user to update")
+	role := flag.String("role", adminUseCase.RoleAdmin, "role to assign: CHEF, RESTAURANT or ADMIN")
+	flag.Parse()
+
+	if strings.TrimSpace(*email) == "" {
+		flag.Usage()
+		return fmt.Errorf("-email is required")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("load config: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	pool, err := pgxpool.New(ctx, cfg.DatabaseURL)
+	if err != nil {
+		return fmt.Errorf("connect to database: %w", err)
+	}
+	defer pool.Close()
+
+	redisClient := redis.NewClient(&redis.Options{
+		Addr: cfg.RedisAddr,
+	})
+	defer redisClient.Close()
+
+	// Existing sessions carry the old role, so they are revoked on change.
+	// The change is audited without an actor, which marks it as out-of-band
+	queries := db.New(pool)
+	service := adminUseCase.NewService(
+		pool,
+		queries,
+		auth.NewTokenStore(redisClient, 30*24*time.Hour),
+		auth.NewRevocationList(redisClient, 15*time.Minute),
+		nil,
+		auditLogUseCase.NewService(queries, slog.Default()),
+	)
+
+	user, err := service.AssignRole(ctx, strings.ToLower(strings.TrimSpace(*email)), strings.ToUpper(strings.TrimSpace(*role)))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s is now %s\n", user.Email, user.Role)
+	return nil
+}
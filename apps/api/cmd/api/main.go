@@ -10,32 +10,56 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"connectrpc.com/connect"
+	"connectrpc.com/otelconnect"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/propagation"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 
+	"github.com/chefnext/chefnext/apps/api/internal/gen/admin/v1/adminv1connect"
+	"github.com/chefnext/chefnext/apps/api/internal/gen/audit/v1/auditv1connect"
 	"github.com/chefnext/chefnext/apps/api/internal/gen/chef/v1/chefv1connect"
 	"github.com/chefnext/chefnext/apps/api/internal/gen/identity/v1/identityv1connect"
 	jobv1connect "github.com/chefnext/chefnext/apps/api/internal/gen/job/v1/jobv1connect"
+	"github.com/chefnext/chefnext/apps/api/internal/gen/kyc/v1/kycv1connect"
 	"github.com/chefnext/chefnext/apps/api/internal/gen/restaurant/v1/restaurantv1connect"
+	adminHandler "github.com/chefnext/chefnext/apps/api/internal/handler/admin"
+	auditHandler "github.com/chefnext/chefnext/apps/api/internal/handler/audit"
 	chefHandler "github.com/chefnext/chefnext/apps/api/internal/handler/chef"
 	"github.com/chefnext/chefnext/apps/api/internal/handler/identity"
 	jobHandler "github.com/chefnext/chefnext/apps/api/internal/handler/job"
+	kycHandler "github.com/chefnext/chefnext/apps/api/internal/handler/kyc"
 	restaurantHandler "github.com/chefnext/chefnext/apps/api/internal/handler/restaurant"
 	"github.com/chefnext/chefnext/apps/api/internal/middleware"
 	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
 	"github.com/chefnext/chefnext/apps/api/internal/pkg/config"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/idempotency"
 	"github.com/chefnext/chefnext/apps/api/internal/pkg/logger"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/mail"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/metrics"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/oidc"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/ratelimit"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/security"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/storage"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/telemetry"
 	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	adminUseCase "github.com/chefnext/chefnext/apps/api/internal/usecase/admin"
+	apiKeyUseCase "github.com/chefnext/chefnext/apps/api/internal/usecase/apikey"
+	auditLogUseCase "github.com/chefnext/chefnext/apps/api/internal/usecase/auditlog"
 	chefProfileUseCase "github.com/chefnext/chefnext/apps/api/internal/usecase/chefprofile"
 	identityUseCase "github.com/chefnext/chefnext/apps/api/internal/usecase/identity"
 	jobUseCase "github.com/chefnext/chefnext/apps/api/internal/usecase/job"
+	kycUseCase "github.com/chefnext/chefnext/apps/api/internal/usecase/kyc"
 	restaurantProfileUseCase "github.com/chefnext/chefnext/apps/api/internal/usecase/restaurantprofile"
+	restaurantTeamUseCase "github.com/chefnext/chefnext/apps/api/internal/usecase/restaurantteam"
 )
 
 func main() {
@@ -56,13 +80,37 @@ func run() error {
 
 	log := logger.New(cfg.LogLevel)
 
+	// Initialize tracing
+	shutdownTracing, err := telemetry.SetupTracing(ctx, cfg.TracesExporter, "chefnext-api")
+	if err != nil {
+		return fmt.Errorf("set up tracing: %w", err)
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			log.Error("flush traces failed", "error", err)
+		}
+	}()
+
 	// Initialize database
-	pool, err := pgxpool.New(ctx, cfg.DatabaseURL)
+	poolConfig, err := pgxpool.ParseConfig(cfg.DatabaseURL)
+	if err != nil {
+		return fmt.Errorf("parse database url: %w", err)
+	}
+	poolConfig.ConnConfig.Tracer = telemetry.NewPgxTracer()
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return fmt.Errorf("connect to database: %w", err)
 	}
 	defer pool.Close()
 
+	// Initialize metrics
+	metricsRegistry := metrics.NewRegistry()
+	apiMetrics := metrics.New(metricsRegistry)
+	metricsRegistry.MustRegister(metrics.NewPgxPoolCollector(pool))
+
 	queries := db.New(pool)
 
 	// Initialize Redis
@@ -71,76 +119,349 @@ func run() error {
 	})
 	defer redisClient.Close()
 
+	// Commands are traced without their arguments, which include tokens
+	if err := redisotel.InstrumentTracing(redisClient, redisotel.WithDBStatement(false)); err != nil {
+		return fmt.Errorf("instrument redis: %w", err)
+	}
+
 	if err := redisClient.Ping(ctx).Err(); err != nil {
 		return fmt.Errorf("connect to redis: %w", err)
 	}
+	metricsRegistry.MustRegister(metrics.NewRedisPoolCollector(redisClient))
+
+	// Initialize signing keys; retired keys are kept as long as the refresh
+	// tokens they signed can still be presented
+	signingKeyBox, err := auth.NewSecretBox(cfg.EncryptionKey, auth.SigningKeysPurpose, cfg.JWTSecret)
+	if err != nil {
+		return fmt.Errorf("create secret box: %w", err)
+	}
+	keyStore := auth.NewKeyStore(redisClient, signingKeyBox)
+	keyManager := auth.NewKeyManager(keyStore, cfg.JWTKeyRotation, cfg.JWTKeyPrepublish, 30*24*time.Hour, log)
+	if err := keyManager.Init(ctx); err != nil {
+		return fmt.Errorf("load signing keys: %w", err)
+	}
+	go keyManager.Run(ctx)
 
 	// Initialize JWT manager and token store
-	jwtManager := auth.NewJWTManager(cfg.JWTSecret, 15*time.Minute, 30*24*time.Hour)
+	jwtManager := auth.NewJWTManager(keyManager, 15*time.Minute, 30*24*time.Hour)
 	tokenStore := auth.NewTokenStore(redisClient, 30*24*time.Hour)
+	revocations := auth.NewRevocationList(redisClient, 15*time.Minute)
+	loginAttempts := auth.NewLoginAttemptLimiter(redisClient,
+		auth.LoginAttemptPolicy{Threshold: 5, Window: time.Hour, BaseLockout: time.Minute, MaxLockout: time.Hour},
+		auth.LoginAttemptPolicy{Threshold: 50, Window: time.Hour, BaseLockout: time.Minute, MaxLockout: time.Hour},
+	)
+	oneTimeTokenKey, err := auth.DeriveKey(cfg.EncryptionKey, auth.OneTimeTokensPurpose)
+	if err != nil {
+		return fmt.Errorf("derive one-time token key: %w", err)
+	}
+	oneTimeTokenStore := auth.NewOneTimeTokenStore(redisClient, oneTimeTokenKey)
+	totpSecretBox, err := auth.NewSecretBox(cfg.EncryptionKey, auth.TOTPSecretsPurpose, cfg.JWTSecret)
+	if err != nil {
+		return fmt.Errorf("create secret box: %w", err)
+	}
+	kycDocumentBox, err := auth.NewSecretBox(cfg.EncryptionKey, auth.KYCDocumentsPurpose, cfg.JWTSecret)
+	if err != nil {
+		return fmt.Errorf("create secret box: %w", err)
+	}
+	magicLinkThrottle := auth.NewRequestThrottle(redisClient, "magic_link", 5, time.Hour)
+	mfaPolicy := auth.NewMFAPolicy(cfg.MFARequiredRoles...)
+
+	// Initialize password policy
+	breachedPasswords, err := loadBreachedPasswords(cfg.BreachedPasswordsFile)
+	if err != nil {
+		return fmt.Errorf("load breached passwords: %w", err)
+	}
+	passwordPolicy := auth.NewPasswordPolicy(cfg.PasswordMinLength, cfg.PasswordBannedPatterns, breachedPasswords)
+	argon2Params := auth.DefaultArgon2Params()
+	argon2Params.Memory = uint32(cfg.Argon2MemoryKiB)
+	argon2Params.Iterations = uint32(cfg.Argon2Iterations)
+	argon2Params.Parallelism = uint8(cfg.Argon2Parallelism)
+
+	// Initialize OpenID Connect providers
+	oidcHTTPClient := &http.Client{Timeout: 10 * time.Second}
+	oidcProviders := make([]*oidc.Provider, 0, len(cfg.OIDCProviders))
+	for _, provider := range cfg.OIDCProviders {
+		oidcProviders = append(oidcProviders, oidc.NewProvider(oidc.ProviderConfig{
+			Name:         provider.Name,
+			IssuerURL:    provider.IssuerURL,
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			RedirectURL:  provider.RedirectURL,
+		}, oidcHTTPClient))
+	}
+	oidcRegistry := oidc.NewRegistry(oidcProviders...)
+	oidcStates := oidc.NewStateStore(redisClient, 10*time.Minute)
+
+	// Initialize security event recorder
+	securityEvents := security.NewLogRecorder(log)
+
+	// Initialize mail sender
+	mailer := mail.NewSMTPSender(cfg.MailpitSMTPAddr, cfg.MailFrom)
+
+	// Initialize blob storage; a missing bucket only affects uploads, so the
+	// API still starts when object storage is down
+	blobStore, err := storage.NewS3Store(storage.S3Config{
+		Endpoint:  cfg.MinIOEndpoint,
+		Region:    cfg.MinIORegion,
+		Bucket:    cfg.MinIOBucket,
+		AccessKey: cfg.MinIOAccessKey,
+		SecretKey: cfg.MinIOSecretKey,
+	}, &http.Client{Timeout: 30 * time.Second})
+	if err != nil {
+		return fmt.Errorf("create blob store: %w", err)
+	}
+	if err := blobStore.EnsureBucket(ctx); err != nil {
+		log.Warn("object storage bucket unavailable", "bucket", cfg.MinIOBucket, "error", err)
+	}
+
+	// Initialize audit log; changes made through use cases are recorded there
+	auditLogUC := auditLogUseCase.NewService(queries, log)
 
 	// Initialize use cases
-	registerUC := identityUseCase.NewRegisterUseCase(queries, jwtManager, tokenStore)
-	loginUC := identityUseCase.NewLoginUseCase(queries, jwtManager, tokenStore)
-	refreshTokenUC := identityUseCase.NewRefreshTokenUseCase(queries, jwtManager, tokenStore)
-	logoutUC := identityUseCase.NewLogoutUseCase(jwtManager, tokenStore)
-	chefProfileUC := chefProfileUseCase.NewService(queries)
-	restaurantProfileUC := restaurantProfileUseCase.NewService(queries)
-	jobUC := jobUseCase.NewService(queries)
+	sendVerificationEmailUC := identityUseCase.NewSendVerificationEmailUseCase(queries, oneTimeTokenStore, mailer, cfg.AppBaseURL)
+	verifyEmailUC := identityUseCase.NewVerifyEmailUseCase(pool, queries, oneTimeTokenStore, auditLogUC)
+	getMeUC := identityUseCase.NewGetMeUseCase(queries)
+	requestPasswordResetUC := identityUseCase.NewRequestPasswordResetUseCase(queries, oneTimeTokenStore, mailer, cfg.AppBaseURL)
+	resetPasswordUC := identityUseCase.NewResetPasswordUseCase(pool, queries, oneTimeTokenStore, tokenStore, revocations, passwordPolicy, argon2Params, auditLogUC)
+	changePasswordUC := identityUseCase.NewChangePasswordUseCase(pool, queries, jwtManager, tokenStore, revocations, passwordPolicy, argon2Params, auditLogUC)
+	sessionsUC := identityUseCase.NewSessionsUseCase(tokenStore, revocations)
+	registerUC := identityUseCase.NewRegisterUseCase(pool, queries, jwtManager, tokenStore, passwordPolicy, argon2Params, sendVerificationEmailUC, auditLogUC, log)
+	loginUC := identityUseCase.NewLoginUseCase(queries, jwtManager, tokenStore, oneTimeTokenStore, loginAttempts, mfaPolicy, securityEvents, argon2Params)
+	refreshTokenUC := identityUseCase.NewRefreshTokenUseCase(queries, jwtManager, tokenStore, revocations, securityEvents)
+	logoutUC := identityUseCase.NewLogoutUseCase(jwtManager, tokenStore, revocations)
+	verifyMFAUC := identityUseCase.NewVerifyMFAUseCase(queries, jwtManager, tokenStore, oneTimeTokenStore, loginAttempts, securityEvents, totpSecretBox)
+	mfaEnrollmentUC := identityUseCase.NewMFAEnrollmentUseCase(pool, queries, jwtManager, tokenStore, revocations, totpSecretBox, auditLogUC)
+	oidcLoginUC := identityUseCase.NewOIDCLoginUseCase(pool, queries, jwtManager, tokenStore, oneTimeTokenStore, mfaPolicy, oidcRegistry, oidcStates, argon2Params, auditLogUC)
+	rolesUC := identityUseCase.NewRolesUseCase(pool, queries, jwtManager, refreshTokenUC, auditLogUC)
+	magicLinkUC := identityUseCase.NewMagicLinkUseCase(queries, jwtManager, tokenStore, oneTimeTokenStore, magicLinkThrottle, mfaPolicy, securityEvents, mailer, cfg.AppBaseURL)
+	accountDeletionUC := identityUseCase.NewAccountDeletionUseCase(pool, queries, tokenStore, revocations, oneTimeTokenStore, totpSecretBox, blobStore, mailer, cfg.AppBaseURL, cfg.AccountDeletionGracePeriod, auditLogUC, log)
+	exportDataUC := identityUseCase.NewExportDataUseCase(queries)
+	go accountDeletionUC.Run(ctx, time.Hour)
+	chefProfileUC := chefProfileUseCase.NewService(pool, queries, auditLogUC)
+	restaurantProfileUC := restaurantProfileUseCase.NewService(pool, queries, auditLogUC)
+	restaurantTeamUC := restaurantTeamUseCase.NewService(pool, queries, mailer, cfg.AppBaseURL)
+	apiKeyUC := apiKeyUseCase.NewService(queries)
+	jobUC := jobUseCase.NewService(pool, queries, apiMetrics, auditLogUC)
+	kycUC := kycUseCase.NewService(pool, queries, blobStore, kycDocumentBox, mailer, cfg.AppBaseURL, auditLogUC)
+	adminUC := adminUseCase.NewService(pool, queries, tokenStore, revocations, kycUC, auditLogUC)
 
 	// Initialize handlers
-	authHandler := identity.NewAuthHandler(registerUC, loginUC, refreshTokenUC, logoutUC)
+	authHandler := identity.NewAuthHandler(
+		registerUC,
+		loginUC,
+		refreshTokenUC,
+		logoutUC,
+		getMeUC,
+		sendVerificationEmailUC,
+		verifyEmailUC,
+		requestPasswordResetUC,
+		resetPasswordUC,
+		changePasswordUC,
+		sessionsUC,
+		verifyMFAUC,
+		mfaEnrollmentUC,
+		oidcLoginUC,
+		accountDeletionUC,
+		exportDataUC,
+		magicLinkUC,
+		rolesUC,
+	)
 	chefProfileHandler := chefHandler.NewProfileHandler(chefProfileUC)
 	restaurantProfileHandler := restaurantHandler.NewProfileHandler(restaurantProfileUC)
+	restaurantTeamHandler := restaurantHandler.NewTeamHandler(restaurantTeamUC)
+	apiKeyHandler := restaurantHandler.NewAPIKeyHandler(apiKeyUC)
 	jobServiceHandler := jobHandler.NewJobHandler(jobUC)
+	kycServiceHandler := kycHandler.NewKycHandler(kycUC)
+	auditServiceHandler := auditHandler.NewAuditHandler(auditLogUC)
+	adminServiceHandler := adminHandler.NewAdminHandler(adminUC, kycUC, auditLogUC)
 
 	// Initialize interceptors
-	authInterceptor := middleware.NewAuthInterceptor(jwtManager)
-	rateLimitInterceptor := middleware.NewRateLimitInterceptor(100, 200) // 100 req/sec, burst 200
+	// otelhttp has already extracted the caller's trace context for the whole
+	// HTTP request, so RPC spans nest under its span instead of re-extracting
+	otelInterceptor, err := otelconnect.NewInterceptor(
+		otelconnect.WithTrustRemote(),
+		otelconnect.WithPropagator(propagation.NewCompositeTextMapPropagator()),
+	)
+	if err != nil {
+		return fmt.Errorf("create tracing interceptor: %w", err)
+	}
+	metricsInterceptor := middleware.NewMetricsInterceptor(apiMetrics)
+	idempotencyInterceptor := middleware.NewIdempotencyInterceptor(idempotency.NewStore(redisClient, 24*time.Hour), log)
+	authInterceptor := middleware.NewAuthInterceptor(jwtManager, revocations, apiKeyUC)
+	mfaInterceptor := middleware.NewMFAInterceptor(mfaPolicy)
+	auditInterceptor := middleware.NewAuditInterceptor()
+	validationInterceptor, err := middleware.NewValidationInterceptor()
+	if err != nil {
+		return fmt.Errorf("create validation interceptor: %w", err)
+	}
+	// Credential and application endpoints get their own, much smaller buckets
+	// so they cannot be brute-forced or spammed within the general allowance
+	rateLimitInterceptor := middleware.NewRateLimitInterceptor(
+		ratelimit.NewLimiter(redisClient),
+		ratelimit.Policy{Rate: 100, Period: time.Second, Burst: 200},
+		map[string]ratelimit.Policy{
+			identityv1connect.AuthServiceLoginProcedure:                           {Rate: 10, Period: time.Minute, Burst: 5},
+			identityv1connect.AuthServiceRegisterProcedure:                        {Rate: 5, Period: time.Hour, Burst: 3},
+			identityv1connect.AuthServiceVerifyMfaProcedure:                       {Rate: 10, Period: time.Minute, Burst: 5},
+			identityv1connect.AuthServiceRequestPasswordResetProcedure:            {Rate: 5, Period: time.Hour, Burst: 3},
+			identityv1connect.AuthServiceRequestMagicLinkProcedure:                {Rate: 5, Period: time.Hour, Burst: 3},
+			identityv1connect.AuthServiceSendAccountDeletionConfirmationProcedure: {Rate: 5, Period: time.Hour, Burst: 3},
+			identityv1connect.AuthServiceDeleteAccountProcedure:                   {Rate: 10, Period: time.Minute, Burst: 5},
+			jobv1connect.JobServiceCreateApplicationProcedure:                     {Rate: 30, Period: time.Hour, Burst: 10},
+		},
+		apiMetrics,
+		log,
+	)
+	// Every call, authenticated or not, also counts against its client IP
+	// before credentials are checked, so guessing tokens or API keys is bounded
+	clientIPRateLimitInterceptor := middleware.NewClientIPRateLimitInterceptor(
+		ratelimit.NewLimiter(redisClient),
+		ratelimit.Policy{Rate: 200, Period: time.Second, Burst: 400},
+		apiMetrics,
+		log,
+	)
+
+	// Auth runs before the per-caller limiter so rate limits are keyed by user
+	// or API key where possible, and by client IP otherwise
+	interceptors := connect.WithInterceptors(
+		otelInterceptor,
+		metricsInterceptor,
+		clientIPRateLimitInterceptor,
+		authInterceptor,
+		rateLimitInterceptor,
+		mfaInterceptor,
+		validationInterceptor,
+		auditInterceptor,
+		idempotencyInterceptor,
+	)
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/health", healthHandler(pool))
+	mux.HandleFunc("GET /.well-known/jwks.json", jwksHandler(keyManager))
+
+	// mount registers a Connect service and remembers its name so its auth
+	// policies can be checked before serving
+	var services []string
+	mount := func(path string, handler http.Handler) {
+		services = append(services, strings.Trim(path, "/"))
+		mux.Handle(path, handler)
+	}
 
 	// Register Connect-RPC routes with interceptors
-	// Apply rate limiting to all endpoints, auth to protected endpoints
 	path, handler := identityv1connect.NewAuthServiceHandler(
 		authHandler,
-		connect.WithInterceptors(rateLimitInterceptor, authInterceptor),
+		interceptors,
 	)
-	mux.Handle(path, handler)
+	mount(path, handler)
 
 	path, handler = chefv1connect.NewChefProfileServiceHandler(
 		chefProfileHandler,
-		connect.WithInterceptors(rateLimitInterceptor, authInterceptor),
+		interceptors,
 	)
-	mux.Handle(path, handler)
+	mount(path, handler)
 
 	path, handler = restaurantv1connect.NewRestaurantProfileServiceHandler(
 		restaurantProfileHandler,
-		connect.WithInterceptors(rateLimitInterceptor, authInterceptor),
+		interceptors,
+	)
+	mount(path, handler)
+
+	path, handler = restaurantv1connect.NewRestaurantTeamServiceHandler(
+		restaurantTeamHandler,
+		interceptors,
 	)
-	mux.Handle(path, handler)
+	mount(path, handler)
+
+	path, handler = restaurantv1connect.NewApiKeyServiceHandler(
+		apiKeyHandler,
+		interceptors,
+	)
+	mount(path, handler)
 
 	path, handler = jobv1connect.NewJobServiceHandler(
 		jobServiceHandler,
-		connect.WithInterceptors(rateLimitInterceptor, authInterceptor),
+		interceptors,
 	)
-	mux.Handle(path, handler)
+	mount(path, handler)
+
+	path, handler = kycv1connect.NewKycServiceHandler(
+		kycServiceHandler,
+		interceptors,
+	)
+	mount(path, handler)
+
+	path, handler = auditv1connect.NewAuditServiceHandler(
+		auditServiceHandler,
+		interceptors,
+	)
+	mount(path, handler)
+
+	// Admin RPCs require the ADMIN role through their auth policies; it is
+	// granted out-of-band through cmd/admin
+	path, handler = adminv1connect.NewAdminServiceHandler(
+		adminServiceHandler,
+		interceptors,
+	)
+	mount(path, handler)
+
+	// Refuse to serve when any RPC is missing its (chefnext.v1.auth) policy
+	if err := middleware.CheckAuthPolicies(services...); err != nil {
+		return fmt.Errorf("auth policies: %w", err)
+	}
 
 	// Use h2c to support HTTP/2 without TLS (required for Connect-RPC)
 	cors := middleware.NewCORSMiddleware(cfg.CORSAllowedOrigins)
-	serverHandler := loggingMiddleware(log, cors(h2c.NewHandler(mux, &http2.Server{})))
+	requestID := middleware.NewRequestIDMiddleware()
+	trustedProxies, err := middleware.ParseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		return fmt.Errorf("parse TRUSTED_PROXIES: %w", err)
+	}
+	clientIP := middleware.NewClientIPMiddleware(trustedProxies)
+	serverHandler := otelhttp.NewHandler(
+		requestID(clientIP(loggingMiddleware(log, cors(h2c.NewHandler(mux, &http2.Server{}))))),
+		"chefnext-api",
+		otelhttp.WithFilter(func(r *http.Request) bool {
+			return r.URL.Path != "/health"
+		}),
+		otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return r.Method + " " + r.URL.Path
+		}),
+	)
 
 	srv := &http.Server{
 		Addr:    net.JoinHostPort(cfg.HTTPHost, cfg.HTTPPort),
 		Handler: serverHandler,
 	}
 
+	// Metrics are served on a separate listener so they are never reachable
+	// through the public API
+	var metricsSrv *http.Server
+	if cfg.MetricsAddr != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("GET /metrics", metrics.Handler(metricsRegistry))
+		metricsSrv = &http.Server{
+			Addr:    cfg.MetricsAddr,
+			Handler: metricsMux,
+		}
+
+		go func() {
+			log.Info("metrics listener ready", "addr", metricsSrv.Addr)
+			if err := metricsSrv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Error("metrics server failed", "error", err)
+			}
+		}()
+	}
+
 	go func() {
 		<-ctx.Done()
 		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 		defer cancel()
 
+		if metricsSrv != nil {
+			if err := metricsSrv.Shutdown(shutdownCtx); err != nil {
+				log.Error("metrics server shutdown failed", "error", err)
+			}
+		}
 		if err := srv.Shutdown(shutdownCtx); err != nil {
 			log.Error("graceful shutdown failed", "error", err)
 		} else {
@@ -180,6 +501,28 @@ func healthHandler(db *pgxpool.Pool) http.HandlerFunc {
 	}
 }
 
+// loadBreachedPasswords reads the configured dataset, falling back to the one
+// shipped with the service
+func loadBreachedPasswords(path string) (*auth.BreachedPasswords, error) {
+	if path == "" {
+		return auth.DefaultBreachedPasswords()
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return auth.LoadBreachedPasswords(file)
+}
+
+func jwksHandler(keys *auth.KeyManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Keys are published a day before they sign, so short caching is safe
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		writeJSON(w, http.StatusOK, keys.JWKS())
+	}
+}
+
 func writeJSON(w http.ResponseWriter, status int, payload any) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
@@ -194,11 +537,12 @@ func loggingMiddleware(log *slog.Logger, next http.Handler) http.Handler {
 		start := time.Now()
 		rw := &responseWriter{ResponseWriter: w, status: http.StatusOK}
 		next.ServeHTTP(rw, r)
-		log.Info("request completed",
+		log.InfoContext(r.Context(), "request completed",
 			"method", r.Method,
 			"path", r.URL.Path,
 			"status", rw.status,
 			"duration", time.Since(start).String(),
+			"request_id", r.Header.Get(middleware.RequestIDHeader),
 		)
 	})
 }
@@ -0,0 +1,67 @@
+// Command breached-passwords builds the hash-prefix file used by the password
+// policy from a list of compromised passwords.
+//
+// Input lines are either plaintext passwords or uppercase hex SHA-1 hashes
+// with an optional ":count" column, as in the Have I Been Pwned downloads:
+//
+//	go run ./cmd/breached-passwords < passwords.txt > internal/pkg/auth/data/breached_passwords.txt
+package main
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintf(os.Stderr, "breached-passwords: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	hashes := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		hashes[hashLine(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	sorted := make([]string, 0, len(hashes))
+	for hash := range hashes {
+		sorted = append(sorted, hash)
+	}
+	sort.Strings(sorted)
+
+	out := bufio.NewWriter(os.Stdout)
+	fmt.Fprintln(out, "# SHA-1 hashes of compromised passwords as PREFIX:SUFFIX, generated by cmd/breached-passwords")
+	for _, hash := range sorted {
+		fmt.Fprintf(out, "%s:%s\n", hash[:5], hash[5:])
+	}
+	return out.Flush()
+}
+
+// hashLine returns the SHA-1 of a plaintext password, or the hash itself when
+// the line already is one
+func hashLine(line string) string {
+	candidate, _, _ := strings.Cut(line, ":")
+	if len(candidate) == sha1.Size*2 {
+		if _, err := hex.DecodeString(candidate); err == nil {
+			return strings.ToUpper(candidate)
+		}
+	}
+	sum := sha1.Sum([]byte(line))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE users
+ADD COLUMN email_verified_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE users
+DROP COLUMN IF EXISTS email_verified_at;
@@ -0,0 +1,41 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS kyc_submissions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    -- submitted, approved or rejected
+    status VARCHAR(20) NOT NULL DEFAULT 'submitted',
+    business_name VARCHAR(255) NOT NULL,
+    registration_number VARCHAR(64) NOT NULL,
+    business_address TEXT NOT NULL,
+    representative_name VARCHAR(255) NOT NULL,
+    rejection_reasons TEXT[] NOT NULL DEFAULT '{}',
+    reviewer_note TEXT,
+    reviewed_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    reviewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_kyc_submissions_user_id ON kyc_submissions(user_id, created_at DESC);
+CREATE INDEX idx_kyc_submissions_status ON kyc_submissions(status, created_at);
+-- A user can only have one submission waiting for review
+CREATE UNIQUE INDEX uniq_kyc_submissions_open ON kyc_submissions(user_id) WHERE status = 'submitted';
+
+CREATE TABLE IF NOT EXISTS kyc_documents (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    submission_id UUID NOT NULL REFERENCES kyc_submissions(id) ON DELETE CASCADE,
+    document_type VARCHAR(40) NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    sha256 CHAR(64) NOT NULL,
+    -- Key of the encrypted blob in object storage
+    storage_key TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_kyc_documents_submission_id ON kyc_documents(submission_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS kyc_documents;
+DROP TABLE IF EXISTS kyc_submissions;
@@ -0,0 +1,17 @@
+-- +goose Up
+ALTER TABLE users
+    ADD COLUMN deletion_requested_at TIMESTAMPTZ,
+    ADD COLUMN deletion_scheduled_for TIMESTAMPTZ,
+    -- Set once personal data was removed; the row stays so records kept for
+    -- the other party, such as applications, still resolve
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX idx_users_deletion_scheduled_for ON users(deletion_scheduled_for)
+    WHERE deletion_scheduled_for IS NOT NULL AND deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_users_deletion_scheduled_for;
+ALTER TABLE users
+    DROP COLUMN IF EXISTS deleted_at,
+    DROP COLUMN IF EXISTS deletion_scheduled_for,
+    DROP COLUMN IF EXISTS deletion_requested_at;
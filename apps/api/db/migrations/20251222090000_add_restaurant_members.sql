@@ -0,0 +1,44 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS restaurant_members (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurant_profiles(id) ON DELETE CASCADE,
+    -- A login manages at most one restaurant, so job and application
+    -- endpoints can keep resolving the restaurant from the caller
+    user_id UUID NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    -- OWNER, MANAGER, RECRUITER or VIEWER
+    role VARCHAR(20) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_restaurant_members_restaurant_id ON restaurant_members(restaurant_id, created_at);
+
+-- Everyone who created a restaurant profile becomes its first owner
+INSERT INTO restaurant_members (restaurant_id, user_id, role)
+SELECT id, user_id, 'OWNER'
+FROM restaurant_profiles
+ON CONFLICT (user_id) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS restaurant_invitations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    restaurant_id UUID NOT NULL REFERENCES restaurant_profiles(id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    role VARCHAR(20) NOT NULL,
+    -- SHA-256 of the token mailed to the invitee
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    invited_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    accepted_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    accepted_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_restaurant_invitations_restaurant_id ON restaurant_invitations(restaurant_id, created_at DESC);
+-- Re-inviting an address replaces its open invitation
+CREATE UNIQUE INDEX uniq_restaurant_invitations_open ON restaurant_invitations(restaurant_id, email)
+    WHERE accepted_at IS NULL AND revoked_at IS NULL;
+
+-- +goose Down
+DROP TABLE IF EXISTS restaurant_invitations;
+DROP TABLE IF EXISTS restaurant_members;
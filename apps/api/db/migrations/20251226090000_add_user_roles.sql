@@ -0,0 +1,18 @@
+-- +goose Up
+-- roles holds every role granted to the user; role is the active one they
+-- last chose and is used as the default at login
+ALTER TABLE users ADD COLUMN roles TEXT[];
+
+UPDATE users SET roles = ARRAY[role];
+
+ALTER TABLE users
+    ALTER COLUMN roles SET NOT NULL,
+    ADD CONSTRAINT users_active_role_granted CHECK (role = ANY(roles));
+
+CREATE INDEX IF NOT EXISTS idx_users_roles ON users USING GIN (roles);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_users_roles;
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS users_active_role_granted,
+    DROP COLUMN IF EXISTS roles;
@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS audit_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    -- NULL for changes made by the system, e.g. the account purge
+    actor_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    -- Active role of the actor when the change was made
+    actor_role VARCHAR(20),
+    api_key_id UUID REFERENCES api_keys(id) ON DELETE SET NULL,
+    -- Connect procedure the change was made through, if any
+    procedure VARCHAR(255),
+    action VARCHAR(50) NOT NULL,
+    target_type VARCHAR(50) NOT NULL,
+    target_id UUID NOT NULL,
+    -- Restaurant owning the target, so owners can see changes to their
+    -- resources; kept without a foreign key so history outlives the restaurant
+    restaurant_id UUID,
+    -- {"before": {...}, "after": {...}} with only the fields that changed
+    changes JSONB NOT NULL DEFAULT '{}',
+    ip_address VARCHAR(64),
+    request_id VARCHAR(128),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_events_target ON audit_events(target_type, target_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_restaurant ON audit_events(restaurant_id, created_at DESC) WHERE restaurant_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_audit_events_actor ON audit_events(actor_user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_events_created_at ON audit_events(created_at DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_events;
@@ -0,0 +1,33 @@
+-- +goose Up
+-- Admin actions are recorded in audit_events like every other change, so the
+-- separate log is moved over and dropped
+INSERT INTO audit_events (id, actor_user_id, actor_role, action, target_type, target_id, changes, ip_address, created_at)
+SELECT
+    id,
+    actor_user_id,
+    CASE WHEN actor_user_id IS NOT NULL THEN 'ADMIN' END,
+    action,
+    'user',
+    target_user_id,
+    jsonb_build_object('after', details),
+    ip_address,
+    created_at
+FROM admin_audit_log
+WHERE target_user_id IS NOT NULL;
+
+DROP TABLE IF EXISTS admin_audit_log;
+
+-- +goose Down
+-- Entries moved into audit_events stay there
+CREATE TABLE IF NOT EXISTS admin_audit_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    actor_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    action VARCHAR(50) NOT NULL,
+    target_user_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    details JSONB NOT NULL DEFAULT '{}',
+    ip_address VARCHAR(64),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_admin_audit_log_target ON admin_audit_log(target_user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_admin_audit_log_created_at ON admin_audit_log(created_at DESC);
@@ -0,0 +1,194 @@
+-- name: ScheduleUserDeletion :one
+UPDATE users
+SET deletion_requested_at = NOW(),
+    deletion_scheduled_for = $2,
+    updated_at = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL
+  AND deletion_scheduled_for IS NULL
+RETURNING *;
+
+-- name: CancelUserDeletion :execrows
+UPDATE users
+SET deletion_requested_at = NULL,
+    deletion_scheduled_for = NULL,
+    updated_at = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL
+  AND deletion_scheduled_for IS NOT NULL;
+
+-- name: ListUsersDueForDeletion :many
+SELECT id
+FROM users
+WHERE deletion_scheduled_for <= $1
+  AND deleted_at IS NULL
+ORDER BY deletion_scheduled_for ASC
+LIMIT $2;
+
+-- name: LockUserDueForDeletion :one
+-- Held until the purge commits, so a cancellation either lands first and the
+-- purge skips the account, or waits and finds nothing left to cancel
+SELECT id
+FROM users
+WHERE id = $1
+  AND deletion_scheduled_for <= $2
+  AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: AnonymizeUser :exec
+UPDATE users
+SET email = 'deleted-' || id::text || '@deleted.invalid',
+    password_hash = '',
+    kyc_flags = '{}',
+    email_verified_at = NULL,
+    suspension_reason = NULL,
+    deletion_scheduled_for = NULL,
+    deleted_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteUserIdentitiesByUser :exec
+DELETE FROM user_identities
+WHERE user_id = $1;
+
+-- name: DeleteTOTPCredentialByUser :exec
+DELETE FROM user_totp_credentials
+WHERE user_id = $1;
+
+-- name: DeleteRecoveryCodesByUser :exec
+DELETE FROM mfa_recovery_codes
+WHERE user_id = $1;
+
+-- name: CountApplicationsByChefUser :one
+SELECT COUNT(*)
+FROM applications a
+JOIN chef_profiles cp ON cp.id = a.chef_profile_id
+WHERE cp.user_id = $1;
+
+-- name: AnonymizeChefProfile :exec
+UPDATE chef_profiles
+SET full_name = 'Deleted user',
+    headline = NULL,
+    summary = NULL,
+    bio = NULL,
+    location = NULL,
+    years_experience = NULL,
+    availability = NULL,
+    languages = NULL,
+    learning_focus = NULL,
+    specialties = NULL,
+    work_areas = NULL,
+    skill_tree_json = NULL,
+    portfolio_items = '[]'::jsonb,
+    updated_at = NOW()
+WHERE user_id = $1;
+
+-- name: RedactApplicationsByChefUser :exec
+UPDATE applications
+SET cover_letter = NULL,
+    updated_at = NOW()
+WHERE chef_profile_id IN (
+    SELECT id FROM chef_profiles WHERE user_id = $1
+);
+
+-- name: CountApplicationsByRestaurantUser :one
+SELECT COUNT(*)
+FROM applications a
+JOIN jobs j ON j.id = a.job_id
+JOIN restaurant_profiles rp ON rp.id = j.restaurant_id
+WHERE rp.user_id = $1;
+
+-- name: AnonymizeRestaurantProfile :exec
+UPDATE restaurant_profiles
+SET name = 'Deleted restaurant',
+    display_name = 'Deleted restaurant',
+    address = NULL,
+    description = NULL,
+    cuisine_types = NULL,
+    tagline = NULL,
+    location = NULL,
+    seats = NULL,
+    mentorship_style = NULL,
+    culture_keywords = NULL,
+    benefits = NULL,
+    support_programs = NULL,
+    learning_highlights = '[]'::jsonb,
+    updated_at = NOW()
+WHERE user_id = $1;
+
+-- name: CloseJobsByRestaurantUser :exec
+UPDATE jobs
+SET status = 'CLOSED',
+    updated_at = NOW()
+WHERE restaurant_id IN (
+    SELECT id FROM restaurant_profiles WHERE user_id = $1
+)
+  AND status <> 'CLOSED';
+
+-- name: ListKYCDocumentKeysByUser :many
+SELECT d.storage_key
+FROM kyc_documents d
+JOIN kyc_submissions s ON s.id = d.submission_id
+WHERE s.user_id = $1;
+
+-- name: DeleteKYCSubmissionsByUser :exec
+DELETE FROM kyc_submissions
+WHERE user_id = $1;
+
+-- name: ListKYCSubmissionsByUser :many
+SELECT *
+FROM kyc_submissions
+WHERE user_id = $1
+ORDER BY created_at ASC;
+
+-- name: ListJobsByRestaurantUser :many
+SELECT
+    j.id,
+    j.title,
+    j.description,
+    j.required_skills,
+    j.location,
+    j.salary_range,
+    j.employment_type,
+    j.status,
+    j.metadata,
+    j.created_at,
+    j.updated_at
+FROM jobs j
+JOIN restaurant_profiles rp ON rp.id = j.restaurant_id
+WHERE rp.user_id = $1
+ORDER BY j.created_at ASC;
+
+-- name: ListApplicationsByChefUser :many
+SELECT
+    a.id,
+    a.job_id,
+    a.status,
+    a.cover_letter,
+    a.created_at,
+    a.updated_at,
+    j.title AS job_title,
+    rp.display_name AS restaurant_display_name
+FROM applications a
+JOIN chef_profiles cp ON cp.id = a.chef_profile_id
+JOIN jobs j ON j.id = a.job_id
+JOIN restaurant_profiles rp ON rp.id = j.restaurant_id
+WHERE cp.user_id = $1
+ORDER BY a.created_at ASC;
+
+-- name: ListApplicationsReceivedByRestaurantUser :many
+SELECT
+    a.id,
+    a.job_id,
+    a.status,
+    a.cover_letter,
+    a.created_at,
+    a.updated_at,
+    j.title AS job_title,
+    cp.full_name AS chef_full_name
+FROM applications a
+JOIN jobs j ON j.id = a.job_id
+JOIN restaurant_profiles rp ON rp.id = j.restaurant_id
+JOIN chef_profiles cp ON cp.id = a.chef_profile_id
+WHERE rp.user_id = $1
+ORDER BY a.created_at ASC;
@@ -0,0 +1,38 @@
+-- name: SearchUsers :many
+SELECT
+    *,
+    COUNT(*) OVER() AS total_count
+FROM users
+WHERE
+    (sqlc.narg('email')::text IS NULL OR email ILIKE '%' || sqlc.narg('email') || '%')
+    AND (sqlc.narg('role')::text IS NULL OR sqlc.narg('role') = ANY(roles))
+    AND (sqlc.narg('suspended')::boolean IS NULL OR (suspended_at IS NOT NULL) = sqlc.narg('suspended'))
+ORDER BY created_at DESC
+LIMIT $1 OFFSET $2;
+
+-- name: UpdateUserRole :one
+-- Replaces every granted role with the single given one
+UPDATE users
+SET role = $2,
+    roles = ARRAY[$2]::text[],
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: SuspendUser :one
+UPDATE users
+SET suspended_at = NOW(),
+    suspension_reason = $2,
+    updated_at = NOW()
+WHERE id = $1
+  AND suspended_at IS NULL
+RETURNING *;
+
+-- name: ReactivateUser :one
+UPDATE users
+SET suspended_at = NULL,
+    suspension_reason = NULL,
+    updated_at = NOW()
+WHERE id = $1
+  AND suspended_at IS NOT NULL
+RETURNING *;
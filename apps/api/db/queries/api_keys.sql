@@ -0,0 +1,52 @@
+-- name: CreateAPIKey :one
+INSERT INTO api_keys (
+    restaurant_id,
+    created_by,
+    name,
+    prefix,
+    key_hash,
+    scopes,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING *;
+
+-- name: ListAPIKeysByRestaurant :many
+SELECT *
+FROM api_keys
+WHERE restaurant_id = $1
+ORDER BY created_at DESC;
+
+-- name: RevokeAPIKey :execrows
+UPDATE api_keys
+SET revoked_at = NOW()
+WHERE id = $1
+  AND restaurant_id = $2
+  AND revoked_at IS NULL;
+
+-- name: GetAPIKeyForAuthentication :one
+-- member_role is NULL once the creator has left the restaurant team
+SELECT
+    k.id,
+    k.restaurant_id,
+    k.created_by,
+    k.scopes,
+    k.expires_at,
+    k.revoked_at,
+    u.email AS user_email,
+    u.roles AS user_roles,
+    u.suspended_at AS user_suspended_at,
+    u.deleted_at AS user_deleted_at,
+    m.role AS member_role
+FROM api_keys k
+JOIN users u ON u.id = k.created_by
+LEFT JOIN restaurant_members m ON m.restaurant_id = k.restaurant_id AND m.user_id = k.created_by
+WHERE k.key_hash = $1;
+
+-- name: TouchAPIKey :exec
+-- Recorded at most once a minute to keep authentication cheap
+UPDATE api_keys
+SET last_used_at = NOW()
+WHERE id = $1
+  AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute');
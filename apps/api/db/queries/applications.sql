@@ -19,6 +19,7 @@ SELECT
     a.id,
     a.job_id,
     a.chef_profile_id,
+    a.status,
     j.restaurant_id,
     rp.user_id AS restaurant_user_id,
     cp.user_id AS chef_user_id
@@ -0,0 +1,42 @@
+-- name: CreateAuditEvent :exec
+INSERT INTO audit_events (
+    actor_user_id,
+    actor_role,
+    api_key_id,
+    procedure,
+    action,
+    target_type,
+    target_id,
+    restaurant_id,
+    changes,
+    ip_address,
+    request_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+);
+
+-- name: ListAuditEvents :many
+SELECT
+    *,
+    COUNT(*) OVER() AS total_count
+FROM audit_events
+WHERE
+    (sqlc.narg('restaurant_id')::uuid IS NULL OR restaurant_id = sqlc.narg('restaurant_id'))
+    AND (sqlc.narg('actor_user_id')::uuid IS NULL OR actor_user_id = sqlc.narg('actor_user_id'))
+    AND (sqlc.narg('target_type')::text IS NULL OR target_type = sqlc.narg('target_type'))
+    AND (sqlc.narg('target_id')::uuid IS NULL OR target_id = sqlc.narg('target_id'))
+ORDER BY created_at DESC
+LIMIT $1 OFFSET $2;
+
+-- name: RedactAuditEventsByUser :exec
+-- Drops the recorded field values of changes to the user's account, chef
+-- profile and KYC submissions once the account is purged
+UPDATE audit_events
+SET changes = '{}'
+WHERE (target_type = 'user' AND target_id = $1)
+   OR (target_type = 'chef_profile' AND target_id IN (
+        SELECT id FROM chef_profiles WHERE user_id = $1
+   ))
+   OR (target_type = 'kyc_submission' AND target_id IN (
+        SELECT id FROM kyc_submissions WHERE user_id = $1
+   ));
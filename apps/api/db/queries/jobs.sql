@@ -90,6 +90,7 @@ LIMIT $4 OFFSET $5;
 SELECT
     j.id,
     j.restaurant_id,
+    j.status,
     rp.user_id AS restaurant_user_id
 FROM jobs j
 JOIN restaurant_profiles rp ON rp.id = j.restaurant_id
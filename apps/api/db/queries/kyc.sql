@@ -0,0 +1,97 @@
+-- name: CreateKYCSubmission :one
+INSERT INTO kyc_submissions (
+    id,
+    user_id,
+    business_name,
+    registration_number,
+    business_address,
+    representative_name
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+)
+RETURNING *;
+
+-- name: DeleteKYCSubmission :exec
+DELETE FROM kyc_submissions
+WHERE id = $1;
+
+-- name: CreateKYCDocument :one
+INSERT INTO kyc_documents (
+    id,
+    submission_id,
+    document_type,
+    file_name,
+    content_type,
+    size_bytes,
+    sha256,
+    storage_key
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8
+)
+RETURNING *;
+
+-- name: GetKYCSubmissionByID :one
+SELECT
+    sqlc.embed(s),
+    u.email AS user_email
+FROM kyc_submissions s
+JOIN users u ON u.id = s.user_id
+WHERE s.id = $1
+LIMIT 1;
+
+-- name: GetLatestKYCSubmissionByUser :one
+SELECT
+    sqlc.embed(s),
+    u.email AS user_email
+FROM kyc_submissions s
+JOIN users u ON u.id = s.user_id
+WHERE s.user_id = $1
+ORDER BY s.created_at DESC
+LIMIT 1;
+
+-- name: ListKYCSubmissions :many
+SELECT
+    sqlc.embed(s),
+    u.email AS user_email,
+    COUNT(*) OVER() AS total_count
+FROM kyc_submissions s
+JOIN users u ON u.id = s.user_id
+WHERE sqlc.narg('status')::text IS NULL OR s.status = sqlc.narg('status')
+ORDER BY s.created_at ASC
+LIMIT $1 OFFSET $2;
+
+-- name: ListKYCDocumentsBySubmission :many
+SELECT *
+FROM kyc_documents
+WHERE submission_id = $1
+ORDER BY created_at ASC;
+
+-- name: GetKYCDocumentByID :one
+SELECT *
+FROM kyc_documents
+WHERE id = $1
+LIMIT 1;
+
+-- name: ReviewKYCSubmission :one
+UPDATE kyc_submissions
+SET status = $2,
+    rejection_reasons = $3,
+    reviewer_note = $4,
+    reviewed_by = $5,
+    reviewed_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'submitted'
+RETURNING *;
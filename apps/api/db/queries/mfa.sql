@@ -0,0 +1,56 @@
+-- name: UpsertPendingTOTPCredential :execrows
+INSERT INTO user_totp_credentials (
+    user_id,
+    secret_encrypted
+) VALUES (
+    $1,
+    $2
+)
+ON CONFLICT (user_id) DO UPDATE
+SET secret_encrypted = EXCLUDED.secret_encrypted,
+    last_used_step = 0,
+    updated_at = NOW()
+WHERE user_totp_credentials.confirmed_at IS NULL;
+
+-- name: GetTOTPCredential :one
+SELECT *
+FROM user_totp_credentials
+WHERE user_id = $1
+LIMIT 1;
+
+-- name: ConfirmTOTPCredential :execrows
+UPDATE user_totp_credentials
+SET confirmed_at = NOW(),
+    last_used_step = $2,
+    updated_at = NOW()
+WHERE user_id = $1
+  AND confirmed_at IS NULL;
+
+-- name: UseTOTPStep :execrows
+UPDATE user_totp_credentials
+SET last_used_step = $2,
+    updated_at = NOW()
+WHERE user_id = $1
+  AND confirmed_at IS NOT NULL
+  AND last_used_step < $2;
+
+-- name: ReplaceRecoveryCodes :exec
+WITH deleted AS (
+    DELETE FROM mfa_recovery_codes
+    WHERE user_id = @user_id
+)
+INSERT INTO mfa_recovery_codes (user_id, code_hash)
+SELECT @user_id, unnest(@code_hashes::varchar[]);
+
+-- name: UseRecoveryCode :execrows
+UPDATE mfa_recovery_codes
+SET used_at = NOW()
+WHERE user_id = $1
+  AND code_hash = $2
+  AND used_at IS NULL;
+
+-- name: CountUnusedRecoveryCodes :one
+SELECT COUNT(*)
+FROM mfa_recovery_codes
+WHERE user_id = $1
+  AND used_at IS NULL;
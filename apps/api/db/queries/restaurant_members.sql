@@ -0,0 +1,144 @@
+-- name: CreateRestaurantMember :one
+INSERT INTO restaurant_members (
+    restaurant_id,
+    user_id,
+    role
+) VALUES (
+    $1, $2, $3
+)
+RETURNING *;
+
+-- name: GetRestaurantMembershipByUser :one
+SELECT
+    m.id,
+    m.restaurant_id,
+    m.role,
+    rp.display_name,
+    rp.tagline,
+    rp.location
+FROM restaurant_members m
+JOIN restaurant_profiles rp ON rp.id = m.restaurant_id
+WHERE m.user_id = $1;
+
+-- name: GetRestaurantMember :one
+SELECT *
+FROM restaurant_members
+WHERE restaurant_id = $1 AND user_id = $2;
+
+-- name: GetRestaurantMemberByID :one
+SELECT sqlc.embed(m), u.email AS user_email
+FROM restaurant_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.id = $1;
+
+-- name: ListRestaurantMembers :many
+SELECT sqlc.embed(m), u.email AS user_email
+FROM restaurant_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.restaurant_id = $1
+ORDER BY m.created_at ASC;
+
+-- name: CountRestaurantMembers :one
+SELECT COUNT(*)
+FROM restaurant_members
+WHERE restaurant_id = $1;
+
+-- name: CountRestaurantOwners :one
+SELECT COUNT(*)
+FROM restaurant_members
+WHERE restaurant_id = $1 AND role = 'OWNER';
+
+-- name: LockRestaurantOwners :many
+-- Held until the transaction ends so concurrent demotions and removals are
+-- checked one at a time and cannot leave the restaurant without an owner
+SELECT id
+FROM restaurant_members
+WHERE restaurant_id = $1 AND role = 'OWNER'
+FOR UPDATE;
+
+-- name: UpdateRestaurantMemberRole :one
+UPDATE restaurant_members
+SET role = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: PromoteLongestStandingRestaurantMember :one
+UPDATE restaurant_members
+SET role = 'OWNER',
+    updated_at = NOW()
+WHERE id = (
+    SELECT m.id
+    FROM restaurant_members m
+    WHERE m.restaurant_id = $1
+    ORDER BY m.created_at ASC, m.id ASC
+    LIMIT 1
+)
+RETURNING *;
+
+-- name: DeleteRestaurantMember :exec
+DELETE FROM restaurant_members
+WHERE id = $1;
+
+-- name: DeleteRestaurantMembershipsByUser :exec
+DELETE FROM restaurant_members
+WHERE user_id = $1;
+
+-- name: GetRestaurantKYCStatus :one
+-- Verification belongs to the account that created the restaurant profile
+SELECT u.kyc_status
+FROM restaurant_profiles rp
+JOIN users u ON u.id = rp.user_id
+WHERE rp.id = $1;
+
+-- name: CreateRestaurantInvitation :one
+INSERT INTO restaurant_invitations (
+    restaurant_id,
+    email,
+    role,
+    token_hash,
+    invited_by,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+RETURNING *;
+
+-- name: RevokeOpenRestaurantInvitation :exec
+UPDATE restaurant_invitations
+SET revoked_at = NOW()
+WHERE restaurant_id = $1
+  AND email = $2
+  AND accepted_at IS NULL
+  AND revoked_at IS NULL;
+
+-- name: RevokeRestaurantInvitation :execrows
+UPDATE restaurant_invitations
+SET revoked_at = NOW()
+WHERE id = $1
+  AND restaurant_id = $2
+  AND accepted_at IS NULL
+  AND revoked_at IS NULL;
+
+-- name: ListOpenRestaurantInvitations :many
+SELECT *
+FROM restaurant_invitations
+WHERE restaurant_id = $1
+  AND accepted_at IS NULL
+  AND revoked_at IS NULL
+ORDER BY created_at DESC;
+
+-- name: GetRestaurantInvitationByTokenHash :one
+SELECT sqlc.embed(i), rp.display_name AS restaurant_display_name
+FROM restaurant_invitations i
+JOIN restaurant_profiles rp ON rp.id = i.restaurant_id
+WHERE i.token_hash = $1;
+
+-- name: AcceptRestaurantInvitation :execrows
+UPDATE restaurant_invitations
+SET accepted_by = $2,
+    accepted_at = NOW()
+WHERE id = $1
+  AND accepted_at IS NULL
+  AND revoked_at IS NULL
+  AND expires_at > NOW();
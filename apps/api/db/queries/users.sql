@@ -3,11 +3,13 @@ INSERT INTO users (
     email,
     password_hash,
     role,
+    roles,
     kyc_status
 ) VALUES (
     $1,
     $2,
     $3,
+    ARRAY[$3]::text[],
     $4
 )
 RETURNING *;
@@ -29,3 +31,30 @@ UPDATE users
 SET kyc_status = $2,
     updated_at = NOW()
 WHERE id = $1;
+
+-- name: MarkUserEmailVerified :exec
+UPDATE users
+SET email_verified_at = COALESCE(email_verified_at, NOW()),
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: AddUserRole :one
+UPDATE users
+SET roles = array_append(roles, sqlc.arg(role)::text),
+    updated_at = NOW()
+WHERE id = $1
+  AND NOT (sqlc.arg(role)::text = ANY(roles))
+RETURNING *;
+
+-- name: SetUserActiveRole :execrows
+UPDATE users
+SET role = sqlc.arg(role)::text,
+    updated_at = NOW()
+WHERE id = $1
+  AND sqlc.arg(role)::text = ANY(roles);
+
+-- name: UpdateUserPasswordHash :exec
+UPDATE users
+SET password_hash = $2,
+    updated_at = NOW()
+WHERE id = $1;
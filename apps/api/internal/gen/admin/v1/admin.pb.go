@@ -0,0 +1,1650 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: admin/v1/admin.proto
+
+package adminv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v11 "github.com/chefnext/chefnext/apps/api/internal/gen/audit/v1"
+	_ "github.com/chefnext/chefnext/apps/api/internal/gen/chefnext/v1"
+	v1 "github.com/chefnext/chefnext/apps/api/internal/gen/identity/v1"
+	v12 "github.com/chefnext/chefnext/apps/api/internal/gen/kyc/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// User is an account as seen by operators
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role          v1.UserRole            `protobuf:"varint,3,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
+	KycStatus     string                 `protobuf:"bytes,4,opt,name=kyc_status,json=kycStatus,proto3" json:"kyc_status,omitempty"`
+	EmailVerified bool                   `protobuf:"varint,5,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	// RFC 3339; empty unless suspended
+	SuspendedAt      string `protobuf:"bytes,6,opt,name=suspended_at,json=suspendedAt,proto3" json:"suspended_at,omitempty"`
+	SuspensionReason string `protobuf:"bytes,7,opt,name=suspension_reason,json=suspensionReason,proto3" json:"suspension_reason,omitempty"`
+	CreatedAt        string `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Every role granted to the user; role is the one they last had active
+	Roles         []v1.UserRole `protobuf:"varint,9,rep,packed,name=roles,proto3,enum=identity.v1.UserRole" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_admin_v1_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetRole() v1.UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return v1.UserRole(0)
+}
+
+func (x *User) GetKycStatus() string {
+	if x != nil {
+		return x.KycStatus
+	}
+	return ""
+}
+
+func (x *User) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *User) GetSuspendedAt() string {
+	if x != nil {
+		return x.SuspendedAt
+	}
+	return ""
+}
+
+func (x *User) GetSuspensionReason() string {
+	if x != nil {
+		return x.SuspensionReason
+	}
+	return ""
+}
+
+func (x *User) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *User) GetRoles() []v1.UserRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// SearchUsersRequest filters users; empty filters match everything
+type SearchUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Case-insensitive substring of the email address
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// Matches users granted the role, whether or not it is active
+	Role v1.UserRole `protobuf:"varint,2,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
+	// Only return suspended users
+	SuspendedOnly bool  `protobuf:"varint,3,opt,name=suspended_only,json=suspendedOnly,proto3" json:"suspended_only,omitempty"`
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersRequest) Reset() {
+	*x = SearchUsersRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersRequest) ProtoMessage() {}
+
+func (x *SearchUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersRequest.ProtoReflect.Descriptor instead.
+func (*SearchUsersRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchUsersRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SearchUsersRequest) GetRole() v1.UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return v1.UserRole(0)
+}
+
+func (x *SearchUsersRequest) GetSuspendedOnly() bool {
+	if x != nil {
+		return x.SuspendedOnly
+	}
+	return false
+}
+
+func (x *SearchUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchUsersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// SearchUsersResponse contains one page of users
+type SearchUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchUsersResponse) Reset() {
+	*x = SearchUsersResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersResponse) ProtoMessage() {}
+
+func (x *SearchUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersResponse.ProtoReflect.Descriptor instead.
+func (*SearchUsersResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SearchUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *SearchUsersResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// GetUserRequest identifies the user
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// GetUserResponse contains the user
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// ChangeUserRoleRequest contains the new role
+type ChangeUserRoleRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// CHEF or RESTAURANT
+	Role          v1.UserRole `protobuf:"varint,2,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
+	Reason        string      `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserRoleRequest) Reset() {
+	*x = ChangeUserRoleRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserRoleRequest) ProtoMessage() {}
+
+func (x *ChangeUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ChangeUserRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChangeUserRoleRequest) GetRole() v1.UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return v1.UserRole(0)
+}
+
+func (x *ChangeUserRoleRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ChangeUserRoleResponse contains the updated user
+type ChangeUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserRoleResponse) Reset() {
+	*x = ChangeUserRoleResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserRoleResponse) ProtoMessage() {}
+
+func (x *ChangeUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*ChangeUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChangeUserRoleResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// SuspendUserRequest identifies the user and why they are suspended
+type SuspendUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SuspendUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// SuspendUserResponse contains the updated user
+type SuspendUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserResponse) Reset() {
+	*x = SuspendUserResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserResponse) ProtoMessage() {}
+
+func (x *SuspendUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserResponse.ProtoReflect.Descriptor instead.
+func (*SuspendUserResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SuspendUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// ReactivateUserRequest identifies the user
+type ReactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserRequest) Reset() {
+	*x = ReactivateUserRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserRequest) ProtoMessage() {}
+
+func (x *ReactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ReactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReactivateUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ReactivateUserResponse contains the updated user
+type ReactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserResponse) Reset() {
+	*x = ReactivateUserResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserResponse) ProtoMessage() {}
+
+func (x *ReactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReactivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// ForceLogoutRequest identifies the user
+type ForceLogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceLogoutRequest) Reset() {
+	*x = ForceLogoutRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceLogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceLogoutRequest) ProtoMessage() {}
+
+func (x *ForceLogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceLogoutRequest.ProtoReflect.Descriptor instead.
+func (*ForceLogoutRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ForceLogoutRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ForceLogoutRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// ForceLogoutResponse confirms the sessions were revoked
+type ForceLogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ForceLogoutResponse) Reset() {
+	*x = ForceLogoutResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForceLogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceLogoutResponse) ProtoMessage() {}
+
+func (x *ForceLogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceLogoutResponse.ProtoReflect.Descriptor instead.
+func (*ForceLogoutResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ForceLogoutResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ListAuditEventsRequest optionally narrows the log to one resource, actor or
+// restaurant
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    string                 `protobuf:"bytes,1,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,3,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	RestaurantId  string                 `protobuf:"bytes,4,opt,name=restaurant_id,json=restaurantId,proto3" json:"restaurant_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListAuditEventsRequest) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetRestaurantId() string {
+	if x != nil {
+		return x.RestaurantId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// ListAuditEventsResponse contains one page of audit events
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*v11.AuditEvent      `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*v11.AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// ListKycSubmissionsRequest optionally narrows the queue to one status
+type ListKycSubmissionsRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Status        v12.KycSubmissionStatus `protobuf:"varint,1,opt,name=status,proto3,enum=kyc.v1.KycSubmissionStatus" json:"status,omitempty"`
+	Limit         int32                   `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                   `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKycSubmissionsRequest) Reset() {
+	*x = ListKycSubmissionsRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKycSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKycSubmissionsRequest) ProtoMessage() {}
+
+func (x *ListKycSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKycSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListKycSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListKycSubmissionsRequest) GetStatus() v12.KycSubmissionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v12.KycSubmissionStatus(0)
+}
+
+func (x *ListKycSubmissionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListKycSubmissionsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// ListKycSubmissionsResponse contains one page of submissions without documents
+type ListKycSubmissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submissions   []*v12.KycSubmission   `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListKycSubmissionsResponse) Reset() {
+	*x = ListKycSubmissionsResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKycSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKycSubmissionsResponse) ProtoMessage() {}
+
+func (x *ListKycSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKycSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListKycSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListKycSubmissionsResponse) GetSubmissions() []*v12.KycSubmission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *ListKycSubmissionsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// GetKycSubmissionRequest identifies the submission
+type GetKycSubmissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId  string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKycSubmissionRequest) Reset() {
+	*x = GetKycSubmissionRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKycSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKycSubmissionRequest) ProtoMessage() {}
+
+func (x *GetKycSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKycSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*GetKycSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetKycSubmissionRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+// GetKycSubmissionResponse contains the submission
+type GetKycSubmissionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submission    *v12.KycSubmission     `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKycSubmissionResponse) Reset() {
+	*x = GetKycSubmissionResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKycSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKycSubmissionResponse) ProtoMessage() {}
+
+func (x *GetKycSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKycSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*GetKycSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetKycSubmissionResponse) GetSubmission() *v12.KycSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+// GetKycDocumentRequest identifies the document
+type GetKycDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    string                 `protobuf:"bytes,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKycDocumentRequest) Reset() {
+	*x = GetKycDocumentRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKycDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKycDocumentRequest) ProtoMessage() {}
+
+func (x *GetKycDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKycDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GetKycDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetKycDocumentRequest) GetDocumentId() string {
+	if x != nil {
+		return x.DocumentId
+	}
+	return ""
+}
+
+// GetKycDocumentResponse contains the decrypted document
+type GetKycDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *v12.KycDocument       `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	Content       []byte                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetKycDocumentResponse) Reset() {
+	*x = GetKycDocumentResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKycDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKycDocumentResponse) ProtoMessage() {}
+
+func (x *GetKycDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKycDocumentResponse.ProtoReflect.Descriptor instead.
+func (*GetKycDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetKycDocumentResponse) GetDocument() *v12.KycDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *GetKycDocumentResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// ApproveKycRequest identifies the submission
+type ApproveKycRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	// Internal note, not shown to the user
+	Note          string `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveKycRequest) Reset() {
+	*x = ApproveKycRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveKycRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveKycRequest) ProtoMessage() {}
+
+func (x *ApproveKycRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveKycRequest.ProtoReflect.Descriptor instead.
+func (*ApproveKycRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ApproveKycRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *ApproveKycRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// ApproveKycResponse contains the reviewed submission
+type ApproveKycResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submission    *v12.KycSubmission     `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveKycResponse) Reset() {
+	*x = ApproveKycResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveKycResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveKycResponse) ProtoMessage() {}
+
+func (x *ApproveKycResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveKycResponse.ProtoReflect.Descriptor instead.
+func (*ApproveKycResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ApproveKycResponse) GetSubmission() *v12.KycSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+// RejectKycRequest lists why the submission was rejected
+type RejectKycRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	SubmissionId string                 `protobuf:"bytes,1,opt,name=submission_id,json=submissionId,proto3" json:"submission_id,omitempty"`
+	// Shown to the user; at least one is required
+	Reasons []string `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// Internal note, not shown to the user
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectKycRequest) Reset() {
+	*x = RejectKycRequest{}
+	mi := &file_admin_v1_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectKycRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectKycRequest) ProtoMessage() {}
+
+func (x *RejectKycRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectKycRequest.ProtoReflect.Descriptor instead.
+func (*RejectKycRequest) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RejectKycRequest) GetSubmissionId() string {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return ""
+}
+
+func (x *RejectKycRequest) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *RejectKycRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// RejectKycResponse contains the reviewed submission
+type RejectKycResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submission    *v12.KycSubmission     `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectKycResponse) Reset() {
+	*x = RejectKycResponse{}
+	mi := &file_admin_v1_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectKycResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectKycResponse) ProtoMessage() {}
+
+func (x *RejectKycResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_admin_v1_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectKycResponse.ProtoReflect.Descriptor instead.
+func (*RejectKycResponse) Descriptor() ([]byte, []int) {
+	return file_admin_v1_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RejectKycResponse) GetSubmission() *v12.KycSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+var File_admin_v1_admin_proto protoreflect.FileDescriptor
+
+const file_admin_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x14admin/v1/admin.proto\x12\badmin.v1\x1a\x14audit/v1/audit.proto\x1a\x1bbuf/validate/validate.proto\x1a\x16chefnext/v1/auth.proto\x1a\x16identity/v1/auth.proto\x1a\x10kyc/v1/kyc.proto\"\xb9\x02\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12)\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x15.identity.v1.UserRoleR\x04role\x12\x1d\n" +
+	"\n" +
+	"kyc_status\x18\x04 \x01(\tR\tkycStatus\x12%\n" +
+	"\x0eemail_verified\x18\x05 \x01(\bR\remailVerified\x12!\n" +
+	"\fsuspended_at\x18\x06 \x01(\tR\vsuspendedAt\x12+\n" +
+	"\x11suspension_reason\x18\a \x01(\tR\x10suspensionReason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12+\n" +
+	"\x05roles\x18\t \x03(\x0e2\x15.identity.v1.UserRoleR\x05roles\"\xb4\x01\n" +
+	"\x12SearchUsersRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x123\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x15.identity.v1.UserRoleB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04role\x12%\n" +
+	"\x0esuspended_only\x18\x03 \x01(\bR\rsuspendedOnly\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\"\\\n" +
+	"\x13SearchUsersResponse\x12$\n" +
+	"\x05users\x18\x01 \x03(\v2\x0e.admin.v1.UserR\x05users\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\"3\n" +
+	"\x0eGetUserRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"5\n" +
+	"\x0fGetUserResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.admin.v1.UserR\x04user\"\x93\x01\n" +
+	"\x15ChangeUserRoleRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x125\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x15.identity.v1.UserRoleB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04role\x12 \n" +
+	"\x06reason\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x06reason\"<\n" +
+	"\x16ChangeUserRoleResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.admin.v1.UserR\x04user\"Y\n" +
+	"\x12SuspendUserRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12 \n" +
+	"\x06reason\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x06reason\"9\n" +
+	"\x13SuspendUserResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.admin.v1.UserR\x04user\"\\\n" +
+	"\x15ReactivateUserRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12 \n" +
+	"\x06reason\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x06reason\"<\n" +
+	"\x16ReactivateUserResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.admin.v1.UserR\x04user\"Y\n" +
+	"\x12ForceLogoutRequest\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12 \n" +
+	"\x06reason\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x06reason\"/\n" +
+	"\x13ForceLogoutResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf4\x01\n" +
+	"\x16ListAuditEventsRequest\x12\x1f\n" +
+	"\vtarget_type\x18\x01 \x01(\tR\n" +
+	"targetType\x12(\n" +
+	"\ttarget_id\x18\x02 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\btargetId\x12/\n" +
+	"\ractor_user_id\x18\x03 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\vactorUserId\x120\n" +
+	"\rrestaurant_id\x18\x04 \x01(\tB\v\xbaH\b\xd8\x01\x01r\x03\xb0\x01\x01R\frestaurantId\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\"h\n" +
+	"\x17ListAuditEventsResponse\x12,\n" +
+	"\x06events\x18\x01 \x03(\v2\x14.audit.v1.AuditEventR\x06events\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\"\x88\x01\n" +
+	"\x19ListKycSubmissionsRequest\x12=\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x1b.kyc.v1.KycSubmissionStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"v\n" +
+	"\x1aListKycSubmissionsResponse\x127\n" +
+	"\vsubmissions\x18\x01 \x03(\v2\x15.kyc.v1.KycSubmissionR\vsubmissions\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x03R\n" +
+	"totalCount\"H\n" +
+	"\x17GetKycSubmissionRequest\x12-\n" +
+	"\rsubmission_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fsubmissionId\"Q\n" +
+	"\x18GetKycSubmissionResponse\x125\n" +
+	"\n" +
+	"submission\x18\x01 \x01(\v2\x15.kyc.v1.KycSubmissionR\n" +
+	"submission\"B\n" +
+	"\x15GetKycDocumentRequest\x12)\n" +
+	"\vdocument_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\n" +
+	"documentId\"c\n" +
+	"\x16GetKycDocumentResponse\x12/\n" +
+	"\bdocument\x18\x01 \x01(\v2\x13.kyc.v1.KycDocumentR\bdocument\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\fR\acontent\"`\n" +
+	"\x11ApproveKycRequest\x12-\n" +
+	"\rsubmission_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fsubmissionId\x12\x1c\n" +
+	"\x04note\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x04note\"K\n" +
+	"\x12ApproveKycResponse\x125\n" +
+	"\n" +
+	"submission\x18\x01 \x01(\v2\x15.kyc.v1.KycSubmissionR\n" +
+	"submission\"y\n" +
+	"\x10RejectKycRequest\x12-\n" +
+	"\rsubmission_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\fsubmissionId\x12\x18\n" +
+	"\areasons\x18\x02 \x03(\tR\areasons\x12\x1c\n" +
+	"\x04note\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x04note\"J\n" +
+	"\x11RejectKycResponse\x125\n" +
+	"\n" +
+	"submission\x18\x01 \x01(\v2\x15.kyc.v1.KycSubmissionR\n" +
+	"submission2\xd2\b\n" +
+	"\fAdminService\x12V\n" +
+	"\vSearchUsers\x12\x1c.admin.v1.SearchUsersRequest\x1a\x1d.admin.v1.SearchUsersResponse\"\n" +
+	"\x8a\xb5\x18\x03\x12\x01\x03\x90\x02\x01\x12J\n" +
+	"\aGetUser\x12\x18.admin.v1.GetUserRequest\x1a\x19.admin.v1.GetUserResponse\"\n" +
+	"\x8a\xb5\x18\x03\x12\x01\x03\x90\x02\x01\x12\\\n" +
+	"\x0eChangeUserRole\x12\x1f.admin.v1.ChangeUserRoleRequest\x1a .admin.v1.ChangeUserRoleResponse\"\a\x8a\xb5\x18\x03\x12\x01\x03\x12S\n" +
+	"\vSuspendUser\x12\x1c.admin.v1.SuspendUserRequest\x1a\x1d.admin.v1.SuspendUserResponse\"\a\x8a\xb5\x18\x03\x12\x01\x03\x12\\\n" +
+	"\x0eReactivateUser\x12\x1f.admin.v1.ReactivateUserRequest\x1a .admin.v1.ReactivateUserResponse\"\a\x8a\xb5\x18\x03\x12\x01\x03\x12S\n" +
+	"\vForceLogout\x12\x1c.admin.v1.ForceLogoutRequest\x1a\x1d.admin.v1.ForceLogoutResponse\"\a\x8a\xb5\x18\x03\x12\x01\x03\x12b\n" +
+	"\x0fListAuditEvents\x12 .admin.v1.ListAuditEventsRequest\x1a!.admin.v1.ListAuditEventsResponse\"\n" +
+	"\x8a\xb5\x18\x03\x12\x01\x03\x90\x02\x01\x12k\n" +
+	"\x12ListKycSubmissions\x12#.admin.v1.ListKycSubmissionsRequest\x1a$.admin.v1.ListKycSubmissionsResponse\"\n" +
+	"\x8a\xb5\x18\x03\x12\x01\x03\x90\x02\x01\x12e\n" +
+	"\x10GetKycSubmission\x12!.admin.v1.GetKycSubmissionRequest\x1a\".admin.v1.GetKycSubmissionResponse\"\n" +
+	"\x8a\xb5\x18\x03\x12\x01\x03\x90\x02\x01\x12_\n" +
+	"\x0eGetKycDocument\x12\x1f.admin.v1.GetKycDocumentRequest\x1a .admin.v1.GetKycDocumentResponse\"\n" +
+	"\x8a\xb5\x18\x03\x12\x01\x03\x90\x02\x01\x12P\n" +
+	"\n" +
+	"ApproveKyc\x12\x1b.admin.v1.ApproveKycRequest\x1a\x1c.admin.v1.ApproveKycResponse\"\a\x8a\xb5\x18\x03\x12\x01\x03\x12M\n" +
+	"\tRejectKyc\x12\x1a.admin.v1.RejectKycRequest\x1a\x1b.admin.v1.RejectKycResponse\"\a\x8a\xb5\x18\x03\x12\x01\x03B\xa0\x01\n" +
+	"\fcom.admin.v1B\n" +
+	"AdminProtoP\x01ZCgithub.com/chefnext/chefnext/apps/api/internal/gen/admin/v1;adminv1\xa2\x02\x03AXX\xaa\x02\bAdmin.V1\xca\x02\bAdmin\\V1\xe2\x02\x14Admin\\V1\\GPBMetadata\xea\x02\tAdmin::V1b\x06proto3"
+
+var (
+	file_admin_v1_admin_proto_rawDescOnce sync.Once
+	file_admin_v1_admin_proto_rawDescData []byte
+)
+
+func file_admin_v1_admin_proto_rawDescGZIP() []byte {
+	file_admin_v1_admin_proto_rawDescOnce.Do(func() {
+		file_admin_v1_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_admin_v1_admin_proto_rawDesc), len(file_admin_v1_admin_proto_rawDesc)))
+	})
+	return file_admin_v1_admin_proto_rawDescData
+}
+
+var file_admin_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_admin_v1_admin_proto_goTypes = []any{
+	(*User)(nil),                       // 0: admin.v1.User
+	(*SearchUsersRequest)(nil),         // 1: admin.v1.SearchUsersRequest
+	(*SearchUsersResponse)(nil),        // 2: admin.v1.SearchUsersResponse
+	(*GetUserRequest)(nil),             // 3: admin.v1.GetUserRequest
+	(*GetUserResponse)(nil),            // 4: admin.v1.GetUserResponse
+	(*ChangeUserRoleRequest)(nil),      // 5: admin.v1.ChangeUserRoleRequest
+	(*ChangeUserRoleResponse)(nil),     // 6: admin.v1.ChangeUserRoleResponse
+	(*SuspendUserRequest)(nil),         // 7: admin.v1.SuspendUserRequest
+	(*SuspendUserResponse)(nil),        // 8: admin.v1.SuspendUserResponse
+	(*ReactivateUserRequest)(nil),      // 9: admin.v1.ReactivateUserRequest
+	(*ReactivateUserResponse)(nil),     // 10: admin.v1.ReactivateUserResponse
+	(*ForceLogoutRequest)(nil),         // 11: admin.v1.ForceLogoutRequest
+	(*ForceLogoutResponse)(nil),        // 12: admin.v1.ForceLogoutResponse
+	(*ListAuditEventsRequest)(nil),     // 13: admin.v1.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),    // 14: admin.v1.ListAuditEventsResponse
+	(*ListKycSubmissionsRequest)(nil),  // 15: admin.v1.ListKycSubmissionsRequest
+	(*ListKycSubmissionsResponse)(nil), // 16: admin.v1.ListKycSubmissionsResponse
+	(*GetKycSubmissionRequest)(nil),    // 17: admin.v1.GetKycSubmissionRequest
+	(*GetKycSubmissionResponse)(nil),   // 18: admin.v1.GetKycSubmissionResponse
+	(*GetKycDocumentRequest)(nil),      // 19: admin.v1.GetKycDocumentRequest
+	(*GetKycDocumentResponse)(nil),     // 20: admin.v1.GetKycDocumentResponse
+	(*ApproveKycRequest)(nil),          // 21: admin.v1.ApproveKycRequest
+	(*ApproveKycResponse)(nil),         // 22: admin.v1.ApproveKycResponse
+	(*RejectKycRequest)(nil),           // 23: admin.v1.RejectKycRequest
+	(*RejectKycResponse)(nil),          // 24: admin.v1.RejectKycResponse
+	(v1.UserRole)(0),                   // 25: identity.v1.UserRole
+	(*v11.AuditEvent)(nil),             // 26: audit.v1.AuditEvent
+	(v12.KycSubmissionStatus)(0),       // 27: kyc.v1.KycSubmissionStatus
+	(*v12.KycSubmission)(nil),          // 28: kyc.v1.KycSubmission
+	(*v12.KycDocument)(nil),            // 29: kyc.v1.KycDocument
+}
+var file_admin_v1_admin_proto_depIdxs = []int32{
+	25, // 0: admin.v1.User.role:type_name -> identity.v1.UserRole
+	25, // 1: admin.v1.User.roles:type_name -> identity.v1.UserRole
+	25, // 2: admin.v1.SearchUsersRequest.role:type_name -> identity.v1.UserRole
+	0,  // 3: admin.v1.SearchUsersResponse.users:type_name -> admin.v1.User
+	0,  // 4: admin.v1.GetUserResponse.user:type_name -> admin.v1.User
+	25, // 5: admin.v1.ChangeUserRoleRequest.role:type_name -> identity.v1.UserRole
+	0,  // 6: admin.v1.ChangeUserRoleResponse.user:type_name -> admin.v1.User
+	0,  // 7: admin.v1.SuspendUserResponse.user:type_name -> admin.v1.User
+	0,  // 8: admin.v1.ReactivateUserResponse.user:type_name -> admin.v1.User
+	26, // 9: admin.v1.ListAuditEventsResponse.events:type_name -> audit.v1.AuditEvent
+	27, // 10: admin.v1.ListKycSubmissionsRequest.status:type_name -> kyc.v1.KycSubmissionStatus
+	28, // 11: admin.v1.ListKycSubmissionsResponse.submissions:type_name -> kyc.v1.KycSubmission
+	28, // 12: admin.v1.GetKycSubmissionResponse.submission:type_name -> kyc.v1.KycSubmission
+	29, // 13: admin.v1.GetKycDocumentResponse.document:type_name -> kyc.v1.KycDocument
+	28, // 14: admin.v1.ApproveKycResponse.submission:type_name -> kyc.v1.KycSubmission
+	28, // 15: admin.v1.RejectKycResponse.submission:type_name -> kyc.v1.KycSubmission
+	1,  // 16: admin.v1.AdminService.SearchUsers:input_type -> admin.v1.SearchUsersRequest
+	3,  // 17: admin.v1.AdminService.GetUser:input_type -> admin.v1.GetUserRequest
+	5,  // 18: admin.v1.AdminService.ChangeUserRole:input_type -> admin.v1.ChangeUserRoleRequest
+	7,  // 19: admin.v1.AdminService.SuspendUser:input_type -> admin.v1.SuspendUserRequest
+	9,  // 20: admin.v1.AdminService.ReactivateUser:input_type -> admin.v1.ReactivateUserRequest
+	11, // 21: admin.v1.AdminService.ForceLogout:input_type -> admin.v1.ForceLogoutRequest
+	13, // 22: admin.v1.AdminService.ListAuditEvents:input_type -> admin.v1.ListAuditEventsRequest
+	15, // 23: admin.v1.AdminService.ListKycSubmissions:input_type -> admin.v1.ListKycSubmissionsRequest
+	17, // 24: admin.v1.AdminService.GetKycSubmission:input_type -> admin.v1.GetKycSubmissionRequest
+	19, // 25: admin.v1.AdminService.GetKycDocument:input_type -> admin.v1.GetKycDocumentRequest
+	21, // 26: admin.v1.AdminService.ApproveKyc:input_type -> admin.v1.ApproveKycRequest
+	23, // 27: admin.v1.AdminService.RejectKyc:input_type -> admin.v1.RejectKycRequest
+	2,  // 28: admin.v1.AdminService.SearchUsers:output_type -> admin.v1.SearchUsersResponse
+	4,  // 29: admin.v1.AdminService.GetUser:output_type -> admin.v1.GetUserResponse
+	6,  // 30: admin.v1.AdminService.ChangeUserRole:output_type -> admin.v1.ChangeUserRoleResponse
+	8,  // 31: admin.v1.AdminService.SuspendUser:output_type -> admin.v1.SuspendUserResponse
+	10, // 32: admin.v1.AdminService.ReactivateUser:output_type -> admin.v1.ReactivateUserResponse
+	12, // 33: admin.v1.AdminService.ForceLogout:output_type -> admin.v1.ForceLogoutResponse
+	14, // 34: admin.v1.AdminService.ListAuditEvents:output_type -> admin.v1.ListAuditEventsResponse
+	16, // 35: admin.v1.AdminService.ListKycSubmissions:output_type -> admin.v1.ListKycSubmissionsResponse
+	18, // 36: admin.v1.AdminService.GetKycSubmission:output_type -> admin.v1.GetKycSubmissionResponse
+	20, // 37: admin.v1.AdminService.GetKycDocument:output_type -> admin.v1.GetKycDocumentResponse
+	22, // 38: admin.v1.AdminService.ApproveKyc:output_type -> admin.v1.ApproveKycResponse
+	24, // 39: admin.v1.AdminService.RejectKyc:output_type -> admin.v1.RejectKycResponse
+	28, // [28:40] is the sub-list for method output_type
+	16, // [16:28] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_admin_v1_admin_proto_init() }
+func file_admin_v1_admin_proto_init() {
+	if File_admin_v1_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_admin_v1_admin_proto_rawDesc), len(file_admin_v1_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   25,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_admin_v1_admin_proto_goTypes,
+		DependencyIndexes: file_admin_v1_admin_proto_depIdxs,
+		MessageInfos:      file_admin_v1_admin_proto_msgTypes,
+	}.Build()
+	File_admin_v1_admin_proto = out.File
+	file_admin_v1_admin_proto_goTypes = nil
+	file_admin_v1_admin_proto_depIdxs = nil
+}
@@ -0,0 +1,463 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: admin/v1/admin.proto
+
+package adminv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/chefnext/chefnext/apps/api/internal/gen/admin/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AdminServiceName is the fully-qualified name of the AdminService service.
+	AdminServiceName = "admin.v1.AdminService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AdminServiceSearchUsersProcedure is the fully-qualified name of the AdminService's SearchUsers
+	// RPC.
+	AdminServiceSearchUsersProcedure = "/admin.v1.AdminService/SearchUsers"
+	// AdminServiceGetUserProcedure is the fully-qualified name of the AdminService's GetUser RPC.
+	AdminServiceGetUserProcedure = "/admin.v1.AdminService/GetUser"
+	// AdminServiceChangeUserRoleProcedure is the fully-qualified name of the AdminService's
+	// ChangeUserRole RPC.
+	AdminServiceChangeUserRoleProcedure = "/admin.v1.AdminService/ChangeUserRole"
+	// AdminServiceSuspendUserProcedure is the fully-qualified name of the AdminService's SuspendUser
+	// RPC.
+	AdminServiceSuspendUserProcedure = "/admin.v1.AdminService/SuspendUser"
+	// AdminServiceReactivateUserProcedure is the fully-qualified name of the AdminService's
+	// ReactivateUser RPC.
+	AdminServiceReactivateUserProcedure = "/admin.v1.AdminService/ReactivateUser"
+	// AdminServiceForceLogoutProcedure is the fully-qualified name of the AdminService's ForceLogout
+	// RPC.
+	AdminServiceForceLogoutProcedure = "/admin.v1.AdminService/ForceLogout"
+	// AdminServiceListAuditEventsProcedure is the fully-qualified name of the AdminService's
+	// ListAuditEvents RPC.
+	AdminServiceListAuditEventsProcedure = "/admin.v1.AdminService/ListAuditEvents"
+	// AdminServiceListKycSubmissionsProcedure is the fully-qualified name of the AdminService's
+	// ListKycSubmissions RPC.
+	AdminServiceListKycSubmissionsProcedure = "/admin.v1.AdminService/ListKycSubmissions"
+	// AdminServiceGetKycSubmissionProcedure is the fully-qualified name of the AdminService's
+	// GetKycSubmission RPC.
+	AdminServiceGetKycSubmissionProcedure = "/admin.v1.AdminService/GetKycSubmission"
+	// AdminServiceGetKycDocumentProcedure is the fully-qualified name of the AdminService's
+	// GetKycDocument RPC.
+	AdminServiceGetKycDocumentProcedure = "/admin.v1.AdminService/GetKycDocument"
+	// AdminServiceApproveKycProcedure is the fully-qualified name of the AdminService's ApproveKyc RPC.
+	AdminServiceApproveKycProcedure = "/admin.v1.AdminService/ApproveKyc"
+	// AdminServiceRejectKycProcedure is the fully-qualified name of the AdminService's RejectKyc RPC.
+	AdminServiceRejectKycProcedure = "/admin.v1.AdminService/RejectKyc"
+)
+
+// AdminServiceClient is a client for the admin.v1.AdminService service.
+type AdminServiceClient interface {
+	// SearchUsers lists users matching the filters, newest first
+	SearchUsers(context.Context, *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error)
+	// GetUser returns a single user
+	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
+	// ChangeUserRole replaces every role of a user with CHEF or RESTAURANT
+	ChangeUserRole(context.Context, *connect.Request[v1.ChangeUserRoleRequest]) (*connect.Response[v1.ChangeUserRoleResponse], error)
+	// SuspendUser blocks a user from signing in and signs out all their sessions
+	SuspendUser(context.Context, *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.SuspendUserResponse], error)
+	// ReactivateUser lifts a suspension
+	ReactivateUser(context.Context, *connect.Request[v1.ReactivateUserRequest]) (*connect.Response[v1.ReactivateUserResponse], error)
+	// ForceLogout signs out all of a user's sessions
+	ForceLogout(context.Context, *connect.Request[v1.ForceLogoutRequest]) (*connect.Response[v1.ForceLogoutResponse], error)
+	// ListAuditEvents lists changes to every resource across the platform,
+	// including admin actions, newest first
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
+	// ListKycSubmissions lists KYC submissions, oldest first
+	ListKycSubmissions(context.Context, *connect.Request[v1.ListKycSubmissionsRequest]) (*connect.Response[v1.ListKycSubmissionsResponse], error)
+	// GetKycSubmission returns a submission with its documents
+	GetKycSubmission(context.Context, *connect.Request[v1.GetKycSubmissionRequest]) (*connect.Response[v1.GetKycSubmissionResponse], error)
+	// GetKycDocument returns the content of an uploaded document
+	GetKycDocument(context.Context, *connect.Request[v1.GetKycDocumentRequest]) (*connect.Response[v1.GetKycDocumentResponse], error)
+	// ApproveKyc verifies the submitting user
+	ApproveKyc(context.Context, *connect.Request[v1.ApproveKycRequest]) (*connect.Response[v1.ApproveKycResponse], error)
+	// RejectKyc rejects a submission with reasons shown to the user
+	RejectKyc(context.Context, *connect.Request[v1.RejectKycRequest]) (*connect.Response[v1.RejectKycResponse], error)
+}
+
+// NewAdminServiceClient constructs a client for the admin.v1.AdminService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AdminServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	adminServiceMethods := v1.File_admin_v1_admin_proto.Services().ByName("AdminService").Methods()
+	return &adminServiceClient{
+		searchUsers: connect.NewClient[v1.SearchUsersRequest, v1.SearchUsersResponse](
+			httpClient,
+			baseURL+AdminServiceSearchUsersProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SearchUsers")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getUser: connect.NewClient[v1.GetUserRequest, v1.GetUserResponse](
+			httpClient,
+			baseURL+AdminServiceGetUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetUser")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		changeUserRole: connect.NewClient[v1.ChangeUserRoleRequest, v1.ChangeUserRoleResponse](
+			httpClient,
+			baseURL+AdminServiceChangeUserRoleProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ChangeUserRole")),
+			connect.WithClientOptions(opts...),
+		),
+		suspendUser: connect.NewClient[v1.SuspendUserRequest, v1.SuspendUserResponse](
+			httpClient,
+			baseURL+AdminServiceSuspendUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("SuspendUser")),
+			connect.WithClientOptions(opts...),
+		),
+		reactivateUser: connect.NewClient[v1.ReactivateUserRequest, v1.ReactivateUserResponse](
+			httpClient,
+			baseURL+AdminServiceReactivateUserProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ReactivateUser")),
+			connect.WithClientOptions(opts...),
+		),
+		forceLogout: connect.NewClient[v1.ForceLogoutRequest, v1.ForceLogoutResponse](
+			httpClient,
+			baseURL+AdminServiceForceLogoutProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ForceLogout")),
+			connect.WithClientOptions(opts...),
+		),
+		listAuditEvents: connect.NewClient[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse](
+			httpClient,
+			baseURL+AdminServiceListAuditEventsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListAuditEvents")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listKycSubmissions: connect.NewClient[v1.ListKycSubmissionsRequest, v1.ListKycSubmissionsResponse](
+			httpClient,
+			baseURL+AdminServiceListKycSubmissionsProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ListKycSubmissions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getKycSubmission: connect.NewClient[v1.GetKycSubmissionRequest, v1.GetKycSubmissionResponse](
+			httpClient,
+			baseURL+AdminServiceGetKycSubmissionProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetKycSubmission")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getKycDocument: connect.NewClient[v1.GetKycDocumentRequest, v1.GetKycDocumentResponse](
+			httpClient,
+			baseURL+AdminServiceGetKycDocumentProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("GetKycDocument")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		approveKyc: connect.NewClient[v1.ApproveKycRequest, v1.ApproveKycResponse](
+			httpClient,
+			baseURL+AdminServiceApproveKycProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("ApproveKyc")),
+			connect.WithClientOptions(opts...),
+		),
+		rejectKyc: connect.NewClient[v1.RejectKycRequest, v1.RejectKycResponse](
+			httpClient,
+			baseURL+AdminServiceRejectKycProcedure,
+			connect.WithSchema(adminServiceMethods.ByName("RejectKyc")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// adminServiceClient implements AdminServiceClient.
+type adminServiceClient struct {
+	searchUsers        *connect.Client[v1.SearchUsersRequest, v1.SearchUsersResponse]
+	getUser            *connect.Client[v1.GetUserRequest, v1.GetUserResponse]
+	changeUserRole     *connect.Client[v1.ChangeUserRoleRequest, v1.ChangeUserRoleResponse]
+	suspendUser        *connect.Client[v1.SuspendUserRequest, v1.SuspendUserResponse]
+	reactivateUser     *connect.Client[v1.ReactivateUserRequest, v1.ReactivateUserResponse]
+	forceLogout        *connect.Client[v1.ForceLogoutRequest, v1.ForceLogoutResponse]
+	listAuditEvents    *connect.Client[v1.ListAuditEventsRequest, v1.ListAuditEventsResponse]
+	listKycSubmissions *connect.Client[v1.ListKycSubmissionsRequest, v1.ListKycSubmissionsResponse]
+	getKycSubmission   *connect.Client[v1.GetKycSubmissionRequest, v1.GetKycSubmissionResponse]
+	getKycDocument     *connect.Client[v1.GetKycDocumentRequest, v1.GetKycDocumentResponse]
+	approveKyc         *connect.Client[v1.ApproveKycRequest, v1.ApproveKycResponse]
+	rejectKyc          *connect.Client[v1.RejectKycRequest, v1.RejectKycResponse]
+}
+
+// SearchUsers calls admin.v1.AdminService.SearchUsers.
+func (c *adminServiceClient) SearchUsers(ctx context.Context, req *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error) {
+	return c.searchUsers.CallUnary(ctx, req)
+}
+
+// GetUser calls admin.v1.AdminService.GetUser.
+func (c *adminServiceClient) GetUser(ctx context.Context, req *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return c.getUser.CallUnary(ctx, req)
+}
+
+// ChangeUserRole calls admin.v1.AdminService.ChangeUserRole.
+func (c *adminServiceClient) ChangeUserRole(ctx context.Context, req *connect.Request[v1.ChangeUserRoleRequest]) (*connect.Response[v1.ChangeUserRoleResponse], error) {
+	return c.changeUserRole.CallUnary(ctx, req)
+}
+
+// SuspendUser calls admin.v1.AdminService.SuspendUser.
+func (c *adminServiceClient) SuspendUser(ctx context.Context, req *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.SuspendUserResponse], error) {
+	return c.suspendUser.CallUnary(ctx, req)
+}
+
+// ReactivateUser calls admin.v1.AdminService.ReactivateUser.
+func (c *adminServiceClient) ReactivateUser(ctx context.Context, req *connect.Request[v1.ReactivateUserRequest]) (*connect.Response[v1.ReactivateUserResponse], error) {
+	return c.reactivateUser.CallUnary(ctx, req)
+}
+
+// ForceLogout calls admin.v1.AdminService.ForceLogout.
+func (c *adminServiceClient) ForceLogout(ctx context.Context, req *connect.Request[v1.ForceLogoutRequest]) (*connect.Response[v1.ForceLogoutResponse], error) {
+	return c.forceLogout.CallUnary(ctx, req)
+}
+
+// ListAuditEvents calls admin.v1.AdminService.ListAuditEvents.
+func (c *adminServiceClient) ListAuditEvents(ctx context.Context, req *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return c.listAuditEvents.CallUnary(ctx, req)
+}
+
+// ListKycSubmissions calls admin.v1.AdminService.ListKycSubmissions.
+func (c *adminServiceClient) ListKycSubmissions(ctx context.Context, req *connect.Request[v1.ListKycSubmissionsRequest]) (*connect.Response[v1.ListKycSubmissionsResponse], error) {
+	return c.listKycSubmissions.CallUnary(ctx, req)
+}
+
+// GetKycSubmission calls admin.v1.AdminService.GetKycSubmission.
+func (c *adminServiceClient) GetKycSubmission(ctx context.Context, req *connect.Request[v1.GetKycSubmissionRequest]) (*connect.Response[v1.GetKycSubmissionResponse], error) {
+	return c.getKycSubmission.CallUnary(ctx, req)
+}
+
+// GetKycDocument calls admin.v1.AdminService.GetKycDocument.
+func (c *adminServiceClient) GetKycDocument(ctx context.Context, req *connect.Request[v1.GetKycDocumentRequest]) (*connect.Response[v1.GetKycDocumentResponse], error) {
+	return c.getKycDocument.CallUnary(ctx, req)
+}
+
+// ApproveKyc calls admin.v1.AdminService.ApproveKyc.
+func (c *adminServiceClient) ApproveKyc(ctx context.Context, req *connect.Request[v1.ApproveKycRequest]) (*connect.Response[v1.ApproveKycResponse], error) {
+	return c.approveKyc.CallUnary(ctx, req)
+}
+
+// RejectKyc calls admin.v1.AdminService.RejectKyc.
+func (c *adminServiceClient) RejectKyc(ctx context.Context, req *connect.Request[v1.RejectKycRequest]) (*connect.Response[v1.RejectKycResponse], error) {
+	return c.rejectKyc.CallUnary(ctx, req)
+}
+
+// AdminServiceHandler is an implementation of the admin.v1.AdminService service.
+type AdminServiceHandler interface {
+	// SearchUsers lists users matching the filters, newest first
+	SearchUsers(context.Context, *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error)
+	// GetUser returns a single user
+	GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error)
+	// ChangeUserRole replaces every role of a user with CHEF or RESTAURANT
+	ChangeUserRole(context.Context, *connect.Request[v1.ChangeUserRoleRequest]) (*connect.Response[v1.ChangeUserRoleResponse], error)
+	// SuspendUser blocks a user from signing in and signs out all their sessions
+	SuspendUser(context.Context, *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.SuspendUserResponse], error)
+	// ReactivateUser lifts a suspension
+	ReactivateUser(context.Context, *connect.Request[v1.ReactivateUserRequest]) (*connect.Response[v1.ReactivateUserResponse], error)
+	// ForceLogout signs out all of a user's sessions
+	ForceLogout(context.Context, *connect.Request[v1.ForceLogoutRequest]) (*connect.Response[v1.ForceLogoutResponse], error)
+	// ListAuditEvents lists changes to every resource across the platform,
+	// including admin actions, newest first
+	ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error)
+	// ListKycSubmissions lists KYC submissions, oldest first
+	ListKycSubmissions(context.Context, *connect.Request[v1.ListKycSubmissionsRequest]) (*connect.Response[v1.ListKycSubmissionsResponse], error)
+	// GetKycSubmission returns a submission with its documents
+	GetKycSubmission(context.Context, *connect.Request[v1.GetKycSubmissionRequest]) (*connect.Response[v1.GetKycSubmissionResponse], error)
+	// GetKycDocument returns the content of an uploaded document
+	GetKycDocument(context.Context, *connect.Request[v1.GetKycDocumentRequest]) (*connect.Response[v1.GetKycDocumentResponse], error)
+	// ApproveKyc verifies the submitting user
+	ApproveKyc(context.Context, *connect.Request[v1.ApproveKycRequest]) (*connect.Response[v1.ApproveKycResponse], error)
+	// RejectKyc rejects a submission with reasons shown to the user
+	RejectKyc(context.Context, *connect.Request[v1.RejectKycRequest]) (*connect.Response[v1.RejectKycResponse], error)
+}
+
+// NewAdminServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	adminServiceMethods := v1.File_admin_v1_admin_proto.Services().ByName("AdminService").Methods()
+	adminServiceSearchUsersHandler := connect.NewUnaryHandler(
+		AdminServiceSearchUsersProcedure,
+		svc.SearchUsers,
+		connect.WithSchema(adminServiceMethods.ByName("SearchUsers")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetUserHandler := connect.NewUnaryHandler(
+		AdminServiceGetUserProcedure,
+		svc.GetUser,
+		connect.WithSchema(adminServiceMethods.ByName("GetUser")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceChangeUserRoleHandler := connect.NewUnaryHandler(
+		AdminServiceChangeUserRoleProcedure,
+		svc.ChangeUserRole,
+		connect.WithSchema(adminServiceMethods.ByName("ChangeUserRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceSuspendUserHandler := connect.NewUnaryHandler(
+		AdminServiceSuspendUserProcedure,
+		svc.SuspendUser,
+		connect.WithSchema(adminServiceMethods.ByName("SuspendUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceReactivateUserHandler := connect.NewUnaryHandler(
+		AdminServiceReactivateUserProcedure,
+		svc.ReactivateUser,
+		connect.WithSchema(adminServiceMethods.ByName("ReactivateUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceForceLogoutHandler := connect.NewUnaryHandler(
+		AdminServiceForceLogoutProcedure,
+		svc.ForceLogout,
+		connect.WithSchema(adminServiceMethods.ByName("ForceLogout")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListAuditEventsHandler := connect.NewUnaryHandler(
+		AdminServiceListAuditEventsProcedure,
+		svc.ListAuditEvents,
+		connect.WithSchema(adminServiceMethods.ByName("ListAuditEvents")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceListKycSubmissionsHandler := connect.NewUnaryHandler(
+		AdminServiceListKycSubmissionsProcedure,
+		svc.ListKycSubmissions,
+		connect.WithSchema(adminServiceMethods.ByName("ListKycSubmissions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetKycSubmissionHandler := connect.NewUnaryHandler(
+		AdminServiceGetKycSubmissionProcedure,
+		svc.GetKycSubmission,
+		connect.WithSchema(adminServiceMethods.ByName("GetKycSubmission")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceGetKycDocumentHandler := connect.NewUnaryHandler(
+		AdminServiceGetKycDocumentProcedure,
+		svc.GetKycDocument,
+		connect.WithSchema(adminServiceMethods.ByName("GetKycDocument")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceApproveKycHandler := connect.NewUnaryHandler(
+		AdminServiceApproveKycProcedure,
+		svc.ApproveKyc,
+		connect.WithSchema(adminServiceMethods.ByName("ApproveKyc")),
+		connect.WithHandlerOptions(opts...),
+	)
+	adminServiceRejectKycHandler := connect.NewUnaryHandler(
+		AdminServiceRejectKycProcedure,
+		svc.RejectKyc,
+		connect.WithSchema(adminServiceMethods.ByName("RejectKyc")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/admin.v1.AdminService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AdminServiceSearchUsersProcedure:
+			adminServiceSearchUsersHandler.ServeHTTP(w, r)
+		case AdminServiceGetUserProcedure:
+			adminServiceGetUserHandler.ServeHTTP(w, r)
+		case AdminServiceChangeUserRoleProcedure:
+			adminServiceChangeUserRoleHandler.ServeHTTP(w, r)
+		case AdminServiceSuspendUserProcedure:
+			adminServiceSuspendUserHandler.ServeHTTP(w, r)
+		case AdminServiceReactivateUserProcedure:
+			adminServiceReactivateUserHandler.ServeHTTP(w, r)
+		case AdminServiceForceLogoutProcedure:
+			adminServiceForceLogoutHandler.ServeHTTP(w, r)
+		case AdminServiceListAuditEventsProcedure:
+			adminServiceListAuditEventsHandler.ServeHTTP(w, r)
+		case AdminServiceListKycSubmissionsProcedure:
+			adminServiceListKycSubmissionsHandler.ServeHTTP(w, r)
+		case AdminServiceGetKycSubmissionProcedure:
+			adminServiceGetKycSubmissionHandler.ServeHTTP(w, r)
+		case AdminServiceGetKycDocumentProcedure:
+			adminServiceGetKycDocumentHandler.ServeHTTP(w, r)
+		case AdminServiceApproveKycProcedure:
+			adminServiceApproveKycHandler.ServeHTTP(w, r)
+		case AdminServiceRejectKycProcedure:
+			adminServiceRejectKycHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAdminServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAdminServiceHandler struct{}
+
+func (UnimplementedAdminServiceHandler) SearchUsers(context.Context, *connect.Request[v1.SearchUsersRequest]) (*connect.Response[v1.SearchUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.SearchUsers is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetUser(context.Context, *connect.Request[v1.GetUserRequest]) (*connect.Response[v1.GetUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.GetUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ChangeUserRole(context.Context, *connect.Request[v1.ChangeUserRoleRequest]) (*connect.Response[v1.ChangeUserRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ChangeUserRole is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) SuspendUser(context.Context, *connect.Request[v1.SuspendUserRequest]) (*connect.Response[v1.SuspendUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.SuspendUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ReactivateUser(context.Context, *connect.Request[v1.ReactivateUserRequest]) (*connect.Response[v1.ReactivateUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ReactivateUser is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ForceLogout(context.Context, *connect.Request[v1.ForceLogoutRequest]) (*connect.Response[v1.ForceLogoutResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ForceLogout is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListAuditEvents(context.Context, *connect.Request[v1.ListAuditEventsRequest]) (*connect.Response[v1.ListAuditEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ListAuditEvents is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListKycSubmissions(context.Context, *connect.Request[v1.ListKycSubmissionsRequest]) (*connect.Response[v1.ListKycSubmissionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ListKycSubmissions is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetKycSubmission(context.Context, *connect.Request[v1.GetKycSubmissionRequest]) (*connect.Response[v1.GetKycSubmissionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.GetKycSubmission is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) GetKycDocument(context.Context, *connect.Request[v1.GetKycDocumentRequest]) (*connect.Response[v1.GetKycDocumentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.GetKycDocument is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ApproveKyc(context.Context, *connect.Request[v1.ApproveKycRequest]) (*connect.Response[v1.ApproveKycResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.ApproveKyc is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RejectKyc(context.Context, *connect.Request[v1.RejectKycRequest]) (*connect.Response[v1.RejectKycResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("admin.v1.AdminService.RejectKyc is not implemented"))
+}
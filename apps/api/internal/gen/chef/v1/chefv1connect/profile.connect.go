@@ -80,12 +80,14 @@ func NewChefProfileServiceClient(httpClient connect.HTTPClient, baseURL string,
 			httpClient,
 			baseURL+ChefProfileServiceGetProfileProcedure,
 			connect.WithSchema(chefProfileServiceMethods.ByName("GetProfile")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getMyProfile: connect.NewClient[v1.GetMyProfileRequest, v1.GetMyProfileResponse](
 			httpClient,
 			baseURL+ChefProfileServiceGetMyProfileProcedure,
 			connect.WithSchema(chefProfileServiceMethods.ByName("GetMyProfile")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		updateProfile: connect.NewClient[v1.UpdateProfileRequest, v1.UpdateProfileResponse](
@@ -98,6 +100,7 @@ func NewChefProfileServiceClient(httpClient connect.HTTPClient, baseURL string,
 			httpClient,
 			baseURL+ChefProfileServiceSearchProfilesProcedure,
 			connect.WithSchema(chefProfileServiceMethods.ByName("SearchProfiles")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -163,12 +166,14 @@ func NewChefProfileServiceHandler(svc ChefProfileServiceHandler, opts ...connect
 		ChefProfileServiceGetProfileProcedure,
 		svc.GetProfile,
 		connect.WithSchema(chefProfileServiceMethods.ByName("GetProfile")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	chefProfileServiceGetMyProfileHandler := connect.NewUnaryHandler(
 		ChefProfileServiceGetMyProfileProcedure,
 		svc.GetMyProfile,
 		connect.WithSchema(chefProfileServiceMethods.ByName("GetMyProfile")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	chefProfileServiceUpdateProfileHandler := connect.NewUnaryHandler(
@@ -181,6 +186,7 @@ func NewChefProfileServiceHandler(svc ChefProfileServiceHandler, opts ...connect
 		ChefProfileServiceSearchProfilesProcedure,
 		svc.SearchProfiles,
 		connect.WithSchema(chefProfileServiceMethods.ByName("SearchProfiles")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/chef.v1.ChefProfileService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
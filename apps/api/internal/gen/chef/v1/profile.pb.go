@@ -7,6 +7,8 @@
 package chefv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/chefnext/chefnext/apps/api/internal/gen/chefnext/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -265,11 +267,12 @@ type CreateProfileRequest struct {
 	Languages       []string               `protobuf:"bytes,8,rep,name=languages,proto3" json:"languages,omitempty"`
 	Bio             string                 `protobuf:"bytes,9,opt,name=bio,proto3" json:"bio,omitempty"`
 	LearningFocus   []string               `protobuf:"bytes,10,rep,name=learning_focus,json=learningFocus,proto3" json:"learning_focus,omitempty"`
-	SkillTreeJson   string                 `protobuf:"bytes,11,opt,name=skill_tree_json,json=skillTreeJson,proto3" json:"skill_tree_json,omitempty"`
-	PortfolioItems  []*PortfolioItem       `protobuf:"bytes,12,rep,name=portfolio_items,json=portfolioItems,proto3" json:"portfolio_items,omitempty"`
-	FullName        string                 `protobuf:"bytes,13,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Must be valid JSON; checked by the service
+	SkillTreeJson  string           `protobuf:"bytes,11,opt,name=skill_tree_json,json=skillTreeJson,proto3" json:"skill_tree_json,omitempty"`
+	PortfolioItems []*PortfolioItem `protobuf:"bytes,12,rep,name=portfolio_items,json=portfolioItems,proto3" json:"portfolio_items,omitempty"`
+	FullName       string           `protobuf:"bytes,13,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateProfileRequest) Reset() {
@@ -605,6 +608,8 @@ func (x *GetMyProfileResponse) GetProfile() *ChefProfile {
 	return nil
 }
 
+// UpdateProfileRequest changes the given fields; empty values and lists leave
+// a field unchanged
 type UpdateProfileRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	ProfileId       string                 `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
@@ -618,11 +623,12 @@ type UpdateProfileRequest struct {
 	Languages       []string               `protobuf:"bytes,9,rep,name=languages,proto3" json:"languages,omitempty"`
 	Bio             string                 `protobuf:"bytes,10,opt,name=bio,proto3" json:"bio,omitempty"`
 	LearningFocus   []string               `protobuf:"bytes,11,rep,name=learning_focus,json=learningFocus,proto3" json:"learning_focus,omitempty"`
-	SkillTreeJson   string                 `protobuf:"bytes,12,opt,name=skill_tree_json,json=skillTreeJson,proto3" json:"skill_tree_json,omitempty"`
-	PortfolioItems  []*PortfolioItem       `protobuf:"bytes,13,rep,name=portfolio_items,json=portfolioItems,proto3" json:"portfolio_items,omitempty"`
-	FullName        string                 `protobuf:"bytes,14,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Must be valid JSON; checked by the service
+	SkillTreeJson  string           `protobuf:"bytes,12,opt,name=skill_tree_json,json=skillTreeJson,proto3" json:"skill_tree_json,omitempty"`
+	PortfolioItems []*PortfolioItem `protobuf:"bytes,13,rep,name=portfolio_items,json=portfolioItems,proto3" json:"portfolio_items,omitempty"`
+	FullName       string           `protobuf:"bytes,14,opt,name=full_name,json=fullName,proto3" json:"full_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateProfileRequest) Reset() {
@@ -913,7 +919,7 @@ var File_chef_v1_profile_proto protoreflect.FileDescriptor
 
 const file_chef_v1_profile_proto_rawDesc = "" +
 	"\n" +
-	"\x15chef/v1/profile.proto\x12\achef.v1\"\xb3\x04\n" +
+	"\x15chef/v1/profile.proto\x12\achef.v1\x1a\x1bbuf/validate/validate.proto\x1a\x16chefnext/v1/auth.proto\"\xb3\x04\n" +
 	"\vChefProfile\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
@@ -935,72 +941,83 @@ const file_chef_v1_profile_proto_rawDesc = "" +
 	"created_at\x18\x0f \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\x10 \x01(\tR\tupdatedAt\x12\x1b\n" +
-	"\tfull_name\x18\x11 \x01(\tR\bfullName\"K\n" +
+	"\tfull_name\x18\x11 \x01(\tR\bfullName\"_\n" +
 	"\rPortfolioItem\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
-	"\x03url\x18\x02 \x01(\tR\x03url\x12\x18\n" +
-	"\acaption\x18\x03 \x01(\tR\acaption\"\xd5\x03\n" +
-	"\x14CreateProfileRequest\x12\x1a\n" +
-	"\bheadline\x18\x01 \x01(\tR\bheadline\x12\x18\n" +
-	"\asummary\x18\x02 \x01(\tR\asummary\x12\x1a\n" +
-	"\blocation\x18\x03 \x01(\tR\blocation\x12)\n" +
-	"\x10years_experience\x18\x04 \x01(\x05R\x0fyearsExperience\x12\"\n" +
-	"\favailability\x18\x05 \x01(\tR\favailability\x12 \n" +
-	"\vspecialties\x18\x06 \x03(\tR\vspecialties\x12\x1d\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\x03url\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x80\x10R\x03url\x12\"\n" +
+	"\acaption\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01R\acaption\"\xf5\x04\n" +
+	"\x14CreateProfileRequest\x12#\n" +
+	"\bheadline\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18xR\bheadline\x12\"\n" +
+	"\asummary\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xd0\x0fR\asummary\x12#\n" +
+	"\blocation\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18dR\blocation\x124\n" +
+	"\x10years_experience\x18\x04 \x01(\x05B\t\xbaH\x06\x1a\x04\x18P(\x00R\x0fyearsExperience\x12+\n" +
+	"\favailability\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18dR\favailability\x122\n" +
+	"\vspecialties\x18\x06 \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x182R\vspecialties\x12/\n" +
 	"\n" +
-	"work_areas\x18\a \x03(\tR\tworkAreas\x12\x1c\n" +
-	"\tlanguages\x18\b \x03(\tR\tlanguages\x12\x10\n" +
-	"\x03bio\x18\t \x01(\tR\x03bio\x12%\n" +
+	"work_areas\x18\a \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x182R\tworkAreas\x12.\n" +
+	"\tlanguages\x18\b \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\n" +
+	"\"\x06r\x04\x10\x01\x182R\tlanguages\x12\x1a\n" +
+	"\x03bio\x18\t \x01(\tB\b\xbaH\x05r\x03\x18\x88'R\x03bio\x127\n" +
 	"\x0elearning_focus\x18\n" +
-	" \x03(\tR\rlearningFocus\x12&\n" +
-	"\x0fskill_tree_json\x18\v \x01(\tR\rskillTreeJson\x12?\n" +
-	"\x0fportfolio_items\x18\f \x03(\v2\x16.chef.v1.PortfolioItemR\x0eportfolioItems\x12\x1b\n" +
-	"\tfull_name\x18\r \x01(\tR\bfullName\"G\n" +
+	" \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x182R\rlearningFocus\x121\n" +
+	"\x0fskill_tree_json\x18\v \x01(\tB\t\xbaH\x06r\x04(\x80\x80\x04R\rskillTreeJson\x12I\n" +
+	"\x0fportfolio_items\x18\f \x03(\v2\x16.chef.v1.PortfolioItemB\b\xbaH\x05\x92\x01\x02\x10\x14R\x0eportfolioItems\x12$\n" +
+	"\tfull_name\x18\r \x01(\tB\a\xbaH\x04r\x02\x18dR\bfullName\"G\n" +
 	"\x15CreateProfileResponse\x12.\n" +
-	"\aprofile\x18\x01 \x01(\v2\x14.chef.v1.ChefProfileR\aprofile\"2\n" +
-	"\x11GetProfileRequest\x12\x1d\n" +
+	"\aprofile\x18\x01 \x01(\v2\x14.chef.v1.ChefProfileR\aprofile\"<\n" +
+	"\x11GetProfileRequest\x12'\n" +
 	"\n" +
-	"profile_id\x18\x01 \x01(\tR\tprofileId\"D\n" +
+	"profile_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprofileId\"D\n" +
 	"\x12GetProfileResponse\x12.\n" +
 	"\aprofile\x18\x01 \x01(\v2\x14.chef.v1.ChefProfileR\aprofile\"\x15\n" +
 	"\x13GetMyProfileRequest\"F\n" +
 	"\x14GetMyProfileResponse\x12.\n" +
-	"\aprofile\x18\x01 \x01(\v2\x14.chef.v1.ChefProfileR\aprofile\"\xf4\x03\n" +
-	"\x14UpdateProfileRequest\x12\x1d\n" +
+	"\aprofile\x18\x01 \x01(\v2\x14.chef.v1.ChefProfileR\aprofile\"\x9e\x05\n" +
+	"\x14UpdateProfileRequest\x12'\n" +
 	"\n" +
-	"profile_id\x18\x01 \x01(\tR\tprofileId\x12\x1a\n" +
-	"\bheadline\x18\x02 \x01(\tR\bheadline\x12\x18\n" +
-	"\asummary\x18\x03 \x01(\tR\asummary\x12\x1a\n" +
-	"\blocation\x18\x04 \x01(\tR\blocation\x12)\n" +
-	"\x10years_experience\x18\x05 \x01(\x05R\x0fyearsExperience\x12\"\n" +
-	"\favailability\x18\x06 \x01(\tR\favailability\x12 \n" +
-	"\vspecialties\x18\a \x03(\tR\vspecialties\x12\x1d\n" +
+	"profile_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprofileId\x12#\n" +
+	"\bheadline\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18xR\bheadline\x12\"\n" +
+	"\asummary\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\xd0\x0fR\asummary\x12#\n" +
+	"\blocation\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18dR\blocation\x124\n" +
+	"\x10years_experience\x18\x05 \x01(\x05B\t\xbaH\x06\x1a\x04\x18P(\x00R\x0fyearsExperience\x12+\n" +
+	"\favailability\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x18dR\favailability\x122\n" +
+	"\vspecialties\x18\a \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x182R\vspecialties\x12/\n" +
 	"\n" +
-	"work_areas\x18\b \x03(\tR\tworkAreas\x12\x1c\n" +
-	"\tlanguages\x18\t \x03(\tR\tlanguages\x12\x10\n" +
+	"work_areas\x18\b \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x182R\tworkAreas\x12.\n" +
+	"\tlanguages\x18\t \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\n" +
+	"\"\x06r\x04\x10\x01\x182R\tlanguages\x12\x1a\n" +
 	"\x03bio\x18\n" +
-	" \x01(\tR\x03bio\x12%\n" +
-	"\x0elearning_focus\x18\v \x03(\tR\rlearningFocus\x12&\n" +
-	"\x0fskill_tree_json\x18\f \x01(\tR\rskillTreeJson\x12?\n" +
-	"\x0fportfolio_items\x18\r \x03(\v2\x16.chef.v1.PortfolioItemR\x0eportfolioItems\x12\x1b\n" +
-	"\tfull_name\x18\x0e \x01(\tR\bfullName\"G\n" +
+	" \x01(\tB\b\xbaH\x05r\x03\x18\x88'R\x03bio\x127\n" +
+	"\x0elearning_focus\x18\v \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x182R\rlearningFocus\x121\n" +
+	"\x0fskill_tree_json\x18\f \x01(\tB\t\xbaH\x06r\x04(\x80\x80\x04R\rskillTreeJson\x12I\n" +
+	"\x0fportfolio_items\x18\r \x03(\v2\x16.chef.v1.PortfolioItemB\b\xbaH\x05\x92\x01\x02\x10\x14R\x0eportfolioItems\x12$\n" +
+	"\tfull_name\x18\x0e \x01(\tB\a\xbaH\x04r\x02\x18dR\bfullName\"G\n" +
 	"\x15UpdateProfileResponse\x12.\n" +
-	"\aprofile\x18\x01 \x01(\v2\x14.chef.v1.ChefProfileR\aprofile\"\x86\x01\n" +
-	"\x15SearchProfilesRequest\x12 \n" +
-	"\vspecialties\x18\x01 \x03(\tR\vspecialties\x12\x1d\n" +
+	"\aprofile\x18\x01 \x01(\v2\x14.chef.v1.ChefProfileR\aprofile\"\x9a\x01\n" +
+	"\x15SearchProfilesRequest\x12*\n" +
+	"\vspecialties\x18\x01 \x03(\tB\b\xbaH\x05\x92\x01\x02\x10\x14R\vspecialties\x12'\n" +
 	"\n" +
-	"work_areas\x18\x02 \x03(\tR\tworkAreas\x12\x14\n" +
+	"work_areas\x18\x02 \x03(\tB\b\xbaH\x05\x92\x01\x02\x10\x14R\tworkAreas\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x04 \x01(\x05R\x06offset\"J\n" +
 	"\x16SearchProfilesResponse\x120\n" +
-	"\bprofiles\x18\x01 \x03(\v2\x14.chef.v1.ChefProfileR\bprofiles2\x9b\x03\n" +
-	"\x12ChefProfileService\x12N\n" +
-	"\rCreateProfile\x12\x1d.chef.v1.CreateProfileRequest\x1a\x1e.chef.v1.CreateProfileResponse\x12E\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x14.chef.v1.ChefProfileR\bprofiles2\xcb\x03\n" +
+	"\x12ChefProfileService\x12W\n" +
+	"\rCreateProfile\x12\x1d.chef.v1.CreateProfileRequest\x1a\x1e.chef.v1.CreateProfileResponse\"\a\x8a\xb5\x18\x03\x12\x01\x01\x12N\n" +
 	"\n" +
-	"GetProfile\x12\x1a.chef.v1.GetProfileRequest\x1a\x1b.chef.v1.GetProfileResponse\x12K\n" +
-	"\fGetMyProfile\x12\x1c.chef.v1.GetMyProfileRequest\x1a\x1d.chef.v1.GetMyProfileResponse\x12N\n" +
-	"\rUpdateProfile\x12\x1d.chef.v1.UpdateProfileRequest\x1a\x1e.chef.v1.UpdateProfileResponse\x12Q\n" +
-	"\x0eSearchProfiles\x12\x1e.chef.v1.SearchProfilesRequest\x1a\x1f.chef.v1.SearchProfilesResponseB\x9b\x01\n" +
+	"GetProfile\x12\x1a.chef.v1.GetProfileRequest\x1a\x1b.chef.v1.GetProfileResponse\"\a\x8a\xb5\x18\x00\x90\x02\x01\x12W\n" +
+	"\fGetMyProfile\x12\x1c.chef.v1.GetMyProfileRequest\x1a\x1d.chef.v1.GetMyProfileResponse\"\n" +
+	"\x8a\xb5\x18\x03\x12\x01\x01\x90\x02\x01\x12W\n" +
+	"\rUpdateProfile\x12\x1d.chef.v1.UpdateProfileRequest\x1a\x1e.chef.v1.UpdateProfileResponse\"\a\x8a\xb5\x18\x03\x12\x01\x01\x12Z\n" +
+	"\x0eSearchProfiles\x12\x1e.chef.v1.SearchProfilesRequest\x1a\x1f.chef.v1.SearchProfilesResponse\"\a\x8a\xb5\x18\x00\x90\x02\x01B\x9b\x01\n" +
 	"\vcom.chef.v1B\fProfileProtoP\x01ZAgithub.com/chefnext/chefnext/apps/api/internal/gen/chef/v1;chefv1\xa2\x02\x03CXX\xaa\x02\aChef.V1\xca\x02\aChef\\V1\xe2\x02\x13Chef\\V1\\GPBMetadata\xea\x02\bChef::V1b\x06proto3"
 
 var (
@@ -0,0 +1,262 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: chefnext/v1/auth.proto
+
+package chefnextv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Role is a role a caller can act as
+type Role int32
+
+const (
+	Role_ROLE_UNSPECIFIED Role = 0
+	Role_ROLE_CHEF        Role = 1
+	Role_ROLE_RESTAURANT  Role = 2
+	Role_ROLE_ADMIN       Role = 3
+)
+
+// Enum value maps for Role.
+var (
+	Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "ROLE_CHEF",
+		2: "ROLE_RESTAURANT",
+		3: "ROLE_ADMIN",
+	}
+	Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"ROLE_CHEF":        1,
+		"ROLE_RESTAURANT":  2,
+		"ROLE_ADMIN":       3,
+	}
+)
+
+func (x Role) Enum() *Role {
+	p := new(Role)
+	*p = x
+	return p
+}
+
+func (x Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_chefnext_v1_auth_proto_enumTypes[0].Descriptor()
+}
+
+func (Role) Type() protoreflect.EnumType {
+	return &file_chefnext_v1_auth_proto_enumTypes[0]
+}
+
+func (x Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Role.Descriptor instead.
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return file_chefnext_v1_auth_proto_rawDescGZIP(), []int{0}
+}
+
+// AuthPolicy declares who may call an RPC. Every RPC must carry one; the API
+// refuses to start otherwise. An empty policy allows any signed-in user.
+type AuthPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// public RPCs are callable without credentials
+	Public bool `protobuf:"varint,1,opt,name=public,proto3" json:"public,omitempty"`
+	// roles the caller's active role must be one of; empty allows every role
+	Roles []Role `protobuf:"varint,2,rep,packed,name=roles,proto3,enum=chefnext.v1.Role" json:"roles,omitempty"`
+	// api_key_scope is the scope an API key needs to call the RPC; RPCs without
+	// one reject API keys
+	ApiKeyScope string `protobuf:"bytes,3,opt,name=api_key_scope,json=apiKeyScope,proto3" json:"api_key_scope,omitempty"`
+	// allow_before_mfa keeps the RPC reachable for users whose role requires MFA
+	// before they have set it up
+	AllowBeforeMfa bool `protobuf:"varint,4,opt,name=allow_before_mfa,json=allowBeforeMfa,proto3" json:"allow_before_mfa,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AuthPolicy) Reset() {
+	*x = AuthPolicy{}
+	mi := &file_chefnext_v1_auth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthPolicy) ProtoMessage() {}
+
+func (x *AuthPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_chefnext_v1_auth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthPolicy.ProtoReflect.Descriptor instead.
+func (*AuthPolicy) Descriptor() ([]byte, []int) {
+	return file_chefnext_v1_auth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuthPolicy) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *AuthPolicy) GetRoles() []Role {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *AuthPolicy) GetApiKeyScope() string {
+	if x != nil {
+		return x.ApiKeyScope
+	}
+	return ""
+}
+
+func (x *AuthPolicy) GetAllowBeforeMfa() bool {
+	if x != nil {
+		return x.AllowBeforeMfa
+	}
+	return false
+}
+
+var file_chefnext_v1_auth_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*AuthPolicy)(nil),
+		Field:         50001,
+		Name:          "chefnext.v1.auth",
+		Tag:           "bytes,50001,opt,name=auth",
+		Filename:      "chefnext/v1/auth.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         50002,
+		Name:          "chefnext.v1.sensitive_response",
+		Tag:           "varint,50002,opt,name=sensitive_response",
+		Filename:      "chefnext/v1/auth.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// auth is read by the API's auth interceptor for every call
+	//
+	// optional chefnext.v1.AuthPolicy auth = 50001;
+	E_Auth = &file_chefnext_v1_auth_proto_extTypes[0]
+	// sensitive_response marks RPCs that return tokens or secrets. The API never
+	// stores their responses to replay retries, so Idempotency-Key is ignored
+	//
+	// optional bool sensitive_response = 50002;
+	E_SensitiveResponse = &file_chefnext_v1_auth_proto_extTypes[1]
+)
+
+var File_chefnext_v1_auth_proto protoreflect.FileDescriptor
+
+const file_chefnext_v1_auth_proto_rawDesc = "" +
+	"\n" +
+	"\x16chefnext/v1/auth.proto\x12\vchefnext.v1\x1a google/protobuf/descriptor.proto\"\x9b\x01\n" +
+	"\n" +
+	"AuthPolicy\x12\x16\n" +
+	"\x06public\x18\x01 \x01(\bR\x06public\x12'\n" +
+	"\x05roles\x18\x02 \x03(\x0e2\x11.chefnext.v1.RoleR\x05roles\x12\"\n" +
+	"\rapi_key_scope\x18\x03 \x01(\tR\vapiKeyScope\x12(\n" +
+	"\x10allow_before_mfa\x18\x04 \x01(\bR\x0eallowBeforeMfa*P\n" +
+	"\x04Role\x12\x14\n" +
+	"\x10ROLE_UNSPECIFIED\x10\x00\x12\r\n" +
+	"\tROLE_CHEF\x10\x01\x12\x13\n" +
+	"\x0fROLE_RESTAURANT\x10\x02\x12\x0e\n" +
+	"\n" +
+	"ROLE_ADMIN\x10\x03:M\n" +
+	"\x04auth\x12\x1e.google.protobuf.MethodOptions\x18ц\x03 \x01(\v2\x17.chefnext.v1.AuthPolicyR\x04auth:O\n" +
+	"\x12sensitive_response\x12\x1e.google.protobuf.MethodOptions\x18҆\x03 \x01(\bR\x11sensitiveResponseB\xb4\x01\n" +
+	"\x0fcom.chefnext.v1B\tAuthProtoP\x01ZIgithub.com/chefnext/chefnext/apps/api/internal/gen/chefnext/v1;chefnextv1\xa2\x02\x03CXX\xaa\x02\vChefnext.V1\xca\x02\vChefnext\\V1\xe2\x02\x17Chefnext\\V1\\GPBMetadata\xea\x02\fChefnext::V1b\x06proto3"
+
+var (
+	file_chefnext_v1_auth_proto_rawDescOnce sync.Once
+	file_chefnext_v1_auth_proto_rawDescData []byte
+)
+
+func file_chefnext_v1_auth_proto_rawDescGZIP() []byte {
+	file_chefnext_v1_auth_proto_rawDescOnce.Do(func() {
+		file_chefnext_v1_auth_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_chefnext_v1_auth_proto_rawDesc), len(file_chefnext_v1_auth_proto_rawDesc)))
+	})
+	return file_chefnext_v1_auth_proto_rawDescData
+}
+
+var file_chefnext_v1_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_chefnext_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_chefnext_v1_auth_proto_goTypes = []any{
+	(Role)(0),                          // 0: chefnext.v1.Role
+	(*AuthPolicy)(nil),                 // 1: chefnext.v1.AuthPolicy
+	(*descriptorpb.MethodOptions)(nil), // 2: google.protobuf.MethodOptions
+}
+var file_chefnext_v1_auth_proto_depIdxs = []int32{
+	0, // 0: chefnext.v1.AuthPolicy.roles:type_name -> chefnext.v1.Role
+	2, // 1: chefnext.v1.auth:extendee -> google.protobuf.MethodOptions
+	2, // 2: chefnext.v1.sensitive_response:extendee -> google.protobuf.MethodOptions
+	1, // 3: chefnext.v1.auth:type_name -> chefnext.v1.AuthPolicy
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	3, // [3:4] is the sub-list for extension type_name
+	1, // [1:3] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_chefnext_v1_auth_proto_init() }
+func file_chefnext_v1_auth_proto_init() {
+	if File_chefnext_v1_auth_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_chefnext_v1_auth_proto_rawDesc), len(file_chefnext_v1_auth_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 2,
+			NumServices:   0,
+		},
+		GoTypes:           file_chefnext_v1_auth_proto_goTypes,
+		DependencyIndexes: file_chefnext_v1_auth_proto_depIdxs,
+		EnumInfos:         file_chefnext_v1_auth_proto_enumTypes,
+		MessageInfos:      file_chefnext_v1_auth_proto_msgTypes,
+		ExtensionInfos:    file_chefnext_v1_auth_proto_extTypes,
+	}.Build()
+	File_chefnext_v1_auth_proto = out.File
+	file_chefnext_v1_auth_proto_goTypes = nil
+	file_chefnext_v1_auth_proto_depIdxs = nil
+}
@@ -7,6 +7,8 @@
 package identityv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/chefnext/chefnext/apps/api/internal/gen/chefnext/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -28,6 +30,8 @@ const (
 	UserRole_USER_ROLE_UNSPECIFIED UserRole = 0
 	UserRole_USER_ROLE_CHEF        UserRole = 1
 	UserRole_USER_ROLE_RESTAURANT  UserRole = 2
+	// Platform operators; only granted out-of-band with cmd/admin
+	UserRole_USER_ROLE_ADMIN UserRole = 3
 )
 
 // Enum value maps for UserRole.
@@ -36,11 +40,13 @@ var (
 		0: "USER_ROLE_UNSPECIFIED",
 		1: "USER_ROLE_CHEF",
 		2: "USER_ROLE_RESTAURANT",
+		3: "USER_ROLE_ADMIN",
 	}
 	UserRole_value = map[string]int32{
 		"USER_ROLE_UNSPECIFIED": 0,
 		"USER_ROLE_CHEF":        1,
 		"USER_ROLE_RESTAURANT":  2,
+		"USER_ROLE_ADMIN":       3,
 	}
 )
 
@@ -73,10 +79,12 @@ func (UserRole) EnumDescriptor() ([]byte, []int) {
 
 // RegisterRequest contains user registration information
 type RegisterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Role          UserRole               `protobuf:"varint,3,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Email    string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Role     UserRole               `protobuf:"varint,3,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
+	// Optional human-readable name of the device, e.g. "Kitchen iPad"
+	DeviceLabel   string `protobuf:"bytes,4,opt,name=device_label,json=deviceLabel,proto3" json:"device_label,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -132,16 +140,24 @@ func (x *RegisterRequest) GetRole() UserRole {
 	return UserRole_USER_ROLE_UNSPECIFIED
 }
 
+func (x *RegisterRequest) GetDeviceLabel() string {
+	if x != nil {
+		return x.DeviceLabel
+	}
+	return ""
+}
+
 // RegisterResponse contains the newly created user and auth tokens
 type RegisterResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Role          UserRole               `protobuf:"varint,3,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
-	AccessToken   string                 `protobuf:"bytes,4,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,5,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	UserId                string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email                 string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role                  UserRole               `protobuf:"varint,3,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
+	AccessToken           string                 `protobuf:"bytes,4,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken          string                 `protobuf:"bytes,5,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	VerificationEmailSent bool                   `protobuf:"varint,6,opt,name=verification_email_sent,json=verificationEmailSent,proto3" json:"verification_email_sent,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *RegisterResponse) Reset() {
@@ -209,11 +225,22 @@ func (x *RegisterResponse) GetRefreshToken() string {
 	return ""
 }
 
+func (x *RegisterResponse) GetVerificationEmailSent() bool {
+	if x != nil {
+		return x.VerificationEmailSent
+	}
+	return false
+}
+
 // LoginRequest contains user login credentials
 type LoginRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Email    string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Optional human-readable name of the device, e.g. "Kitchen iPad"
+	DeviceLabel string `protobuf:"bytes,3,opt,name=device_label,json=deviceLabel,proto3" json:"device_label,omitempty"`
+	// Optional active role for users holding several; defaults to the one last active
+	Role          UserRole `protobuf:"varint,4,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -262,14 +289,39 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetDeviceLabel() string {
+	if x != nil {
+		return x.DeviceLabel
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
 // LoginResponse contains the authenticated user and auth tokens
 type LoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Role          UserRole               `protobuf:"varint,3,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
-	AccessToken   string                 `protobuf:"bytes,4,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
-	RefreshToken  string                 `protobuf:"bytes,5,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email  string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role   UserRole               `protobuf:"varint,3,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
+	// Empty when mfa_required is set
+	AccessToken string `protobuf:"bytes,4,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// Empty when mfa_required is set
+	RefreshToken string `protobuf:"bytes,5,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// True when the login must be completed with VerifyMfa
+	MfaRequired bool `protobuf:"varint,6,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	// Short-lived challenge token to pass to VerifyMfa
+	MfaToken string `protobuf:"bytes,7,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	// True when the user's role requires MFA but it is not set up yet; only
+	// enrollment endpoints are available until it is
+	MfaEnrollmentRequired bool `protobuf:"varint,8,opt,name=mfa_enrollment_required,json=mfaEnrollmentRequired,proto3" json:"mfa_enrollment_required,omitempty"`
+	// Every role granted to the user; role is the active one
+	Roles         []UserRole `protobuf:"varint,9,rep,packed,name=roles,proto3,enum=identity.v1.UserRole" json:"roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -339,6 +391,34 @@ func (x *LoginResponse) GetRefreshToken() string {
 	return ""
 }
 
+func (x *LoginResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetMfaEnrollmentRequired() bool {
+	if x != nil {
+		return x.MfaEnrollmentRequired
+	}
+	return false
+}
+
+func (x *LoginResponse) GetRoles() []UserRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
 // RefreshTokenRequest contains the refresh token
 type RefreshTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -570,6 +650,11 @@ type GetMeResponse struct {
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	Role          UserRole               `protobuf:"varint,3,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
+	EmailVerified bool                   `protobuf:"varint,4,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"`
+	// Set while the account is scheduled for deletion
+	DeletionScheduledFor string `protobuf:"bytes,5,opt,name=deletion_scheduled_for,json=deletionScheduledFor,proto3" json:"deletion_scheduled_for,omitempty"`
+	// Every role granted to the user; role is the one the session acts as
+	Roles         []UserRole `protobuf:"varint,6,rep,packed,name=roles,proto3,enum=identity.v1.UserRole" json:"roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -625,54 +710,3027 @@ func (x *GetMeResponse) GetRole() UserRole {
 	return UserRole_USER_ROLE_UNSPECIFIED
 }
 
-var File_identity_v1_auth_proto protoreflect.FileDescriptor
+func (x *GetMeResponse) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
 
-const file_identity_v1_auth_proto_rawDesc = "" +
-	"\n" +
-	"\x16identity/v1/auth.proto\x12\videntity.v1\"n\n" +
-	"\x0fRegisterRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12)\n" +
-	"\x04role\x18\x03 \x01(\x0e2\x15.identity.v1.UserRoleR\x04role\"\xb4\x01\n" +
-	"\x10RegisterResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12)\n" +
-	"\x04role\x18\x03 \x01(\x0e2\x15.identity.v1.UserRoleR\x04role\x12!\n" +
-	"\faccess_token\x18\x04 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x05 \x01(\tR\frefreshToken\"@\n" +
-	"\fLoginRequest\x12\x14\n" +
-	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xb1\x01\n" +
-	"\rLoginResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12)\n" +
-	"\x04role\x18\x03 \x01(\x0e2\x15.identity.v1.UserRoleR\x04role\x12!\n" +
-	"\faccess_token\x18\x04 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x05 \x01(\tR\frefreshToken\":\n" +
-	"\x13RefreshTokenRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"^\n" +
-	"\x14RefreshTokenResponse\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"4\n" +
-	"\rLogoutRequest\x12#\n" +
-	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"*\n" +
-	"\x0eLogoutResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x0e\n" +
-	"\fGetMeRequest\"i\n" +
-	"\rGetMeResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
-	"\x05email\x18\x02 \x01(\tR\x05email\x12)\n" +
-	"\x04role\x18\x03 \x01(\x0e2\x15.identity.v1.UserRoleR\x04role*S\n" +
-	"\bUserRole\x12\x19\n" +
-	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x12\n" +
-	"\x0eUSER_ROLE_CHEF\x10\x01\x12\x18\n" +
-	"\x14USER_ROLE_RESTAURANT\x10\x022\xee\x02\n" +
-	"\vAuthService\x12G\n" +
-	"\bRegister\x12\x1c.identity.v1.RegisterRequest\x1a\x1d.identity.v1.RegisterResponse\x12>\n" +
-	"\x05Login\x12\x19.identity.v1.LoginRequest\x1a\x1a.identity.v1.LoginResponse\x12S\n" +
-	"\fRefreshToken\x12 .identity.v1.RefreshTokenRequest\x1a!.identity.v1.RefreshTokenResponse\x12A\n" +
-	"\x06Logout\x12\x1a.identity.v1.LogoutRequest\x1a\x1b.identity.v1.LogoutResponse\x12>\n" +
-	"\x05GetMe\x12\x19.identity.v1.GetMeRequest\x1a\x1a.identity.v1.GetMeResponseB\xb4\x01\n" +
+func (x *GetMeResponse) GetDeletionScheduledFor() string {
+	if x != nil {
+		return x.DeletionScheduledFor
+	}
+	return ""
+}
+
+func (x *GetMeResponse) GetRoles() []UserRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// SendVerificationEmailRequest is empty as the recipient is the authenticated user
+type SendVerificationEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendVerificationEmailRequest) Reset() {
+	*x = SendVerificationEmailRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendVerificationEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationEmailRequest) ProtoMessage() {}
+
+func (x *SendVerificationEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendVerificationEmailRequest.ProtoReflect.Descriptor instead.
+func (*SendVerificationEmailRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+// SendVerificationEmailResponse confirms the verification mail was sent
+type SendVerificationEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendVerificationEmailResponse) Reset() {
+	*x = SendVerificationEmailResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendVerificationEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendVerificationEmailResponse) ProtoMessage() {}
+
+func (x *SendVerificationEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendVerificationEmailResponse.ProtoReflect.Descriptor instead.
+func (*SendVerificationEmailResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SendVerificationEmailResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// VerifyEmailRequest contains the token delivered by the verification mail
+type VerifyEmailRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailRequest) Reset() {
+	*x = VerifyEmailRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailRequest) ProtoMessage() {}
+
+func (x *VerifyEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VerifyEmailRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// VerifyEmailResponse confirms the email address was verified
+type VerifyEmailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailResponse) Reset() {
+	*x = VerifyEmailResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailResponse) ProtoMessage() {}
+
+func (x *VerifyEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VerifyEmailResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// RequestPasswordResetRequest contains the email of the account to recover
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// RequestPasswordResetResponse is returned whether or not the email is registered
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RequestPasswordResetResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ResetPasswordRequest contains the reset token and the new password
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// ResetPasswordResponse confirms the password was reset
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResetPasswordResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ChangePasswordRequest contains the current and new passwords
+type ChangePasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+// ChangePasswordResponse contains fresh tokens for the calling client
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ChangePasswordResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ChangePasswordResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// Session describes a device the user is signed in on
+type Session struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	SessionId   string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	DeviceLabel string                 `protobuf:"bytes,2,opt,name=device_label,json=deviceLabel,proto3" json:"device_label,omitempty"`
+	IpAddress   string                 `protobuf:"bytes,3,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent   string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	CreatedAt   string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt  string                 `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// True for the session the request was made with
+	Current       bool `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Session) Reset() {
+	*x = Session{}
+	mi := &file_identity_v1_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Session) ProtoMessage() {}
+
+func (x *Session) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Session) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *Session) GetDeviceLabel() string {
+	if x != nil {
+		return x.DeviceLabel
+	}
+	return ""
+}
+
+func (x *Session) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *Session) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Session) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+// ListSessionsRequest is empty as authentication is handled via JWT
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{21}
+}
+
+// ListSessionsResponse contains the current user's active sessions
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*Session             `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// RevokeSessionRequest identifies the session to sign out
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RevokeSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// RevokeSessionResponse confirms the session was revoked
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RevokeSessionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// RevokeOtherSessionsRequest is empty as the current session comes from the JWT
+type RevokeOtherSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOtherSessionsRequest) Reset() {
+	*x = RevokeOtherSessionsRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeOtherSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeOtherSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{25}
+}
+
+// RevokeOtherSessionsResponse confirms the other sessions were revoked
+type RevokeOtherSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOtherSessionsResponse) Reset() {
+	*x = RevokeOtherSessionsResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeOtherSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeOtherSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *RevokeOtherSessionsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// VerifyMfaRequest contains the challenge from Login and the second factor
+type VerifyMfaRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MfaToken string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	// A TOTP code or one of the recovery codes
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Optional human-readable name of the device, e.g. "Kitchen iPad"
+	DeviceLabel   string `protobuf:"bytes,3,opt,name=device_label,json=deviceLabel,proto3" json:"device_label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMfaRequest) Reset() {
+	*x = VerifyMfaRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMfaRequest) ProtoMessage() {}
+
+func (x *VerifyMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMfaRequest.ProtoReflect.Descriptor instead.
+func (*VerifyMfaRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *VerifyMfaRequest) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *VerifyMfaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyMfaRequest) GetDeviceLabel() string {
+	if x != nil {
+		return x.DeviceLabel
+	}
+	return ""
+}
+
+// VerifyMfaResponse contains the authenticated user and auth tokens
+type VerifyMfaResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	UserId                 string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email                  string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role                   UserRole               `protobuf:"varint,3,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
+	AccessToken            string                 `protobuf:"bytes,4,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken           string                 `protobuf:"bytes,5,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	RecoveryCodesRemaining int32                  `protobuf:"varint,6,opt,name=recovery_codes_remaining,json=recoveryCodesRemaining,proto3" json:"recovery_codes_remaining,omitempty"`
+	// Every role granted to the user; role is the active one
+	Roles         []UserRole `protobuf:"varint,7,rep,packed,name=roles,proto3,enum=identity.v1.UserRole" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMfaResponse) Reset() {
+	*x = VerifyMfaResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMfaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMfaResponse) ProtoMessage() {}
+
+func (x *VerifyMfaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMfaResponse.ProtoReflect.Descriptor instead.
+func (*VerifyMfaResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *VerifyMfaResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VerifyMfaResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *VerifyMfaResponse) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *VerifyMfaResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *VerifyMfaResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *VerifyMfaResponse) GetRecoveryCodesRemaining() int32 {
+	if x != nil {
+		return x.RecoveryCodesRemaining
+	}
+	return 0
+}
+
+func (x *VerifyMfaResponse) GetRoles() []UserRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// BeginTotpEnrollmentRequest is empty as authentication is handled via JWT
+type BeginTotpEnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginTotpEnrollmentRequest) Reset() {
+	*x = BeginTotpEnrollmentRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginTotpEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTotpEnrollmentRequest) ProtoMessage() {}
+
+func (x *BeginTotpEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTotpEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*BeginTotpEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{29}
+}
+
+// BeginTotpEnrollmentResponse contains the secret to add to an authenticator app
+type BeginTotpEnrollmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Base32 secret for manual entry
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// otpauth:// URI to render as a QR code
+	OtpauthUri    string `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginTotpEnrollmentResponse) Reset() {
+	*x = BeginTotpEnrollmentResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginTotpEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTotpEnrollmentResponse) ProtoMessage() {}
+
+func (x *BeginTotpEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTotpEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*BeginTotpEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BeginTotpEnrollmentResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *BeginTotpEnrollmentResponse) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+// ConfirmTotpEnrollmentRequest contains a code generated from the new secret
+type ConfirmTotpEnrollmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTotpEnrollmentRequest) Reset() {
+	*x = ConfirmTotpEnrollmentRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTotpEnrollmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTotpEnrollmentRequest) ProtoMessage() {}
+
+func (x *ConfirmTotpEnrollmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTotpEnrollmentRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTotpEnrollmentRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ConfirmTotpEnrollmentRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// ConfirmTotpEnrollmentResponse contains recovery codes and tokens for an MFA session
+type ConfirmTotpEnrollmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Shown only once; each code can replace a TOTP code a single time
+	RecoveryCodes []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	AccessToken   string   `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string   `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTotpEnrollmentResponse) Reset() {
+	*x = ConfirmTotpEnrollmentResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTotpEnrollmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTotpEnrollmentResponse) ProtoMessage() {}
+
+func (x *ConfirmTotpEnrollmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTotpEnrollmentResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTotpEnrollmentResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ConfirmTotpEnrollmentResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+func (x *ConfirmTotpEnrollmentResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ConfirmTotpEnrollmentResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// RegenerateRecoveryCodesRequest contains a current TOTP code
+type RegenerateRecoveryCodesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesRequest) Reset() {
+	*x = RegenerateRecoveryCodesRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesRequest) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RegenerateRecoveryCodesRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// RegenerateRecoveryCodesResponse contains the new recovery codes
+type RegenerateRecoveryCodesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateRecoveryCodesResponse) Reset() {
+	*x = RegenerateRecoveryCodesResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateRecoveryCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateRecoveryCodesResponse) ProtoMessage() {}
+
+func (x *RegenerateRecoveryCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateRecoveryCodesResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RegenerateRecoveryCodesResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+// StartOidcLoginRequest selects the identity provider
+type StartOidcLoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Provider name, e.g. "google" or "line"
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Role for the account if the callback registers a new user
+	Role          UserRole `protobuf:"varint,2,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartOidcLoginRequest) Reset() {
+	*x = StartOidcLoginRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOidcLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOidcLoginRequest) ProtoMessage() {}
+
+func (x *StartOidcLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOidcLoginRequest.ProtoReflect.Descriptor instead.
+func (*StartOidcLoginRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StartOidcLoginRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *StartOidcLoginRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+// StartOidcLoginResponse contains the provider authorization URL
+type StartOidcLoginResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StartOidcLoginResponse) Reset() {
+	*x = StartOidcLoginResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOidcLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOidcLoginResponse) ProtoMessage() {}
+
+func (x *StartOidcLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOidcLoginResponse.ProtoReflect.Descriptor instead.
+func (*StartOidcLoginResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StartOidcLoginResponse) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+// CompleteOidcLoginRequest contains the parameters from the provider redirect
+type CompleteOidcLoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	State string                 `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Code  string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Optional human-readable name of the device, e.g. "Kitchen iPad"
+	DeviceLabel   string `protobuf:"bytes,3,opt,name=device_label,json=deviceLabel,proto3" json:"device_label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteOidcLoginRequest) Reset() {
+	*x = CompleteOidcLoginRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOidcLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOidcLoginRequest) ProtoMessage() {}
+
+func (x *CompleteOidcLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOidcLoginRequest.ProtoReflect.Descriptor instead.
+func (*CompleteOidcLoginRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CompleteOidcLoginRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CompleteOidcLoginRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CompleteOidcLoginRequest) GetDeviceLabel() string {
+	if x != nil {
+		return x.DeviceLabel
+	}
+	return ""
+}
+
+// CompleteOidcLoginResponse contains the authenticated user and auth tokens
+//
+// When the flow was started with StartOidcLink only linked is set and the
+// caller keeps its current session.
+type CompleteOidcLoginResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email  string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role   UserRole               `protobuf:"varint,3,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
+	// Empty when mfa_required is set
+	AccessToken string `protobuf:"bytes,4,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// Empty when mfa_required is set
+	RefreshToken string `protobuf:"bytes,5,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// True when the login must be completed with VerifyMfa
+	MfaRequired bool `protobuf:"varint,6,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	// Short-lived challenge token to pass to VerifyMfa
+	MfaToken string `protobuf:"bytes,7,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	// True when the user's role requires MFA but it is not set up yet
+	MfaEnrollmentRequired bool `protobuf:"varint,8,opt,name=mfa_enrollment_required,json=mfaEnrollmentRequired,proto3" json:"mfa_enrollment_required,omitempty"`
+	// True when a new account was registered for the provider identity
+	AccountCreated bool `protobuf:"varint,9,opt,name=account_created,json=accountCreated,proto3" json:"account_created,omitempty"`
+	// True when the identity was linked to the current user
+	Linked   bool   `protobuf:"varint,10,opt,name=linked,proto3" json:"linked,omitempty"`
+	Provider string `protobuf:"bytes,11,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Every role granted to the user; role is the active one
+	Roles         []UserRole `protobuf:"varint,12,rep,packed,name=roles,proto3,enum=identity.v1.UserRole" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteOidcLoginResponse) Reset() {
+	*x = CompleteOidcLoginResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOidcLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOidcLoginResponse) ProtoMessage() {}
+
+func (x *CompleteOidcLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOidcLoginResponse.ProtoReflect.Descriptor instead.
+func (*CompleteOidcLoginResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CompleteOidcLoginResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CompleteOidcLoginResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CompleteOidcLoginResponse) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *CompleteOidcLoginResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *CompleteOidcLoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *CompleteOidcLoginResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *CompleteOidcLoginResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *CompleteOidcLoginResponse) GetMfaEnrollmentRequired() bool {
+	if x != nil {
+		return x.MfaEnrollmentRequired
+	}
+	return false
+}
+
+func (x *CompleteOidcLoginResponse) GetAccountCreated() bool {
+	if x != nil {
+		return x.AccountCreated
+	}
+	return false
+}
+
+func (x *CompleteOidcLoginResponse) GetLinked() bool {
+	if x != nil {
+		return x.Linked
+	}
+	return false
+}
+
+func (x *CompleteOidcLoginResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CompleteOidcLoginResponse) GetRoles() []UserRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// StartOidcLinkRequest selects the identity provider to link
+type StartOidcLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartOidcLinkRequest) Reset() {
+	*x = StartOidcLinkRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOidcLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOidcLinkRequest) ProtoMessage() {}
+
+func (x *StartOidcLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOidcLinkRequest.ProtoReflect.Descriptor instead.
+func (*StartOidcLinkRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StartOidcLinkRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+// StartOidcLinkResponse contains the provider authorization URL
+type StartOidcLinkResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StartOidcLinkResponse) Reset() {
+	*x = StartOidcLinkResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOidcLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOidcLinkResponse) ProtoMessage() {}
+
+func (x *StartOidcLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartOidcLinkResponse.ProtoReflect.Descriptor instead.
+func (*StartOidcLinkResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StartOidcLinkResponse) GetAuthorizationUrl() string {
+	if x != nil {
+		return x.AuthorizationUrl
+	}
+	return ""
+}
+
+// ListLinkedIdentitiesRequest is empty as authentication is handled via JWT
+type ListLinkedIdentitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLinkedIdentitiesRequest) Reset() {
+	*x = ListLinkedIdentitiesRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLinkedIdentitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLinkedIdentitiesRequest) ProtoMessage() {}
+
+func (x *ListLinkedIdentitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLinkedIdentitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListLinkedIdentitiesRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{41}
+}
+
+// LinkedIdentity is an identity provider account linked to the user
+type LinkedIdentity struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Provider string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// Email reported by the provider, if any
+	Email         string `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	LinkedAt      string `protobuf:"bytes,3,opt,name=linked_at,json=linkedAt,proto3" json:"linked_at,omitempty"`
+	LastLoginAt   string `protobuf:"bytes,4,opt,name=last_login_at,json=lastLoginAt,proto3" json:"last_login_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkedIdentity) Reset() {
+	*x = LinkedIdentity{}
+	mi := &file_identity_v1_auth_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkedIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkedIdentity) ProtoMessage() {}
+
+func (x *LinkedIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkedIdentity.ProtoReflect.Descriptor instead.
+func (*LinkedIdentity) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *LinkedIdentity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LinkedIdentity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LinkedIdentity) GetLinkedAt() string {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return ""
+}
+
+func (x *LinkedIdentity) GetLastLoginAt() string {
+	if x != nil {
+		return x.LastLoginAt
+	}
+	return ""
+}
+
+// ListLinkedIdentitiesResponse contains the linked identities
+type ListLinkedIdentitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Identities    []*LinkedIdentity      `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLinkedIdentitiesResponse) Reset() {
+	*x = ListLinkedIdentitiesResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLinkedIdentitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLinkedIdentitiesResponse) ProtoMessage() {}
+
+func (x *ListLinkedIdentitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLinkedIdentitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListLinkedIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListLinkedIdentitiesResponse) GetIdentities() []*LinkedIdentity {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+// UnlinkIdentityRequest selects the provider to unlink
+type UnlinkIdentityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityRequest) Reset() {
+	*x = UnlinkIdentityRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityRequest) ProtoMessage() {}
+
+func (x *UnlinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UnlinkIdentityRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+// UnlinkIdentityResponse confirms the identity was unlinked
+type UnlinkIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkIdentityResponse) Reset() {
+	*x = UnlinkIdentityResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkIdentityResponse) ProtoMessage() {}
+
+func (x *UnlinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UnlinkIdentityResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SendAccountDeletionConfirmationRequest is empty as authentication is handled via JWT
+type SendAccountDeletionConfirmationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendAccountDeletionConfirmationRequest) Reset() {
+	*x = SendAccountDeletionConfirmationRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendAccountDeletionConfirmationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAccountDeletionConfirmationRequest) ProtoMessage() {}
+
+func (x *SendAccountDeletionConfirmationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAccountDeletionConfirmationRequest.ProtoReflect.Descriptor instead.
+func (*SendAccountDeletionConfirmationRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{46}
+}
+
+// SendAccountDeletionConfirmationResponse confirms the mail was sent
+type SendAccountDeletionConfirmationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendAccountDeletionConfirmationResponse) Reset() {
+	*x = SendAccountDeletionConfirmationResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendAccountDeletionConfirmationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendAccountDeletionConfirmationResponse) ProtoMessage() {}
+
+func (x *SendAccountDeletionConfirmationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendAccountDeletionConfirmationResponse.ProtoReflect.Descriptor instead.
+func (*SendAccountDeletionConfirmationResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SendAccountDeletionConfirmationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// DeleteAccountRequest re-authenticates the user with one of the password, a
+// two-factor code or a mailed confirmation token
+type DeleteAccountRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Password string                 `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	// A TOTP code or one of the recovery codes
+	MfaCode string `protobuf:"bytes,2,opt,name=mfa_code,json=mfaCode,proto3" json:"mfa_code,omitempty"`
+	// The token from the SendAccountDeletionConfirmation mail
+	ConfirmationToken string `protobuf:"bytes,3,opt,name=confirmation_token,json=confirmationToken,proto3" json:"confirmation_token,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *DeleteAccountRequest) GetMfaCode() string {
+	if x != nil {
+		return x.MfaCode
+	}
+	return ""
+}
+
+func (x *DeleteAccountRequest) GetConfirmationToken() string {
+	if x != nil {
+		return x.ConfirmationToken
+	}
+	return ""
+}
+
+// DeleteAccountResponse reports when the account will be deleted
+type DeleteAccountResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	DeletionScheduledFor string                 `protobuf:"bytes,1,opt,name=deletion_scheduled_for,json=deletionScheduledFor,proto3" json:"deletion_scheduled_for,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteAccountResponse) GetDeletionScheduledFor() string {
+	if x != nil {
+		return x.DeletionScheduledFor
+	}
+	return ""
+}
+
+// CancelAccountDeletionRequest is empty as authentication is handled via JWT
+type CancelAccountDeletionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAccountDeletionRequest) Reset() {
+	*x = CancelAccountDeletionRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAccountDeletionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountDeletionRequest) ProtoMessage() {}
+
+func (x *CancelAccountDeletionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccountDeletionRequest.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{50}
+}
+
+// CancelAccountDeletionResponse confirms the deletion was cancelled
+type CancelAccountDeletionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelAccountDeletionResponse) Reset() {
+	*x = CancelAccountDeletionResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelAccountDeletionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelAccountDeletionResponse) ProtoMessage() {}
+
+func (x *CancelAccountDeletionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelAccountDeletionResponse.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CancelAccountDeletionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ExportMyDataRequest is empty as authentication is handled via JWT
+type ExportMyDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataRequest) Reset() {
+	*x = ExportMyDataRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataRequest) ProtoMessage() {}
+
+func (x *ExportMyDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{52}
+}
+
+// ExportMyDataResponse carries the archive as a downloadable JSON file
+type ExportMyDataResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FileName      string                 `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Archive       []byte                 `protobuf:"bytes,3,opt,name=archive,proto3" json:"archive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportMyDataResponse) Reset() {
+	*x = ExportMyDataResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportMyDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportMyDataResponse) ProtoMessage() {}
+
+func (x *ExportMyDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportMyDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportMyDataResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ExportMyDataResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ExportMyDataResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportMyDataResponse) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+// RequestMagicLinkRequest asks for a sign-in link to be mailed
+type RequestMagicLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestMagicLinkRequest) Reset() {
+	*x = RequestMagicLinkRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestMagicLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMagicLinkRequest) ProtoMessage() {}
+
+func (x *RequestMagicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestMagicLinkRequest.ProtoReflect.Descriptor instead.
+func (*RequestMagicLinkRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RequestMagicLinkRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+// RequestMagicLinkResponse is returned whether or not the email is registered
+type RequestMagicLinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Secret to keep on this device and send with ConsumeMagicLink; the link
+	// does not work without it
+	DeviceBinding string `protobuf:"bytes,1,opt,name=device_binding,json=deviceBinding,proto3" json:"device_binding,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestMagicLinkResponse) Reset() {
+	*x = RequestMagicLinkResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestMagicLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMagicLinkResponse) ProtoMessage() {}
+
+func (x *RequestMagicLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestMagicLinkResponse.ProtoReflect.Descriptor instead.
+func (*RequestMagicLinkResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RequestMagicLinkResponse) GetDeviceBinding() string {
+	if x != nil {
+		return x.DeviceBinding
+	}
+	return ""
+}
+
+// ConsumeMagicLinkRequest contains the mailed token and the device binding
+type ConsumeMagicLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	DeviceBinding string                 `protobuf:"bytes,2,opt,name=device_binding,json=deviceBinding,proto3" json:"device_binding,omitempty"`
+	// Optional human-readable name of the device, e.g. "Kitchen iPad"
+	DeviceLabel   string `protobuf:"bytes,3,opt,name=device_label,json=deviceLabel,proto3" json:"device_label,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeMagicLinkRequest) Reset() {
+	*x = ConsumeMagicLinkRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeMagicLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeMagicLinkRequest) ProtoMessage() {}
+
+func (x *ConsumeMagicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeMagicLinkRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeMagicLinkRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ConsumeMagicLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConsumeMagicLinkRequest) GetDeviceBinding() string {
+	if x != nil {
+		return x.DeviceBinding
+	}
+	return ""
+}
+
+func (x *ConsumeMagicLinkRequest) GetDeviceLabel() string {
+	if x != nil {
+		return x.DeviceLabel
+	}
+	return ""
+}
+
+// ConsumeMagicLinkResponse contains the authenticated user and auth tokens
+type ConsumeMagicLinkResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email  string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role   UserRole               `protobuf:"varint,3,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
+	// Empty when mfa_required is set
+	AccessToken string `protobuf:"bytes,4,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	// Empty when mfa_required is set
+	RefreshToken string `protobuf:"bytes,5,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	// True when the login must be completed with VerifyMfa
+	MfaRequired bool `protobuf:"varint,6,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	// Short-lived challenge token to pass to VerifyMfa
+	MfaToken string `protobuf:"bytes,7,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	// True when the user's role requires MFA but it is not set up yet
+	MfaEnrollmentRequired bool `protobuf:"varint,8,opt,name=mfa_enrollment_required,json=mfaEnrollmentRequired,proto3" json:"mfa_enrollment_required,omitempty"`
+	// Every role granted to the user; role is the active one
+	Roles         []UserRole `protobuf:"varint,9,rep,packed,name=roles,proto3,enum=identity.v1.UserRole" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeMagicLinkResponse) Reset() {
+	*x = ConsumeMagicLinkResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeMagicLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeMagicLinkResponse) ProtoMessage() {}
+
+func (x *ConsumeMagicLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeMagicLinkResponse.ProtoReflect.Descriptor instead.
+func (*ConsumeMagicLinkResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ConsumeMagicLinkResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConsumeMagicLinkResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ConsumeMagicLinkResponse) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *ConsumeMagicLinkResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *ConsumeMagicLinkResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *ConsumeMagicLinkResponse) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *ConsumeMagicLinkResponse) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *ConsumeMagicLinkResponse) GetMfaEnrollmentRequired() bool {
+	if x != nil {
+		return x.MfaEnrollmentRequired
+	}
+	return false
+}
+
+func (x *ConsumeMagicLinkResponse) GetRoles() []UserRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// AddRoleRequest names the role to add; only CHEF and RESTAURANT can be added
+type AddRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          UserRole               `protobuf:"varint,1,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRoleRequest) Reset() {
+	*x = AddRoleRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRoleRequest) ProtoMessage() {}
+
+func (x *AddRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRoleRequest.ProtoReflect.Descriptor instead.
+func (*AddRoleRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AddRoleRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+// AddRoleResponse lists every role the user now holds
+type AddRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []UserRole             `protobuf:"varint,1,rep,packed,name=roles,proto3,enum=identity.v1.UserRole" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRoleResponse) Reset() {
+	*x = AddRoleResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRoleResponse) ProtoMessage() {}
+
+func (x *AddRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRoleResponse.ProtoReflect.Descriptor instead.
+func (*AddRoleResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *AddRoleResponse) GetRoles() []UserRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// SwitchRoleRequest contains the role to act as and the session's refresh token
+type SwitchRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Role  UserRole               `protobuf:"varint,1,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
+	// The current refresh token, which is rotated like RefreshToken does
+	RefreshToken  string `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwitchRoleRequest) Reset() {
+	*x = SwitchRoleRequest{}
+	mi := &file_identity_v1_auth_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchRoleRequest) ProtoMessage() {}
+
+func (x *SwitchRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchRoleRequest.ProtoReflect.Descriptor instead.
+func (*SwitchRoleRequest) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SwitchRoleRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *SwitchRoleRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+// SwitchRoleResponse contains tokens acting as the new role
+type SwitchRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          UserRole               `protobuf:"varint,1,opt,name=role,proto3,enum=identity.v1.UserRole" json:"role,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwitchRoleResponse) Reset() {
+	*x = SwitchRoleResponse{}
+	mi := &file_identity_v1_auth_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwitchRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchRoleResponse) ProtoMessage() {}
+
+func (x *SwitchRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_v1_auth_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchRoleResponse.ProtoReflect.Descriptor instead.
+func (*SwitchRoleResponse) Descriptor() ([]byte, []int) {
+	return file_identity_v1_auth_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SwitchRoleResponse) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *SwitchRoleResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *SwitchRoleResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+var File_identity_v1_auth_proto protoreflect.FileDescriptor
+
+const file_identity_v1_auth_proto_rawDesc = "" +
+	"\n" +
+	"\x16identity/v1/auth.proto\x12\videntity.v1\x1a\x1bbuf/validate/validate.proto\x1a\x16chefnext/v1/auth.proto\"\xad\x01\n" +
+	"\x0fRegisterRequest\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x123\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x15.identity.v1.UserRoleB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04role\x12*\n" +
+	"\fdevice_label\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18dR\vdeviceLabel\"\xec\x01\n" +
+	"\x10RegisterResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12)\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x15.identity.v1.UserRoleR\x04role\x12!\n" +
+	"\faccess_token\x18\x04 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x05 \x01(\tR\frefreshToken\x126\n" +
+	"\x17verification_email_sent\x18\x06 \x01(\bR\x15verificationEmailSent\"\xa1\x01\n" +
+	"\fLoginRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12*\n" +
+	"\fdevice_label\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18dR\vdeviceLabel\x123\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x15.identity.v1.UserRoleB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04role\"\xd6\x02\n" +
+	"\rLoginResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12)\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x15.identity.v1.UserRoleR\x04role\x12!\n" +
+	"\faccess_token\x18\x04 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x05 \x01(\tR\frefreshToken\x12!\n" +
+	"\fmfa_required\x18\x06 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\a \x01(\tR\bmfaToken\x126\n" +
+	"\x17mfa_enrollment_required\x18\b \x01(\bR\x15mfaEnrollmentRequired\x12+\n" +
+	"\x05roles\x18\t \x03(\x0e2\x15.identity.v1.UserRoleR\x05roles\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"^\n" +
+	"\x14RefreshTokenResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"4\n" +
+	"\rLogoutRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"*\n" +
+	"\x0eLogoutResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x0e\n" +
+	"\fGetMeRequest\"\xf3\x01\n" +
+	"\rGetMeResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12)\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x15.identity.v1.UserRoleR\x04role\x12%\n" +
+	"\x0eemail_verified\x18\x04 \x01(\bR\remailVerified\x124\n" +
+	"\x16deletion_scheduled_for\x18\x05 \x01(\tR\x14deletionScheduledFor\x12+\n" +
+	"\x05roles\x18\x06 \x03(\x0e2\x15.identity.v1.UserRoleR\x05roles\"\x1e\n" +
+	"\x1cSendVerificationEmailRequest\"9\n" +
+	"\x1dSendVerificationEmailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"*\n" +
+	"\x12VerifyEmailRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"/\n" +
+	"\x13VerifyEmailResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"<\n" +
+	"\x1bRequestPasswordResetRequest\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\"8\n" +
+	"\x1cRequestPasswordResetResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"O\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"1\n" +
+	"\x15ResetPasswordResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"e\n" +
+	"\x15ChangePasswordRequest\x12)\n" +
+	"\x10current_password\x18\x01 \x01(\tR\x0fcurrentPassword\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"`\n" +
+	"\x16ChangePasswordResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"\xe4\x01\n" +
+	"\aSession\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12!\n" +
+	"\fdevice_label\x18\x02 \x01(\tR\vdeviceLabel\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x03 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12 \n" +
+	"\flast_used_at\x18\x06 \x01(\tR\n" +
+	"lastUsedAt\x12\x18\n" +
+	"\acurrent\x18\a \x01(\bR\acurrent\"\x15\n" +
+	"\x13ListSessionsRequest\"H\n" +
+	"\x14ListSessionsResponse\x120\n" +
+	"\bsessions\x18\x01 \x03(\v2\x14.identity.v1.SessionR\bsessions\"?\n" +
+	"\x14RevokeSessionRequest\x12'\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tsessionId\"1\n" +
+	"\x15RevokeSessionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1c\n" +
+	"\x1aRevokeOtherSessionsRequest\"7\n" +
+	"\x1bRevokeOtherSessionsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"o\n" +
+	"\x10VerifyMfaRequest\x12\x1b\n" +
+	"\tmfa_token\x18\x01 \x01(\tR\bmfaToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12*\n" +
+	"\fdevice_label\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18dR\vdeviceLabel\"\x9c\x02\n" +
+	"\x11VerifyMfaResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12)\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x15.identity.v1.UserRoleR\x04role\x12!\n" +
+	"\faccess_token\x18\x04 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x05 \x01(\tR\frefreshToken\x128\n" +
+	"\x18recovery_codes_remaining\x18\x06 \x01(\x05R\x16recoveryCodesRemaining\x12+\n" +
+	"\x05roles\x18\a \x03(\x0e2\x15.identity.v1.UserRoleR\x05roles\"\x1c\n" +
+	"\x1aBeginTotpEnrollmentRequest\"V\n" +
+	"\x1bBeginTotpEnrollmentResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_uri\x18\x02 \x01(\tR\n" +
+	"otpauthUri\"2\n" +
+	"\x1cConfirmTotpEnrollmentRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x8e\x01\n" +
+	"\x1dConfirmTotpEnrollmentResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\"4\n" +
+	"\x1eRegenerateRecoveryCodesRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"H\n" +
+	"\x1fRegenerateRecoveryCodesResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"h\n" +
+	"\x15StartOidcLoginRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x123\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x15.identity.v1.UserRoleB\b\xbaH\x05\x82\x01\x02\x10\x01R\x04role\"E\n" +
+	"\x16StartOidcLoginResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\"p\n" +
+	"\x18CompleteOidcLoginRequest\x12\x14\n" +
+	"\x05state\x18\x01 \x01(\tR\x05state\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12*\n" +
+	"\fdevice_label\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18dR\vdeviceLabel\"\xbf\x03\n" +
+	"\x19CompleteOidcLoginResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12)\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x15.identity.v1.UserRoleR\x04role\x12!\n" +
+	"\faccess_token\x18\x04 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x05 \x01(\tR\frefreshToken\x12!\n" +
+	"\fmfa_required\x18\x06 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\a \x01(\tR\bmfaToken\x126\n" +
+	"\x17mfa_enrollment_required\x18\b \x01(\bR\x15mfaEnrollmentRequired\x12'\n" +
+	"\x0faccount_created\x18\t \x01(\bR\x0eaccountCreated\x12\x16\n" +
+	"\x06linked\x18\n" +
+	" \x01(\bR\x06linked\x12\x1a\n" +
+	"\bprovider\x18\v \x01(\tR\bprovider\x12+\n" +
+	"\x05roles\x18\f \x03(\x0e2\x15.identity.v1.UserRoleR\x05roles\"2\n" +
+	"\x14StartOidcLinkRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"D\n" +
+	"\x15StartOidcLinkResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\"\x1d\n" +
+	"\x1bListLinkedIdentitiesRequest\"\x83\x01\n" +
+	"\x0eLinkedIdentity\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1b\n" +
+	"\tlinked_at\x18\x03 \x01(\tR\blinkedAt\x12\"\n" +
+	"\rlast_login_at\x18\x04 \x01(\tR\vlastLoginAt\"[\n" +
+	"\x1cListLinkedIdentitiesResponse\x12;\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x1b.identity.v1.LinkedIdentityR\n" +
+	"identities\"3\n" +
+	"\x15UnlinkIdentityRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"2\n" +
+	"\x16UnlinkIdentityResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"(\n" +
+	"&SendAccountDeletionConfirmationRequest\"C\n" +
+	"'SendAccountDeletionConfirmationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"|\n" +
+	"\x14DeleteAccountRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\x12\x19\n" +
+	"\bmfa_code\x18\x02 \x01(\tR\amfaCode\x12-\n" +
+	"\x12confirmation_token\x18\x03 \x01(\tR\x11confirmationToken\"M\n" +
+	"\x15DeleteAccountResponse\x124\n" +
+	"\x16deletion_scheduled_for\x18\x01 \x01(\tR\x14deletionScheduledFor\"\x1e\n" +
+	"\x1cCancelAccountDeletionRequest\"9\n" +
+	"\x1dCancelAccountDeletionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x15\n" +
+	"\x13ExportMyDataRequest\"p\n" +
+	"\x14ExportMyDataResponse\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x18\n" +
+	"\aarchive\x18\x03 \x01(\fR\aarchive\"8\n" +
+	"\x17RequestMagicLinkRequest\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\"A\n" +
+	"\x18RequestMagicLinkResponse\x12%\n" +
+	"\x0edevice_binding\x18\x01 \x01(\tR\rdeviceBinding\"\x82\x01\n" +
+	"\x17ConsumeMagicLinkRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12%\n" +
+	"\x0edevice_binding\x18\x02 \x01(\tR\rdeviceBinding\x12*\n" +
+	"\fdevice_label\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18dR\vdeviceLabel\"\xe1\x02\n" +
+	"\x18ConsumeMagicLinkResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12)\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x15.identity.v1.UserRoleR\x04role\x12!\n" +
+	"\faccess_token\x18\x04 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x05 \x01(\tR\frefreshToken\x12!\n" +
+	"\fmfa_required\x18\x06 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\a \x01(\tR\bmfaToken\x126\n" +
+	"\x17mfa_enrollment_required\x18\b \x01(\bR\x15mfaEnrollmentRequired\x12+\n" +
+	"\x05roles\x18\t \x03(\x0e2\x15.identity.v1.UserRoleR\x05roles\"G\n" +
+	"\x0eAddRoleRequest\x125\n" +
+	"\x04role\x18\x01 \x01(\x0e2\x15.identity.v1.UserRoleB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04role\">\n" +
+	"\x0fAddRoleResponse\x12+\n" +
+	"\x05roles\x18\x01 \x03(\x0e2\x15.identity.v1.UserRoleR\x05roles\"o\n" +
+	"\x11SwitchRoleRequest\x125\n" +
+	"\x04role\x18\x01 \x01(\x0e2\x15.identity.v1.UserRoleB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04role\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"\x87\x01\n" +
+	"\x12SwitchRoleResponse\x12)\n" +
+	"\x04role\x18\x01 \x01(\x0e2\x15.identity.v1.UserRoleR\x04role\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken*h\n" +
+	"\bUserRole\x12\x19\n" +
+	"\x15USER_ROLE_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eUSER_ROLE_CHEF\x10\x01\x12\x18\n" +
+	"\x14USER_ROLE_RESTAURANT\x10\x02\x12\x13\n" +
+	"\x0fUSER_ROLE_ADMIN\x10\x032\xf3\x17\n" +
+	"\vAuthService\x12S\n" +
+	"\bRegister\x12\x1c.identity.v1.RegisterRequest\x1a\x1d.identity.v1.RegisterResponse\"\n" +
+	"\x8a\xb5\x18\x02\b\x01\x90\xb5\x18\x01\x12J\n" +
+	"\x05Login\x12\x19.identity.v1.LoginRequest\x1a\x1a.identity.v1.LoginResponse\"\n" +
+	"\x8a\xb5\x18\x02\b\x01\x90\xb5\x18\x01\x12_\n" +
+	"\fRefreshToken\x12 .identity.v1.RefreshTokenRequest\x1a!.identity.v1.RefreshTokenResponse\"\n" +
+	"\x8a\xb5\x18\x02 \x01\x90\xb5\x18\x01\x12I\n" +
+	"\x06Logout\x12\x1a.identity.v1.LogoutRequest\x1a\x1b.identity.v1.LogoutResponse\"\x06\x8a\xb5\x18\x02 \x01\x12I\n" +
+	"\x05GetMe\x12\x19.identity.v1.GetMeRequest\x1a\x1a.identity.v1.GetMeResponse\"\t\x8a\xb5\x18\x02 \x01\x90\x02\x01\x12t\n" +
+	"\x15SendVerificationEmail\x12).identity.v1.SendVerificationEmailRequest\x1a*.identity.v1.SendVerificationEmailResponse\"\x04\x8a\xb5\x18\x00\x12X\n" +
+	"\vVerifyEmail\x12\x1f.identity.v1.VerifyEmailRequest\x1a .identity.v1.VerifyEmailResponse\"\x06\x8a\xb5\x18\x02\b\x01\x12s\n" +
+	"\x14RequestPasswordReset\x12(.identity.v1.RequestPasswordResetRequest\x1a).identity.v1.RequestPasswordResetResponse\"\x06\x8a\xb5\x18\x02\b\x01\x12^\n" +
+	"\rResetPassword\x12!.identity.v1.ResetPasswordRequest\x1a\".identity.v1.ResetPasswordResponse\"\x06\x8a\xb5\x18\x02\b\x01\x12c\n" +
+	"\x0eChangePassword\x12\".identity.v1.ChangePasswordRequest\x1a#.identity.v1.ChangePasswordResponse\"\b\x8a\xb5\x18\x00\x90\xb5\x18\x01\x12\\\n" +
+	"\fListSessions\x12 .identity.v1.ListSessionsRequest\x1a!.identity.v1.ListSessionsResponse\"\a\x8a\xb5\x18\x00\x90\x02\x01\x12\\\n" +
+	"\rRevokeSession\x12!.identity.v1.RevokeSessionRequest\x1a\".identity.v1.RevokeSessionResponse\"\x04\x8a\xb5\x18\x00\x12n\n" +
+	"\x13RevokeOtherSessions\x12'.identity.v1.RevokeOtherSessionsRequest\x1a(.identity.v1.RevokeOtherSessionsResponse\"\x04\x8a\xb5\x18\x00\x12V\n" +
+	"\tVerifyMfa\x12\x1d.identity.v1.VerifyMfaRequest\x1a\x1e.identity.v1.VerifyMfaResponse\"\n" +
+	"\x8a\xb5\x18\x02\b\x01\x90\xb5\x18\x01\x12t\n" +
+	"\x13BeginTotpEnrollment\x12'.identity.v1.BeginTotpEnrollmentRequest\x1a(.identity.v1.BeginTotpEnrollmentResponse\"\n" +
+	"\x8a\xb5\x18\x02 \x01\x90\xb5\x18\x01\x12z\n" +
+	"\x15ConfirmTotpEnrollment\x12).identity.v1.ConfirmTotpEnrollmentRequest\x1a*.identity.v1.ConfirmTotpEnrollmentResponse\"\n" +
+	"\x8a\xb5\x18\x02 \x01\x90\xb5\x18\x01\x12~\n" +
+	"\x17RegenerateRecoveryCodes\x12+.identity.v1.RegenerateRecoveryCodesRequest\x1a,.identity.v1.RegenerateRecoveryCodesResponse\"\b\x8a\xb5\x18\x00\x90\xb5\x18\x01\x12a\n" +
+	"\x0eStartOidcLogin\x12\".identity.v1.StartOidcLoginRequest\x1a#.identity.v1.StartOidcLoginResponse\"\x06\x8a\xb5\x18\x02\b\x01\x12n\n" +
+	"\x11CompleteOidcLogin\x12%.identity.v1.CompleteOidcLoginRequest\x1a&.identity.v1.CompleteOidcLoginResponse\"\n" +
+	"\x8a\xb5\x18\x02\b\x01\x90\xb5\x18\x01\x12\\\n" +
+	"\rStartOidcLink\x12!.identity.v1.StartOidcLinkRequest\x1a\".identity.v1.StartOidcLinkResponse\"\x04\x8a\xb5\x18\x00\x12t\n" +
+	"\x14ListLinkedIdentities\x12(.identity.v1.ListLinkedIdentitiesRequest\x1a).identity.v1.ListLinkedIdentitiesResponse\"\a\x8a\xb5\x18\x00\x90\x02\x01\x12_\n" +
+	"\x0eUnlinkIdentity\x12\".identity.v1.UnlinkIdentityRequest\x1a#.identity.v1.UnlinkIdentityResponse\"\x04\x8a\xb5\x18\x00\x12\x92\x01\n" +
+	"\x1fSendAccountDeletionConfirmation\x123.identity.v1.SendAccountDeletionConfirmationRequest\x1a4.identity.v1.SendAccountDeletionConfirmationResponse\"\x04\x8a\xb5\x18\x00\x12\\\n" +
+	"\rDeleteAccount\x12!.identity.v1.DeleteAccountRequest\x1a\".identity.v1.DeleteAccountResponse\"\x04\x8a\xb5\x18\x00\x12t\n" +
+	"\x15CancelAccountDeletion\x12).identity.v1.CancelAccountDeletionRequest\x1a*.identity.v1.CancelAccountDeletionResponse\"\x04\x8a\xb5\x18\x00\x12\\\n" +
+	"\fExportMyData\x12 .identity.v1.ExportMyDataRequest\x1a!.identity.v1.ExportMyDataResponse\"\a\x8a\xb5\x18\x00\x90\x02\x01\x12k\n" +
+	"\x10RequestMagicLink\x12$.identity.v1.RequestMagicLinkRequest\x1a%.identity.v1.RequestMagicLinkResponse\"\n" +
+	"\x8a\xb5\x18\x02\b\x01\x90\xb5\x18\x01\x12k\n" +
+	"\x10ConsumeMagicLink\x12$.identity.v1.ConsumeMagicLinkRequest\x1a%.identity.v1.ConsumeMagicLinkResponse\"\n" +
+	"\x8a\xb5\x18\x02\b\x01\x90\xb5\x18\x01\x12J\n" +
+	"\aAddRole\x12\x1b.identity.v1.AddRoleRequest\x1a\x1c.identity.v1.AddRoleResponse\"\x04\x8a\xb5\x18\x00\x12W\n" +
+	"\n" +
+	"SwitchRole\x12\x1e.identity.v1.SwitchRoleRequest\x1a\x1f.identity.v1.SwitchRoleResponse\"\b\x8a\xb5\x18\x00\x90\xb5\x18\x01B\xb4\x01\n" +
 	"\x0fcom.identity.v1B\tAuthProtoP\x01ZIgithub.com/chefnext/chefnext/apps/api/internal/gen/identity/v1;identityv1\xa2\x02\x03IXX\xaa\x02\vIdentity.V1\xca\x02\vIdentity\\V1\xe2\x02\x17Identity\\V1\\GPBMetadata\xea\x02\fIdentity::V1b\x06proto3"
 
 var (
@@ -688,40 +3746,158 @@ func file_identity_v1_auth_proto_rawDescGZIP() []byte {
 }
 
 var file_identity_v1_auth_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_identity_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_identity_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
 var file_identity_v1_auth_proto_goTypes = []any{
-	(UserRole)(0),                // 0: identity.v1.UserRole
-	(*RegisterRequest)(nil),      // 1: identity.v1.RegisterRequest
-	(*RegisterResponse)(nil),     // 2: identity.v1.RegisterResponse
-	(*LoginRequest)(nil),         // 3: identity.v1.LoginRequest
-	(*LoginResponse)(nil),        // 4: identity.v1.LoginResponse
-	(*RefreshTokenRequest)(nil),  // 5: identity.v1.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil), // 6: identity.v1.RefreshTokenResponse
-	(*LogoutRequest)(nil),        // 7: identity.v1.LogoutRequest
-	(*LogoutResponse)(nil),       // 8: identity.v1.LogoutResponse
-	(*GetMeRequest)(nil),         // 9: identity.v1.GetMeRequest
-	(*GetMeResponse)(nil),        // 10: identity.v1.GetMeResponse
+	(UserRole)(0),                                   // 0: identity.v1.UserRole
+	(*RegisterRequest)(nil),                         // 1: identity.v1.RegisterRequest
+	(*RegisterResponse)(nil),                        // 2: identity.v1.RegisterResponse
+	(*LoginRequest)(nil),                            // 3: identity.v1.LoginRequest
+	(*LoginResponse)(nil),                           // 4: identity.v1.LoginResponse
+	(*RefreshTokenRequest)(nil),                     // 5: identity.v1.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),                    // 6: identity.v1.RefreshTokenResponse
+	(*LogoutRequest)(nil),                           // 7: identity.v1.LogoutRequest
+	(*LogoutResponse)(nil),                          // 8: identity.v1.LogoutResponse
+	(*GetMeRequest)(nil),                            // 9: identity.v1.GetMeRequest
+	(*GetMeResponse)(nil),                           // 10: identity.v1.GetMeResponse
+	(*SendVerificationEmailRequest)(nil),            // 11: identity.v1.SendVerificationEmailRequest
+	(*SendVerificationEmailResponse)(nil),           // 12: identity.v1.SendVerificationEmailResponse
+	(*VerifyEmailRequest)(nil),                      // 13: identity.v1.VerifyEmailRequest
+	(*VerifyEmailResponse)(nil),                     // 14: identity.v1.VerifyEmailResponse
+	(*RequestPasswordResetRequest)(nil),             // 15: identity.v1.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),            // 16: identity.v1.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),                    // 17: identity.v1.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),                   // 18: identity.v1.ResetPasswordResponse
+	(*ChangePasswordRequest)(nil),                   // 19: identity.v1.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),                  // 20: identity.v1.ChangePasswordResponse
+	(*Session)(nil),                                 // 21: identity.v1.Session
+	(*ListSessionsRequest)(nil),                     // 22: identity.v1.ListSessionsRequest
+	(*ListSessionsResponse)(nil),                    // 23: identity.v1.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),                    // 24: identity.v1.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),                   // 25: identity.v1.RevokeSessionResponse
+	(*RevokeOtherSessionsRequest)(nil),              // 26: identity.v1.RevokeOtherSessionsRequest
+	(*RevokeOtherSessionsResponse)(nil),             // 27: identity.v1.RevokeOtherSessionsResponse
+	(*VerifyMfaRequest)(nil),                        // 28: identity.v1.VerifyMfaRequest
+	(*VerifyMfaResponse)(nil),                       // 29: identity.v1.VerifyMfaResponse
+	(*BeginTotpEnrollmentRequest)(nil),              // 30: identity.v1.BeginTotpEnrollmentRequest
+	(*BeginTotpEnrollmentResponse)(nil),             // 31: identity.v1.BeginTotpEnrollmentResponse
+	(*ConfirmTotpEnrollmentRequest)(nil),            // 32: identity.v1.ConfirmTotpEnrollmentRequest
+	(*ConfirmTotpEnrollmentResponse)(nil),           // 33: identity.v1.ConfirmTotpEnrollmentResponse
+	(*RegenerateRecoveryCodesRequest)(nil),          // 34: identity.v1.RegenerateRecoveryCodesRequest
+	(*RegenerateRecoveryCodesResponse)(nil),         // 35: identity.v1.RegenerateRecoveryCodesResponse
+	(*StartOidcLoginRequest)(nil),                   // 36: identity.v1.StartOidcLoginRequest
+	(*StartOidcLoginResponse)(nil),                  // 37: identity.v1.StartOidcLoginResponse
+	(*CompleteOidcLoginRequest)(nil),                // 38: identity.v1.CompleteOidcLoginRequest
+	(*CompleteOidcLoginResponse)(nil),               // 39: identity.v1.CompleteOidcLoginResponse
+	(*StartOidcLinkRequest)(nil),                    // 40: identity.v1.StartOidcLinkRequest
+	(*StartOidcLinkResponse)(nil),                   // 41: identity.v1.StartOidcLinkResponse
+	(*ListLinkedIdentitiesRequest)(nil),             // 42: identity.v1.ListLinkedIdentitiesRequest
+	(*LinkedIdentity)(nil),                          // 43: identity.v1.LinkedIdentity
+	(*ListLinkedIdentitiesResponse)(nil),            // 44: identity.v1.ListLinkedIdentitiesResponse
+	(*UnlinkIdentityRequest)(nil),                   // 45: identity.v1.UnlinkIdentityRequest
+	(*UnlinkIdentityResponse)(nil),                  // 46: identity.v1.UnlinkIdentityResponse
+	(*SendAccountDeletionConfirmationRequest)(nil),  // 47: identity.v1.SendAccountDeletionConfirmationRequest
+	(*SendAccountDeletionConfirmationResponse)(nil), // 48: identity.v1.SendAccountDeletionConfirmationResponse
+	(*DeleteAccountRequest)(nil),                    // 49: identity.v1.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),                   // 50: identity.v1.DeleteAccountResponse
+	(*CancelAccountDeletionRequest)(nil),            // 51: identity.v1.CancelAccountDeletionRequest
+	(*CancelAccountDeletionResponse)(nil),           // 52: identity.v1.CancelAccountDeletionResponse
+	(*ExportMyDataRequest)(nil),                     // 53: identity.v1.ExportMyDataRequest
+	(*ExportMyDataResponse)(nil),                    // 54: identity.v1.ExportMyDataResponse
+	(*RequestMagicLinkRequest)(nil),                 // 55: identity.v1.RequestMagicLinkRequest
+	(*RequestMagicLinkResponse)(nil),                // 56: identity.v1.RequestMagicLinkResponse
+	(*ConsumeMagicLinkRequest)(nil),                 // 57: identity.v1.ConsumeMagicLinkRequest
+	(*ConsumeMagicLinkResponse)(nil),                // 58: identity.v1.ConsumeMagicLinkResponse
+	(*AddRoleRequest)(nil),                          // 59: identity.v1.AddRoleRequest
+	(*AddRoleResponse)(nil),                         // 60: identity.v1.AddRoleResponse
+	(*SwitchRoleRequest)(nil),                       // 61: identity.v1.SwitchRoleRequest
+	(*SwitchRoleResponse)(nil),                      // 62: identity.v1.SwitchRoleResponse
 }
 var file_identity_v1_auth_proto_depIdxs = []int32{
 	0,  // 0: identity.v1.RegisterRequest.role:type_name -> identity.v1.UserRole
 	0,  // 1: identity.v1.RegisterResponse.role:type_name -> identity.v1.UserRole
-	0,  // 2: identity.v1.LoginResponse.role:type_name -> identity.v1.UserRole
-	0,  // 3: identity.v1.GetMeResponse.role:type_name -> identity.v1.UserRole
-	1,  // 4: identity.v1.AuthService.Register:input_type -> identity.v1.RegisterRequest
-	3,  // 5: identity.v1.AuthService.Login:input_type -> identity.v1.LoginRequest
-	5,  // 6: identity.v1.AuthService.RefreshToken:input_type -> identity.v1.RefreshTokenRequest
-	7,  // 7: identity.v1.AuthService.Logout:input_type -> identity.v1.LogoutRequest
-	9,  // 8: identity.v1.AuthService.GetMe:input_type -> identity.v1.GetMeRequest
-	2,  // 9: identity.v1.AuthService.Register:output_type -> identity.v1.RegisterResponse
-	4,  // 10: identity.v1.AuthService.Login:output_type -> identity.v1.LoginResponse
-	6,  // 11: identity.v1.AuthService.RefreshToken:output_type -> identity.v1.RefreshTokenResponse
-	8,  // 12: identity.v1.AuthService.Logout:output_type -> identity.v1.LogoutResponse
-	10, // 13: identity.v1.AuthService.GetMe:output_type -> identity.v1.GetMeResponse
-	9,  // [9:14] is the sub-list for method output_type
-	4,  // [4:9] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	0,  // 2: identity.v1.LoginRequest.role:type_name -> identity.v1.UserRole
+	0,  // 3: identity.v1.LoginResponse.role:type_name -> identity.v1.UserRole
+	0,  // 4: identity.v1.LoginResponse.roles:type_name -> identity.v1.UserRole
+	0,  // 5: identity.v1.GetMeResponse.role:type_name -> identity.v1.UserRole
+	0,  // 6: identity.v1.GetMeResponse.roles:type_name -> identity.v1.UserRole
+	21, // 7: identity.v1.ListSessionsResponse.sessions:type_name -> identity.v1.Session
+	0,  // 8: identity.v1.VerifyMfaResponse.role:type_name -> identity.v1.UserRole
+	0,  // 9: identity.v1.VerifyMfaResponse.roles:type_name -> identity.v1.UserRole
+	0,  // 10: identity.v1.StartOidcLoginRequest.role:type_name -> identity.v1.UserRole
+	0,  // 11: identity.v1.CompleteOidcLoginResponse.role:type_name -> identity.v1.UserRole
+	0,  // 12: identity.v1.CompleteOidcLoginResponse.roles:type_name -> identity.v1.UserRole
+	43, // 13: identity.v1.ListLinkedIdentitiesResponse.identities:type_name -> identity.v1.LinkedIdentity
+	0,  // 14: identity.v1.ConsumeMagicLinkResponse.role:type_name -> identity.v1.UserRole
+	0,  // 15: identity.v1.ConsumeMagicLinkResponse.roles:type_name -> identity.v1.UserRole
+	0,  // 16: identity.v1.AddRoleRequest.role:type_name -> identity.v1.UserRole
+	0,  // 17: identity.v1.AddRoleResponse.roles:type_name -> identity.v1.UserRole
+	0,  // 18: identity.v1.SwitchRoleRequest.role:type_name -> identity.v1.UserRole
+	0,  // 19: identity.v1.SwitchRoleResponse.role:type_name -> identity.v1.UserRole
+	1,  // 20: identity.v1.AuthService.Register:input_type -> identity.v1.RegisterRequest
+	3,  // 21: identity.v1.AuthService.Login:input_type -> identity.v1.LoginRequest
+	5,  // 22: identity.v1.AuthService.RefreshToken:input_type -> identity.v1.RefreshTokenRequest
+	7,  // 23: identity.v1.AuthService.Logout:input_type -> identity.v1.LogoutRequest
+	9,  // 24: identity.v1.AuthService.GetMe:input_type -> identity.v1.GetMeRequest
+	11, // 25: identity.v1.AuthService.SendVerificationEmail:input_type -> identity.v1.SendVerificationEmailRequest
+	13, // 26: identity.v1.AuthService.VerifyEmail:input_type -> identity.v1.VerifyEmailRequest
+	15, // 27: identity.v1.AuthService.RequestPasswordReset:input_type -> identity.v1.RequestPasswordResetRequest
+	17, // 28: identity.v1.AuthService.ResetPassword:input_type -> identity.v1.ResetPasswordRequest
+	19, // 29: identity.v1.AuthService.ChangePassword:input_type -> identity.v1.ChangePasswordRequest
+	22, // 30: identity.v1.AuthService.ListSessions:input_type -> identity.v1.ListSessionsRequest
+	24, // 31: identity.v1.AuthService.RevokeSession:input_type -> identity.v1.RevokeSessionRequest
+	26, // 32: identity.v1.AuthService.RevokeOtherSessions:input_type -> identity.v1.RevokeOtherSessionsRequest
+	28, // 33: identity.v1.AuthService.VerifyMfa:input_type -> identity.v1.VerifyMfaRequest
+	30, // 34: identity.v1.AuthService.BeginTotpEnrollment:input_type -> identity.v1.BeginTotpEnrollmentRequest
+	32, // 35: identity.v1.AuthService.ConfirmTotpEnrollment:input_type -> identity.v1.ConfirmTotpEnrollmentRequest
+	34, // 36: identity.v1.AuthService.RegenerateRecoveryCodes:input_type -> identity.v1.RegenerateRecoveryCodesRequest
+	36, // 37: identity.v1.AuthService.StartOidcLogin:input_type -> identity.v1.StartOidcLoginRequest
+	38, // 38: identity.v1.AuthService.CompleteOidcLogin:input_type -> identity.v1.CompleteOidcLoginRequest
+	40, // 39: identity.v1.AuthService.StartOidcLink:input_type -> identity.v1.StartOidcLinkRequest
+	42, // 40: identity.v1.AuthService.ListLinkedIdentities:input_type -> identity.v1.ListLinkedIdentitiesRequest
+	45, // 41: identity.v1.AuthService.UnlinkIdentity:input_type -> identity.v1.UnlinkIdentityRequest
+	47, // 42: identity.v1.AuthService.SendAccountDeletionConfirmation:input_type -> identity.v1.SendAccountDeletionConfirmationRequest
+	49, // 43: identity.v1.AuthService.DeleteAccount:input_type -> identity.v1.DeleteAccountRequest
+	51, // 44: identity.v1.AuthService.CancelAccountDeletion:input_type -> identity.v1.CancelAccountDeletionRequest
+	53, // 45: identity.v1.AuthService.ExportMyData:input_type -> identity.v1.ExportMyDataRequest
+	55, // 46: identity.v1.AuthService.RequestMagicLink:input_type -> identity.v1.RequestMagicLinkRequest
+	57, // 47: identity.v1.AuthService.ConsumeMagicLink:input_type -> identity.v1.ConsumeMagicLinkRequest
+	59, // 48: identity.v1.AuthService.AddRole:input_type -> identity.v1.AddRoleRequest
+	61, // 49: identity.v1.AuthService.SwitchRole:input_type -> identity.v1.SwitchRoleRequest
+	2,  // 50: identity.v1.AuthService.Register:output_type -> identity.v1.RegisterResponse
+	4,  // 51: identity.v1.AuthService.Login:output_type -> identity.v1.LoginResponse
+	6,  // 52: identity.v1.AuthService.RefreshToken:output_type -> identity.v1.RefreshTokenResponse
+	8,  // 53: identity.v1.AuthService.Logout:output_type -> identity.v1.LogoutResponse
+	10, // 54: identity.v1.AuthService.GetMe:output_type -> identity.v1.GetMeResponse
+	12, // 55: identity.v1.AuthService.SendVerificationEmail:output_type -> identity.v1.SendVerificationEmailResponse
+	14, // 56: identity.v1.AuthService.VerifyEmail:output_type -> identity.v1.VerifyEmailResponse
+	16, // 57: identity.v1.AuthService.RequestPasswordReset:output_type -> identity.v1.RequestPasswordResetResponse
+	18, // 58: identity.v1.AuthService.ResetPassword:output_type -> identity.v1.ResetPasswordResponse
+	20, // 59: identity.v1.AuthService.ChangePassword:output_type -> identity.v1.ChangePasswordResponse
+	23, // 60: identity.v1.AuthService.ListSessions:output_type -> identity.v1.ListSessionsResponse
+	25, // 61: identity.v1.AuthService.RevokeSession:output_type -> identity.v1.RevokeSessionResponse
+	27, // 62: identity.v1.AuthService.RevokeOtherSessions:output_type -> identity.v1.RevokeOtherSessionsResponse
+	29, // 63: identity.v1.AuthService.VerifyMfa:output_type -> identity.v1.VerifyMfaResponse
+	31, // 64: identity.v1.AuthService.BeginTotpEnrollment:output_type -> identity.v1.BeginTotpEnrollmentResponse
+	33, // 65: identity.v1.AuthService.ConfirmTotpEnrollment:output_type -> identity.v1.ConfirmTotpEnrollmentResponse
+	35, // 66: identity.v1.AuthService.RegenerateRecoveryCodes:output_type -> identity.v1.RegenerateRecoveryCodesResponse
+	37, // 67: identity.v1.AuthService.StartOidcLogin:output_type -> identity.v1.StartOidcLoginResponse
+	39, // 68: identity.v1.AuthService.CompleteOidcLogin:output_type -> identity.v1.CompleteOidcLoginResponse
+	41, // 69: identity.v1.AuthService.StartOidcLink:output_type -> identity.v1.StartOidcLinkResponse
+	44, // 70: identity.v1.AuthService.ListLinkedIdentities:output_type -> identity.v1.ListLinkedIdentitiesResponse
+	46, // 71: identity.v1.AuthService.UnlinkIdentity:output_type -> identity.v1.UnlinkIdentityResponse
+	48, // 72: identity.v1.AuthService.SendAccountDeletionConfirmation:output_type -> identity.v1.SendAccountDeletionConfirmationResponse
+	50, // 73: identity.v1.AuthService.DeleteAccount:output_type -> identity.v1.DeleteAccountResponse
+	52, // 74: identity.v1.AuthService.CancelAccountDeletion:output_type -> identity.v1.CancelAccountDeletionResponse
+	54, // 75: identity.v1.AuthService.ExportMyData:output_type -> identity.v1.ExportMyDataResponse
+	56, // 76: identity.v1.AuthService.RequestMagicLink:output_type -> identity.v1.RequestMagicLinkResponse
+	58, // 77: identity.v1.AuthService.ConsumeMagicLink:output_type -> identity.v1.ConsumeMagicLinkResponse
+	60, // 78: identity.v1.AuthService.AddRole:output_type -> identity.v1.AddRoleResponse
+	62, // 79: identity.v1.AuthService.SwitchRole:output_type -> identity.v1.SwitchRoleResponse
+	50, // [50:80] is the sub-list for method output_type
+	20, // [20:50] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_identity_v1_auth_proto_init() }
@@ -735,7 +3911,7 @@ func file_identity_v1_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_identity_v1_auth_proto_rawDesc), len(file_identity_v1_auth_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   10,
+			NumMessages:   62,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -44,6 +44,77 @@ const (
 	AuthServiceLogoutProcedure = "/identity.v1.AuthService/Logout"
 	// AuthServiceGetMeProcedure is the fully-qualified name of the AuthService's GetMe RPC.
 	AuthServiceGetMeProcedure = "/identity.v1.AuthService/GetMe"
+	// AuthServiceSendVerificationEmailProcedure is the fully-qualified name of the AuthService's
+	// SendVerificationEmail RPC.
+	AuthServiceSendVerificationEmailProcedure = "/identity.v1.AuthService/SendVerificationEmail"
+	// AuthServiceVerifyEmailProcedure is the fully-qualified name of the AuthService's VerifyEmail RPC.
+	AuthServiceVerifyEmailProcedure = "/identity.v1.AuthService/VerifyEmail"
+	// AuthServiceRequestPasswordResetProcedure is the fully-qualified name of the AuthService's
+	// RequestPasswordReset RPC.
+	AuthServiceRequestPasswordResetProcedure = "/identity.v1.AuthService/RequestPasswordReset"
+	// AuthServiceResetPasswordProcedure is the fully-qualified name of the AuthService's ResetPassword
+	// RPC.
+	AuthServiceResetPasswordProcedure = "/identity.v1.AuthService/ResetPassword"
+	// AuthServiceChangePasswordProcedure is the fully-qualified name of the AuthService's
+	// ChangePassword RPC.
+	AuthServiceChangePasswordProcedure = "/identity.v1.AuthService/ChangePassword"
+	// AuthServiceListSessionsProcedure is the fully-qualified name of the AuthService's ListSessions
+	// RPC.
+	AuthServiceListSessionsProcedure = "/identity.v1.AuthService/ListSessions"
+	// AuthServiceRevokeSessionProcedure is the fully-qualified name of the AuthService's RevokeSession
+	// RPC.
+	AuthServiceRevokeSessionProcedure = "/identity.v1.AuthService/RevokeSession"
+	// AuthServiceRevokeOtherSessionsProcedure is the fully-qualified name of the AuthService's
+	// RevokeOtherSessions RPC.
+	AuthServiceRevokeOtherSessionsProcedure = "/identity.v1.AuthService/RevokeOtherSessions"
+	// AuthServiceVerifyMfaProcedure is the fully-qualified name of the AuthService's VerifyMfa RPC.
+	AuthServiceVerifyMfaProcedure = "/identity.v1.AuthService/VerifyMfa"
+	// AuthServiceBeginTotpEnrollmentProcedure is the fully-qualified name of the AuthService's
+	// BeginTotpEnrollment RPC.
+	AuthServiceBeginTotpEnrollmentProcedure = "/identity.v1.AuthService/BeginTotpEnrollment"
+	// AuthServiceConfirmTotpEnrollmentProcedure is the fully-qualified name of the AuthService's
+	// ConfirmTotpEnrollment RPC.
+	AuthServiceConfirmTotpEnrollmentProcedure = "/identity.v1.AuthService/ConfirmTotpEnrollment"
+	// AuthServiceRegenerateRecoveryCodesProcedure is the fully-qualified name of the AuthService's
+	// RegenerateRecoveryCodes RPC.
+	AuthServiceRegenerateRecoveryCodesProcedure = "/identity.v1.AuthService/RegenerateRecoveryCodes"
+	// AuthServiceStartOidcLoginProcedure is the fully-qualified name of the AuthService's
+	// StartOidcLogin RPC.
+	AuthServiceStartOidcLoginProcedure = "/identity.v1.AuthService/StartOidcLogin"
+	// AuthServiceCompleteOidcLoginProcedure is the fully-qualified name of the AuthService's
+	// CompleteOidcLogin RPC.
+	AuthServiceCompleteOidcLoginProcedure = "/identity.v1.AuthService/CompleteOidcLogin"
+	// AuthServiceStartOidcLinkProcedure is the fully-qualified name of the AuthService's StartOidcLink
+	// RPC.
+	AuthServiceStartOidcLinkProcedure = "/identity.v1.AuthService/StartOidcLink"
+	// AuthServiceListLinkedIdentitiesProcedure is the fully-qualified name of the AuthService's
+	// ListLinkedIdentities RPC.
+	AuthServiceListLinkedIdentitiesProcedure = "/identity.v1.AuthService/ListLinkedIdentities"
+	// AuthServiceUnlinkIdentityProcedure is the fully-qualified name of the AuthService's
+	// UnlinkIdentity RPC.
+	AuthServiceUnlinkIdentityProcedure = "/identity.v1.AuthService/UnlinkIdentity"
+	// AuthServiceSendAccountDeletionConfirmationProcedure is the fully-qualified name of the
+	// AuthService's SendAccountDeletionConfirmation RPC.
+	AuthServiceSendAccountDeletionConfirmationProcedure = "/identity.v1.AuthService/SendAccountDeletionConfirmation"
+	// AuthServiceDeleteAccountProcedure is the fully-qualified name of the AuthService's DeleteAccount
+	// RPC.
+	AuthServiceDeleteAccountProcedure = "/identity.v1.AuthService/DeleteAccount"
+	// AuthServiceCancelAccountDeletionProcedure is the fully-qualified name of the AuthService's
+	// CancelAccountDeletion RPC.
+	AuthServiceCancelAccountDeletionProcedure = "/identity.v1.AuthService/CancelAccountDeletion"
+	// AuthServiceExportMyDataProcedure is the fully-qualified name of the AuthService's ExportMyData
+	// RPC.
+	AuthServiceExportMyDataProcedure = "/identity.v1.AuthService/ExportMyData"
+	// AuthServiceRequestMagicLinkProcedure is the fully-qualified name of the AuthService's
+	// RequestMagicLink RPC.
+	AuthServiceRequestMagicLinkProcedure = "/identity.v1.AuthService/RequestMagicLink"
+	// AuthServiceConsumeMagicLinkProcedure is the fully-qualified name of the AuthService's
+	// ConsumeMagicLink RPC.
+	AuthServiceConsumeMagicLinkProcedure = "/identity.v1.AuthService/ConsumeMagicLink"
+	// AuthServiceAddRoleProcedure is the fully-qualified name of the AuthService's AddRole RPC.
+	AuthServiceAddRoleProcedure = "/identity.v1.AuthService/AddRole"
+	// AuthServiceSwitchRoleProcedure is the fully-qualified name of the AuthService's SwitchRole RPC.
+	AuthServiceSwitchRoleProcedure = "/identity.v1.AuthService/SwitchRole"
 )
 
 // AuthServiceClient is a client for the identity.v1.AuthService service.
@@ -58,6 +129,57 @@ type AuthServiceClient interface {
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
 	// GetMe returns the current authenticated user's information
 	GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error)
+	// SendVerificationEmail (re)sends the email verification link to the current user
+	SendVerificationEmail(context.Context, *connect.Request[v1.SendVerificationEmailRequest]) (*connect.Response[v1.SendVerificationEmailResponse], error)
+	// VerifyEmail confirms ownership of an email address using a token from the verification mail
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	// RequestPasswordReset mails a password reset link if the email belongs to an account
+	RequestPasswordReset(context.Context, *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error)
+	// ResetPassword sets a new password using a token from the reset mail
+	ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error)
+	// ChangePassword replaces the current user's password after re-verifying the old one
+	ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error)
+	// ListSessions returns the devices the current user is signed in on
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// RevokeSession signs out one of the current user's sessions
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	// RevokeOtherSessions signs out every session except the calling one
+	RevokeOtherSessions(context.Context, *connect.Request[v1.RevokeOtherSessionsRequest]) (*connect.Response[v1.RevokeOtherSessionsResponse], error)
+	// VerifyMfa completes a login that returned an MFA challenge
+	VerifyMfa(context.Context, *connect.Request[v1.VerifyMfaRequest]) (*connect.Response[v1.VerifyMfaResponse], error)
+	// BeginTotpEnrollment generates a TOTP secret for the current user
+	BeginTotpEnrollment(context.Context, *connect.Request[v1.BeginTotpEnrollmentRequest]) (*connect.Response[v1.BeginTotpEnrollmentResponse], error)
+	// ConfirmTotpEnrollment activates TOTP with a code from the authenticator app
+	ConfirmTotpEnrollment(context.Context, *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.ConfirmTotpEnrollmentResponse], error)
+	// RegenerateRecoveryCodes replaces the current user's MFA recovery codes
+	RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error)
+	// StartOidcLogin returns the identity provider URL to redirect the user to
+	StartOidcLogin(context.Context, *connect.Request[v1.StartOidcLoginRequest]) (*connect.Response[v1.StartOidcLoginResponse], error)
+	// CompleteOidcLogin handles the provider callback and signs in, links or registers the user
+	CompleteOidcLogin(context.Context, *connect.Request[v1.CompleteOidcLoginRequest]) (*connect.Response[v1.CompleteOidcLoginResponse], error)
+	// StartOidcLink starts linking an identity provider to the current user
+	StartOidcLink(context.Context, *connect.Request[v1.StartOidcLinkRequest]) (*connect.Response[v1.StartOidcLinkResponse], error)
+	// ListLinkedIdentities lists the identity providers linked to the current user
+	ListLinkedIdentities(context.Context, *connect.Request[v1.ListLinkedIdentitiesRequest]) (*connect.Response[v1.ListLinkedIdentitiesResponse], error)
+	// UnlinkIdentity removes a linked identity provider from the current user
+	UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error)
+	// SendAccountDeletionConfirmation mails a link that confirms DeleteAccount in
+	// place of the password, for accounts that never set one
+	SendAccountDeletionConfirmation(context.Context, *connect.Request[v1.SendAccountDeletionConfirmationRequest]) (*connect.Response[v1.SendAccountDeletionConfirmationResponse], error)
+	// DeleteAccount schedules the current user's account for deletion after a grace period
+	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
+	// CancelAccountDeletion keeps an account whose deletion is still pending
+	CancelAccountDeletion(context.Context, *connect.Request[v1.CancelAccountDeletionRequest]) (*connect.Response[v1.CancelAccountDeletionResponse], error)
+	// ExportMyData returns a JSON archive of the current user's data
+	ExportMyData(context.Context, *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error)
+	// RequestMagicLink mails a single-use sign-in link bound to the requesting device
+	RequestMagicLink(context.Context, *connect.Request[v1.RequestMagicLinkRequest]) (*connect.Response[v1.RequestMagicLinkResponse], error)
+	// ConsumeMagicLink signs in with a mailed link from the device that requested it
+	ConsumeMagicLink(context.Context, *connect.Request[v1.ConsumeMagicLinkRequest]) (*connect.Response[v1.ConsumeMagicLinkResponse], error)
+	// AddRole grants the current user another role, e.g. RESTAURANT to a chef who opens a shop
+	AddRole(context.Context, *connect.Request[v1.AddRoleRequest]) (*connect.Response[v1.AddRoleResponse], error)
+	// SwitchRole reissues the current session's tokens acting as another granted role
+	SwitchRole(context.Context, *connect.Request[v1.SwitchRoleRequest]) (*connect.Response[v1.SwitchRoleResponse], error)
 }
 
 // NewAuthServiceClient constructs a client for the identity.v1.AuthService service. By default, it
@@ -99,6 +221,160 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 			httpClient,
 			baseURL+AuthServiceGetMeProcedure,
 			connect.WithSchema(authServiceMethods.ByName("GetMe")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		sendVerificationEmail: connect.NewClient[v1.SendVerificationEmailRequest, v1.SendVerificationEmailResponse](
+			httpClient,
+			baseURL+AuthServiceSendVerificationEmailProcedure,
+			connect.WithSchema(authServiceMethods.ByName("SendVerificationEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyEmail: connect.NewClient[v1.VerifyEmailRequest, v1.VerifyEmailResponse](
+			httpClient,
+			baseURL+AuthServiceVerifyEmailProcedure,
+			connect.WithSchema(authServiceMethods.ByName("VerifyEmail")),
+			connect.WithClientOptions(opts...),
+		),
+		requestPasswordReset: connect.NewClient[v1.RequestPasswordResetRequest, v1.RequestPasswordResetResponse](
+			httpClient,
+			baseURL+AuthServiceRequestPasswordResetProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RequestPasswordReset")),
+			connect.WithClientOptions(opts...),
+		),
+		resetPassword: connect.NewClient[v1.ResetPasswordRequest, v1.ResetPasswordResponse](
+			httpClient,
+			baseURL+AuthServiceResetPasswordProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ResetPassword")),
+			connect.WithClientOptions(opts...),
+		),
+		changePassword: connect.NewClient[v1.ChangePasswordRequest, v1.ChangePasswordResponse](
+			httpClient,
+			baseURL+AuthServiceChangePasswordProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ChangePassword")),
+			connect.WithClientOptions(opts...),
+		),
+		listSessions: connect.NewClient[v1.ListSessionsRequest, v1.ListSessionsResponse](
+			httpClient,
+			baseURL+AuthServiceListSessionsProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListSessions")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		revokeSession: connect.NewClient[v1.RevokeSessionRequest, v1.RevokeSessionResponse](
+			httpClient,
+			baseURL+AuthServiceRevokeSessionProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RevokeSession")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeOtherSessions: connect.NewClient[v1.RevokeOtherSessionsRequest, v1.RevokeOtherSessionsResponse](
+			httpClient,
+			baseURL+AuthServiceRevokeOtherSessionsProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RevokeOtherSessions")),
+			connect.WithClientOptions(opts...),
+		),
+		verifyMfa: connect.NewClient[v1.VerifyMfaRequest, v1.VerifyMfaResponse](
+			httpClient,
+			baseURL+AuthServiceVerifyMfaProcedure,
+			connect.WithSchema(authServiceMethods.ByName("VerifyMfa")),
+			connect.WithClientOptions(opts...),
+		),
+		beginTotpEnrollment: connect.NewClient[v1.BeginTotpEnrollmentRequest, v1.BeginTotpEnrollmentResponse](
+			httpClient,
+			baseURL+AuthServiceBeginTotpEnrollmentProcedure,
+			connect.WithSchema(authServiceMethods.ByName("BeginTotpEnrollment")),
+			connect.WithClientOptions(opts...),
+		),
+		confirmTotpEnrollment: connect.NewClient[v1.ConfirmTotpEnrollmentRequest, v1.ConfirmTotpEnrollmentResponse](
+			httpClient,
+			baseURL+AuthServiceConfirmTotpEnrollmentProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ConfirmTotpEnrollment")),
+			connect.WithClientOptions(opts...),
+		),
+		regenerateRecoveryCodes: connect.NewClient[v1.RegenerateRecoveryCodesRequest, v1.RegenerateRecoveryCodesResponse](
+			httpClient,
+			baseURL+AuthServiceRegenerateRecoveryCodesProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RegenerateRecoveryCodes")),
+			connect.WithClientOptions(opts...),
+		),
+		startOidcLogin: connect.NewClient[v1.StartOidcLoginRequest, v1.StartOidcLoginResponse](
+			httpClient,
+			baseURL+AuthServiceStartOidcLoginProcedure,
+			connect.WithSchema(authServiceMethods.ByName("StartOidcLogin")),
+			connect.WithClientOptions(opts...),
+		),
+		completeOidcLogin: connect.NewClient[v1.CompleteOidcLoginRequest, v1.CompleteOidcLoginResponse](
+			httpClient,
+			baseURL+AuthServiceCompleteOidcLoginProcedure,
+			connect.WithSchema(authServiceMethods.ByName("CompleteOidcLogin")),
+			connect.WithClientOptions(opts...),
+		),
+		startOidcLink: connect.NewClient[v1.StartOidcLinkRequest, v1.StartOidcLinkResponse](
+			httpClient,
+			baseURL+AuthServiceStartOidcLinkProcedure,
+			connect.WithSchema(authServiceMethods.ByName("StartOidcLink")),
+			connect.WithClientOptions(opts...),
+		),
+		listLinkedIdentities: connect.NewClient[v1.ListLinkedIdentitiesRequest, v1.ListLinkedIdentitiesResponse](
+			httpClient,
+			baseURL+AuthServiceListLinkedIdentitiesProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ListLinkedIdentities")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		unlinkIdentity: connect.NewClient[v1.UnlinkIdentityRequest, v1.UnlinkIdentityResponse](
+			httpClient,
+			baseURL+AuthServiceUnlinkIdentityProcedure,
+			connect.WithSchema(authServiceMethods.ByName("UnlinkIdentity")),
+			connect.WithClientOptions(opts...),
+		),
+		sendAccountDeletionConfirmation: connect.NewClient[v1.SendAccountDeletionConfirmationRequest, v1.SendAccountDeletionConfirmationResponse](
+			httpClient,
+			baseURL+AuthServiceSendAccountDeletionConfirmationProcedure,
+			connect.WithSchema(authServiceMethods.ByName("SendAccountDeletionConfirmation")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAccount: connect.NewClient[v1.DeleteAccountRequest, v1.DeleteAccountResponse](
+			httpClient,
+			baseURL+AuthServiceDeleteAccountProcedure,
+			connect.WithSchema(authServiceMethods.ByName("DeleteAccount")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelAccountDeletion: connect.NewClient[v1.CancelAccountDeletionRequest, v1.CancelAccountDeletionResponse](
+			httpClient,
+			baseURL+AuthServiceCancelAccountDeletionProcedure,
+			connect.WithSchema(authServiceMethods.ByName("CancelAccountDeletion")),
+			connect.WithClientOptions(opts...),
+		),
+		exportMyData: connect.NewClient[v1.ExportMyDataRequest, v1.ExportMyDataResponse](
+			httpClient,
+			baseURL+AuthServiceExportMyDataProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ExportMyData")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		requestMagicLink: connect.NewClient[v1.RequestMagicLinkRequest, v1.RequestMagicLinkResponse](
+			httpClient,
+			baseURL+AuthServiceRequestMagicLinkProcedure,
+			connect.WithSchema(authServiceMethods.ByName("RequestMagicLink")),
+			connect.WithClientOptions(opts...),
+		),
+		consumeMagicLink: connect.NewClient[v1.ConsumeMagicLinkRequest, v1.ConsumeMagicLinkResponse](
+			httpClient,
+			baseURL+AuthServiceConsumeMagicLinkProcedure,
+			connect.WithSchema(authServiceMethods.ByName("ConsumeMagicLink")),
+			connect.WithClientOptions(opts...),
+		),
+		addRole: connect.NewClient[v1.AddRoleRequest, v1.AddRoleResponse](
+			httpClient,
+			baseURL+AuthServiceAddRoleProcedure,
+			connect.WithSchema(authServiceMethods.ByName("AddRole")),
+			connect.WithClientOptions(opts...),
+		),
+		switchRole: connect.NewClient[v1.SwitchRoleRequest, v1.SwitchRoleResponse](
+			httpClient,
+			baseURL+AuthServiceSwitchRoleProcedure,
+			connect.WithSchema(authServiceMethods.ByName("SwitchRole")),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -106,11 +382,36 @@ func NewAuthServiceClient(httpClient connect.HTTPClient, baseURL string, opts ..
 
 // authServiceClient implements AuthServiceClient.
 type authServiceClient struct {
-	register     *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
-	login        *connect.Client[v1.LoginRequest, v1.LoginResponse]
-	refreshToken *connect.Client[v1.RefreshTokenRequest, v1.RefreshTokenResponse]
-	logout       *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
-	getMe        *connect.Client[v1.GetMeRequest, v1.GetMeResponse]
+	register                        *connect.Client[v1.RegisterRequest, v1.RegisterResponse]
+	login                           *connect.Client[v1.LoginRequest, v1.LoginResponse]
+	refreshToken                    *connect.Client[v1.RefreshTokenRequest, v1.RefreshTokenResponse]
+	logout                          *connect.Client[v1.LogoutRequest, v1.LogoutResponse]
+	getMe                           *connect.Client[v1.GetMeRequest, v1.GetMeResponse]
+	sendVerificationEmail           *connect.Client[v1.SendVerificationEmailRequest, v1.SendVerificationEmailResponse]
+	verifyEmail                     *connect.Client[v1.VerifyEmailRequest, v1.VerifyEmailResponse]
+	requestPasswordReset            *connect.Client[v1.RequestPasswordResetRequest, v1.RequestPasswordResetResponse]
+	resetPassword                   *connect.Client[v1.ResetPasswordRequest, v1.ResetPasswordResponse]
+	changePassword                  *connect.Client[v1.ChangePasswordRequest, v1.ChangePasswordResponse]
+	listSessions                    *connect.Client[v1.ListSessionsRequest, v1.ListSessionsResponse]
+	revokeSession                   *connect.Client[v1.RevokeSessionRequest, v1.RevokeSessionResponse]
+	revokeOtherSessions             *connect.Client[v1.RevokeOtherSessionsRequest, v1.RevokeOtherSessionsResponse]
+	verifyMfa                       *connect.Client[v1.VerifyMfaRequest, v1.VerifyMfaResponse]
+	beginTotpEnrollment             *connect.Client[v1.BeginTotpEnrollmentRequest, v1.BeginTotpEnrollmentResponse]
+	confirmTotpEnrollment           *connect.Client[v1.ConfirmTotpEnrollmentRequest, v1.ConfirmTotpEnrollmentResponse]
+	regenerateRecoveryCodes         *connect.Client[v1.RegenerateRecoveryCodesRequest, v1.RegenerateRecoveryCodesResponse]
+	startOidcLogin                  *connect.Client[v1.StartOidcLoginRequest, v1.StartOidcLoginResponse]
+	completeOidcLogin               *connect.Client[v1.CompleteOidcLoginRequest, v1.CompleteOidcLoginResponse]
+	startOidcLink                   *connect.Client[v1.StartOidcLinkRequest, v1.StartOidcLinkResponse]
+	listLinkedIdentities            *connect.Client[v1.ListLinkedIdentitiesRequest, v1.ListLinkedIdentitiesResponse]
+	unlinkIdentity                  *connect.Client[v1.UnlinkIdentityRequest, v1.UnlinkIdentityResponse]
+	sendAccountDeletionConfirmation *connect.Client[v1.SendAccountDeletionConfirmationRequest, v1.SendAccountDeletionConfirmationResponse]
+	deleteAccount                   *connect.Client[v1.DeleteAccountRequest, v1.DeleteAccountResponse]
+	cancelAccountDeletion           *connect.Client[v1.CancelAccountDeletionRequest, v1.CancelAccountDeletionResponse]
+	exportMyData                    *connect.Client[v1.ExportMyDataRequest, v1.ExportMyDataResponse]
+	requestMagicLink                *connect.Client[v1.RequestMagicLinkRequest, v1.RequestMagicLinkResponse]
+	consumeMagicLink                *connect.Client[v1.ConsumeMagicLinkRequest, v1.ConsumeMagicLinkResponse]
+	addRole                         *connect.Client[v1.AddRoleRequest, v1.AddRoleResponse]
+	switchRole                      *connect.Client[v1.SwitchRoleRequest, v1.SwitchRoleResponse]
 }
 
 // Register calls identity.v1.AuthService.Register.
@@ -138,6 +439,131 @@ func (c *authServiceClient) GetMe(ctx context.Context, req *connect.Request[v1.G
 	return c.getMe.CallUnary(ctx, req)
 }
 
+// SendVerificationEmail calls identity.v1.AuthService.SendVerificationEmail.
+func (c *authServiceClient) SendVerificationEmail(ctx context.Context, req *connect.Request[v1.SendVerificationEmailRequest]) (*connect.Response[v1.SendVerificationEmailResponse], error) {
+	return c.sendVerificationEmail.CallUnary(ctx, req)
+}
+
+// VerifyEmail calls identity.v1.AuthService.VerifyEmail.
+func (c *authServiceClient) VerifyEmail(ctx context.Context, req *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return c.verifyEmail.CallUnary(ctx, req)
+}
+
+// RequestPasswordReset calls identity.v1.AuthService.RequestPasswordReset.
+func (c *authServiceClient) RequestPasswordReset(ctx context.Context, req *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error) {
+	return c.requestPasswordReset.CallUnary(ctx, req)
+}
+
+// ResetPassword calls identity.v1.AuthService.ResetPassword.
+func (c *authServiceClient) ResetPassword(ctx context.Context, req *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error) {
+	return c.resetPassword.CallUnary(ctx, req)
+}
+
+// ChangePassword calls identity.v1.AuthService.ChangePassword.
+func (c *authServiceClient) ChangePassword(ctx context.Context, req *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error) {
+	return c.changePassword.CallUnary(ctx, req)
+}
+
+// ListSessions calls identity.v1.AuthService.ListSessions.
+func (c *authServiceClient) ListSessions(ctx context.Context, req *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return c.listSessions.CallUnary(ctx, req)
+}
+
+// RevokeSession calls identity.v1.AuthService.RevokeSession.
+func (c *authServiceClient) RevokeSession(ctx context.Context, req *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return c.revokeSession.CallUnary(ctx, req)
+}
+
+// RevokeOtherSessions calls identity.v1.AuthService.RevokeOtherSessions.
+func (c *authServiceClient) RevokeOtherSessions(ctx context.Context, req *connect.Request[v1.RevokeOtherSessionsRequest]) (*connect.Response[v1.RevokeOtherSessionsResponse], error) {
+	return c.revokeOtherSessions.CallUnary(ctx, req)
+}
+
+// VerifyMfa calls identity.v1.AuthService.VerifyMfa.
+func (c *authServiceClient) VerifyMfa(ctx context.Context, req *connect.Request[v1.VerifyMfaRequest]) (*connect.Response[v1.VerifyMfaResponse], error) {
+	return c.verifyMfa.CallUnary(ctx, req)
+}
+
+// BeginTotpEnrollment calls identity.v1.AuthService.BeginTotpEnrollment.
+func (c *authServiceClient) BeginTotpEnrollment(ctx context.Context, req *connect.Request[v1.BeginTotpEnrollmentRequest]) (*connect.Response[v1.BeginTotpEnrollmentResponse], error) {
+	return c.beginTotpEnrollment.CallUnary(ctx, req)
+}
+
+// ConfirmTotpEnrollment calls identity.v1.AuthService.ConfirmTotpEnrollment.
+func (c *authServiceClient) ConfirmTotpEnrollment(ctx context.Context, req *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.ConfirmTotpEnrollmentResponse], error) {
+	return c.confirmTotpEnrollment.CallUnary(ctx, req)
+}
+
+// RegenerateRecoveryCodes calls identity.v1.AuthService.RegenerateRecoveryCodes.
+func (c *authServiceClient) RegenerateRecoveryCodes(ctx context.Context, req *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error) {
+	return c.regenerateRecoveryCodes.CallUnary(ctx, req)
+}
+
+// StartOidcLogin calls identity.v1.AuthService.StartOidcLogin.
+func (c *authServiceClient) StartOidcLogin(ctx context.Context, req *connect.Request[v1.StartOidcLoginRequest]) (*connect.Response[v1.StartOidcLoginResponse], error) {
+	return c.startOidcLogin.CallUnary(ctx, req)
+}
+
+// CompleteOidcLogin calls identity.v1.AuthService.CompleteOidcLogin.
+func (c *authServiceClient) CompleteOidcLogin(ctx context.Context, req *connect.Request[v1.CompleteOidcLoginRequest]) (*connect.Response[v1.CompleteOidcLoginResponse], error) {
+	return c.completeOidcLogin.CallUnary(ctx, req)
+}
+
+// StartOidcLink calls identity.v1.AuthService.StartOidcLink.
+func (c *authServiceClient) StartOidcLink(ctx context.Context, req *connect.Request[v1.StartOidcLinkRequest]) (*connect.Response[v1.StartOidcLinkResponse], error) {
+	return c.startOidcLink.CallUnary(ctx, req)
+}
+
+// ListLinkedIdentities calls identity.v1.AuthService.ListLinkedIdentities.
+func (c *authServiceClient) ListLinkedIdentities(ctx context.Context, req *connect.Request[v1.ListLinkedIdentitiesRequest]) (*connect.Response[v1.ListLinkedIdentitiesResponse], error) {
+	return c.listLinkedIdentities.CallUnary(ctx, req)
+}
+
+// UnlinkIdentity calls identity.v1.AuthService.UnlinkIdentity.
+func (c *authServiceClient) UnlinkIdentity(ctx context.Context, req *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error) {
+	return c.unlinkIdentity.CallUnary(ctx, req)
+}
+
+// SendAccountDeletionConfirmation calls identity.v1.AuthService.SendAccountDeletionConfirmation.
+func (c *authServiceClient) SendAccountDeletionConfirmation(ctx context.Context, req *connect.Request[v1.SendAccountDeletionConfirmationRequest]) (*connect.Response[v1.SendAccountDeletionConfirmationResponse], error) {
+	return c.sendAccountDeletionConfirmation.CallUnary(ctx, req)
+}
+
+// DeleteAccount calls identity.v1.AuthService.DeleteAccount.
+func (c *authServiceClient) DeleteAccount(ctx context.Context, req *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error) {
+	return c.deleteAccount.CallUnary(ctx, req)
+}
+
+// CancelAccountDeletion calls identity.v1.AuthService.CancelAccountDeletion.
+func (c *authServiceClient) CancelAccountDeletion(ctx context.Context, req *connect.Request[v1.CancelAccountDeletionRequest]) (*connect.Response[v1.CancelAccountDeletionResponse], error) {
+	return c.cancelAccountDeletion.CallUnary(ctx, req)
+}
+
+// ExportMyData calls identity.v1.AuthService.ExportMyData.
+func (c *authServiceClient) ExportMyData(ctx context.Context, req *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error) {
+	return c.exportMyData.CallUnary(ctx, req)
+}
+
+// RequestMagicLink calls identity.v1.AuthService.RequestMagicLink.
+func (c *authServiceClient) RequestMagicLink(ctx context.Context, req *connect.Request[v1.RequestMagicLinkRequest]) (*connect.Response[v1.RequestMagicLinkResponse], error) {
+	return c.requestMagicLink.CallUnary(ctx, req)
+}
+
+// ConsumeMagicLink calls identity.v1.AuthService.ConsumeMagicLink.
+func (c *authServiceClient) ConsumeMagicLink(ctx context.Context, req *connect.Request[v1.ConsumeMagicLinkRequest]) (*connect.Response[v1.ConsumeMagicLinkResponse], error) {
+	return c.consumeMagicLink.CallUnary(ctx, req)
+}
+
+// AddRole calls identity.v1.AuthService.AddRole.
+func (c *authServiceClient) AddRole(ctx context.Context, req *connect.Request[v1.AddRoleRequest]) (*connect.Response[v1.AddRoleResponse], error) {
+	return c.addRole.CallUnary(ctx, req)
+}
+
+// SwitchRole calls identity.v1.AuthService.SwitchRole.
+func (c *authServiceClient) SwitchRole(ctx context.Context, req *connect.Request[v1.SwitchRoleRequest]) (*connect.Response[v1.SwitchRoleResponse], error) {
+	return c.switchRole.CallUnary(ctx, req)
+}
+
 // AuthServiceHandler is an implementation of the identity.v1.AuthService service.
 type AuthServiceHandler interface {
 	// Register creates a new user account
@@ -150,6 +576,57 @@ type AuthServiceHandler interface {
 	Logout(context.Context, *connect.Request[v1.LogoutRequest]) (*connect.Response[v1.LogoutResponse], error)
 	// GetMe returns the current authenticated user's information
 	GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error)
+	// SendVerificationEmail (re)sends the email verification link to the current user
+	SendVerificationEmail(context.Context, *connect.Request[v1.SendVerificationEmailRequest]) (*connect.Response[v1.SendVerificationEmailResponse], error)
+	// VerifyEmail confirms ownership of an email address using a token from the verification mail
+	VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error)
+	// RequestPasswordReset mails a password reset link if the email belongs to an account
+	RequestPasswordReset(context.Context, *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error)
+	// ResetPassword sets a new password using a token from the reset mail
+	ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error)
+	// ChangePassword replaces the current user's password after re-verifying the old one
+	ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error)
+	// ListSessions returns the devices the current user is signed in on
+	ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error)
+	// RevokeSession signs out one of the current user's sessions
+	RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error)
+	// RevokeOtherSessions signs out every session except the calling one
+	RevokeOtherSessions(context.Context, *connect.Request[v1.RevokeOtherSessionsRequest]) (*connect.Response[v1.RevokeOtherSessionsResponse], error)
+	// VerifyMfa completes a login that returned an MFA challenge
+	VerifyMfa(context.Context, *connect.Request[v1.VerifyMfaRequest]) (*connect.Response[v1.VerifyMfaResponse], error)
+	// BeginTotpEnrollment generates a TOTP secret for the current user
+	BeginTotpEnrollment(context.Context, *connect.Request[v1.BeginTotpEnrollmentRequest]) (*connect.Response[v1.BeginTotpEnrollmentResponse], error)
+	// ConfirmTotpEnrollment activates TOTP with a code from the authenticator app
+	ConfirmTotpEnrollment(context.Context, *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.ConfirmTotpEnrollmentResponse], error)
+	// RegenerateRecoveryCodes replaces the current user's MFA recovery codes
+	RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error)
+	// StartOidcLogin returns the identity provider URL to redirect the user to
+	StartOidcLogin(context.Context, *connect.Request[v1.StartOidcLoginRequest]) (*connect.Response[v1.StartOidcLoginResponse], error)
+	// CompleteOidcLogin handles the provider callback and signs in, links or registers the user
+	CompleteOidcLogin(context.Context, *connect.Request[v1.CompleteOidcLoginRequest]) (*connect.Response[v1.CompleteOidcLoginResponse], error)
+	// StartOidcLink starts linking an identity provider to the current user
+	StartOidcLink(context.Context, *connect.Request[v1.StartOidcLinkRequest]) (*connect.Response[v1.StartOidcLinkResponse], error)
+	// ListLinkedIdentities lists the identity providers linked to the current user
+	ListLinkedIdentities(context.Context, *connect.Request[v1.ListLinkedIdentitiesRequest]) (*connect.Response[v1.ListLinkedIdentitiesResponse], error)
+	// UnlinkIdentity removes a linked identity provider from the current user
+	UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error)
+	// SendAccountDeletionConfirmation mails a link that confirms DeleteAccount in
+	// place of the password, for accounts that never set one
+	SendAccountDeletionConfirmation(context.Context, *connect.Request[v1.SendAccountDeletionConfirmationRequest]) (*connect.Response[v1.SendAccountDeletionConfirmationResponse], error)
+	// DeleteAccount schedules the current user's account for deletion after a grace period
+	DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error)
+	// CancelAccountDeletion keeps an account whose deletion is still pending
+	CancelAccountDeletion(context.Context, *connect.Request[v1.CancelAccountDeletionRequest]) (*connect.Response[v1.CancelAccountDeletionResponse], error)
+	// ExportMyData returns a JSON archive of the current user's data
+	ExportMyData(context.Context, *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error)
+	// RequestMagicLink mails a single-use sign-in link bound to the requesting device
+	RequestMagicLink(context.Context, *connect.Request[v1.RequestMagicLinkRequest]) (*connect.Response[v1.RequestMagicLinkResponse], error)
+	// ConsumeMagicLink signs in with a mailed link from the device that requested it
+	ConsumeMagicLink(context.Context, *connect.Request[v1.ConsumeMagicLinkRequest]) (*connect.Response[v1.ConsumeMagicLinkResponse], error)
+	// AddRole grants the current user another role, e.g. RESTAURANT to a chef who opens a shop
+	AddRole(context.Context, *connect.Request[v1.AddRoleRequest]) (*connect.Response[v1.AddRoleResponse], error)
+	// SwitchRole reissues the current session's tokens acting as another granted role
+	SwitchRole(context.Context, *connect.Request[v1.SwitchRoleRequest]) (*connect.Response[v1.SwitchRoleResponse], error)
 }
 
 // NewAuthServiceHandler builds an HTTP handler from the service implementation. It returns the path
@@ -187,6 +664,160 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 		AuthServiceGetMeProcedure,
 		svc.GetMe,
 		connect.WithSchema(authServiceMethods.ByName("GetMe")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceSendVerificationEmailHandler := connect.NewUnaryHandler(
+		AuthServiceSendVerificationEmailProcedure,
+		svc.SendVerificationEmail,
+		connect.WithSchema(authServiceMethods.ByName("SendVerificationEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceVerifyEmailHandler := connect.NewUnaryHandler(
+		AuthServiceVerifyEmailProcedure,
+		svc.VerifyEmail,
+		connect.WithSchema(authServiceMethods.ByName("VerifyEmail")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRequestPasswordResetHandler := connect.NewUnaryHandler(
+		AuthServiceRequestPasswordResetProcedure,
+		svc.RequestPasswordReset,
+		connect.WithSchema(authServiceMethods.ByName("RequestPasswordReset")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceResetPasswordHandler := connect.NewUnaryHandler(
+		AuthServiceResetPasswordProcedure,
+		svc.ResetPassword,
+		connect.WithSchema(authServiceMethods.ByName("ResetPassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceChangePasswordHandler := connect.NewUnaryHandler(
+		AuthServiceChangePasswordProcedure,
+		svc.ChangePassword,
+		connect.WithSchema(authServiceMethods.ByName("ChangePassword")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceListSessionsHandler := connect.NewUnaryHandler(
+		AuthServiceListSessionsProcedure,
+		svc.ListSessions,
+		connect.WithSchema(authServiceMethods.ByName("ListSessions")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRevokeSessionHandler := connect.NewUnaryHandler(
+		AuthServiceRevokeSessionProcedure,
+		svc.RevokeSession,
+		connect.WithSchema(authServiceMethods.ByName("RevokeSession")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRevokeOtherSessionsHandler := connect.NewUnaryHandler(
+		AuthServiceRevokeOtherSessionsProcedure,
+		svc.RevokeOtherSessions,
+		connect.WithSchema(authServiceMethods.ByName("RevokeOtherSessions")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceVerifyMfaHandler := connect.NewUnaryHandler(
+		AuthServiceVerifyMfaProcedure,
+		svc.VerifyMfa,
+		connect.WithSchema(authServiceMethods.ByName("VerifyMfa")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceBeginTotpEnrollmentHandler := connect.NewUnaryHandler(
+		AuthServiceBeginTotpEnrollmentProcedure,
+		svc.BeginTotpEnrollment,
+		connect.WithSchema(authServiceMethods.ByName("BeginTotpEnrollment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceConfirmTotpEnrollmentHandler := connect.NewUnaryHandler(
+		AuthServiceConfirmTotpEnrollmentProcedure,
+		svc.ConfirmTotpEnrollment,
+		connect.WithSchema(authServiceMethods.ByName("ConfirmTotpEnrollment")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRegenerateRecoveryCodesHandler := connect.NewUnaryHandler(
+		AuthServiceRegenerateRecoveryCodesProcedure,
+		svc.RegenerateRecoveryCodes,
+		connect.WithSchema(authServiceMethods.ByName("RegenerateRecoveryCodes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceStartOidcLoginHandler := connect.NewUnaryHandler(
+		AuthServiceStartOidcLoginProcedure,
+		svc.StartOidcLogin,
+		connect.WithSchema(authServiceMethods.ByName("StartOidcLogin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceCompleteOidcLoginHandler := connect.NewUnaryHandler(
+		AuthServiceCompleteOidcLoginProcedure,
+		svc.CompleteOidcLogin,
+		connect.WithSchema(authServiceMethods.ByName("CompleteOidcLogin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceStartOidcLinkHandler := connect.NewUnaryHandler(
+		AuthServiceStartOidcLinkProcedure,
+		svc.StartOidcLink,
+		connect.WithSchema(authServiceMethods.ByName("StartOidcLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceListLinkedIdentitiesHandler := connect.NewUnaryHandler(
+		AuthServiceListLinkedIdentitiesProcedure,
+		svc.ListLinkedIdentities,
+		connect.WithSchema(authServiceMethods.ByName("ListLinkedIdentities")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceUnlinkIdentityHandler := connect.NewUnaryHandler(
+		AuthServiceUnlinkIdentityProcedure,
+		svc.UnlinkIdentity,
+		connect.WithSchema(authServiceMethods.ByName("UnlinkIdentity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceSendAccountDeletionConfirmationHandler := connect.NewUnaryHandler(
+		AuthServiceSendAccountDeletionConfirmationProcedure,
+		svc.SendAccountDeletionConfirmation,
+		connect.WithSchema(authServiceMethods.ByName("SendAccountDeletionConfirmation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceDeleteAccountHandler := connect.NewUnaryHandler(
+		AuthServiceDeleteAccountProcedure,
+		svc.DeleteAccount,
+		connect.WithSchema(authServiceMethods.ByName("DeleteAccount")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceCancelAccountDeletionHandler := connect.NewUnaryHandler(
+		AuthServiceCancelAccountDeletionProcedure,
+		svc.CancelAccountDeletion,
+		connect.WithSchema(authServiceMethods.ByName("CancelAccountDeletion")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceExportMyDataHandler := connect.NewUnaryHandler(
+		AuthServiceExportMyDataProcedure,
+		svc.ExportMyData,
+		connect.WithSchema(authServiceMethods.ByName("ExportMyData")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceRequestMagicLinkHandler := connect.NewUnaryHandler(
+		AuthServiceRequestMagicLinkProcedure,
+		svc.RequestMagicLink,
+		connect.WithSchema(authServiceMethods.ByName("RequestMagicLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceConsumeMagicLinkHandler := connect.NewUnaryHandler(
+		AuthServiceConsumeMagicLinkProcedure,
+		svc.ConsumeMagicLink,
+		connect.WithSchema(authServiceMethods.ByName("ConsumeMagicLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceAddRoleHandler := connect.NewUnaryHandler(
+		AuthServiceAddRoleProcedure,
+		svc.AddRole,
+		connect.WithSchema(authServiceMethods.ByName("AddRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	authServiceSwitchRoleHandler := connect.NewUnaryHandler(
+		AuthServiceSwitchRoleProcedure,
+		svc.SwitchRole,
+		connect.WithSchema(authServiceMethods.ByName("SwitchRole")),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/identity.v1.AuthService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -201,6 +832,56 @@ func NewAuthServiceHandler(svc AuthServiceHandler, opts ...connect.HandlerOption
 			authServiceLogoutHandler.ServeHTTP(w, r)
 		case AuthServiceGetMeProcedure:
 			authServiceGetMeHandler.ServeHTTP(w, r)
+		case AuthServiceSendVerificationEmailProcedure:
+			authServiceSendVerificationEmailHandler.ServeHTTP(w, r)
+		case AuthServiceVerifyEmailProcedure:
+			authServiceVerifyEmailHandler.ServeHTTP(w, r)
+		case AuthServiceRequestPasswordResetProcedure:
+			authServiceRequestPasswordResetHandler.ServeHTTP(w, r)
+		case AuthServiceResetPasswordProcedure:
+			authServiceResetPasswordHandler.ServeHTTP(w, r)
+		case AuthServiceChangePasswordProcedure:
+			authServiceChangePasswordHandler.ServeHTTP(w, r)
+		case AuthServiceListSessionsProcedure:
+			authServiceListSessionsHandler.ServeHTTP(w, r)
+		case AuthServiceRevokeSessionProcedure:
+			authServiceRevokeSessionHandler.ServeHTTP(w, r)
+		case AuthServiceRevokeOtherSessionsProcedure:
+			authServiceRevokeOtherSessionsHandler.ServeHTTP(w, r)
+		case AuthServiceVerifyMfaProcedure:
+			authServiceVerifyMfaHandler.ServeHTTP(w, r)
+		case AuthServiceBeginTotpEnrollmentProcedure:
+			authServiceBeginTotpEnrollmentHandler.ServeHTTP(w, r)
+		case AuthServiceConfirmTotpEnrollmentProcedure:
+			authServiceConfirmTotpEnrollmentHandler.ServeHTTP(w, r)
+		case AuthServiceRegenerateRecoveryCodesProcedure:
+			authServiceRegenerateRecoveryCodesHandler.ServeHTTP(w, r)
+		case AuthServiceStartOidcLoginProcedure:
+			authServiceStartOidcLoginHandler.ServeHTTP(w, r)
+		case AuthServiceCompleteOidcLoginProcedure:
+			authServiceCompleteOidcLoginHandler.ServeHTTP(w, r)
+		case AuthServiceStartOidcLinkProcedure:
+			authServiceStartOidcLinkHandler.ServeHTTP(w, r)
+		case AuthServiceListLinkedIdentitiesProcedure:
+			authServiceListLinkedIdentitiesHandler.ServeHTTP(w, r)
+		case AuthServiceUnlinkIdentityProcedure:
+			authServiceUnlinkIdentityHandler.ServeHTTP(w, r)
+		case AuthServiceSendAccountDeletionConfirmationProcedure:
+			authServiceSendAccountDeletionConfirmationHandler.ServeHTTP(w, r)
+		case AuthServiceDeleteAccountProcedure:
+			authServiceDeleteAccountHandler.ServeHTTP(w, r)
+		case AuthServiceCancelAccountDeletionProcedure:
+			authServiceCancelAccountDeletionHandler.ServeHTTP(w, r)
+		case AuthServiceExportMyDataProcedure:
+			authServiceExportMyDataHandler.ServeHTTP(w, r)
+		case AuthServiceRequestMagicLinkProcedure:
+			authServiceRequestMagicLinkHandler.ServeHTTP(w, r)
+		case AuthServiceConsumeMagicLinkProcedure:
+			authServiceConsumeMagicLinkHandler.ServeHTTP(w, r)
+		case AuthServiceAddRoleProcedure:
+			authServiceAddRoleHandler.ServeHTTP(w, r)
+		case AuthServiceSwitchRoleProcedure:
+			authServiceSwitchRoleHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -229,3 +910,103 @@ func (UnimplementedAuthServiceHandler) Logout(context.Context, *connect.Request[
 func (UnimplementedAuthServiceHandler) GetMe(context.Context, *connect.Request[v1.GetMeRequest]) (*connect.Response[v1.GetMeResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.GetMe is not implemented"))
 }
+
+func (UnimplementedAuthServiceHandler) SendVerificationEmail(context.Context, *connect.Request[v1.SendVerificationEmailRequest]) (*connect.Response[v1.SendVerificationEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.SendVerificationEmail is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) VerifyEmail(context.Context, *connect.Request[v1.VerifyEmailRequest]) (*connect.Response[v1.VerifyEmailResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.VerifyEmail is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RequestPasswordReset(context.Context, *connect.Request[v1.RequestPasswordResetRequest]) (*connect.Response[v1.RequestPasswordResetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.RequestPasswordReset is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ResetPassword(context.Context, *connect.Request[v1.ResetPasswordRequest]) (*connect.Response[v1.ResetPasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.ResetPassword is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ChangePassword(context.Context, *connect.Request[v1.ChangePasswordRequest]) (*connect.Response[v1.ChangePasswordResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.ChangePassword is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ListSessions(context.Context, *connect.Request[v1.ListSessionsRequest]) (*connect.Response[v1.ListSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.ListSessions is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RevokeSession(context.Context, *connect.Request[v1.RevokeSessionRequest]) (*connect.Response[v1.RevokeSessionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.RevokeSession is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RevokeOtherSessions(context.Context, *connect.Request[v1.RevokeOtherSessionsRequest]) (*connect.Response[v1.RevokeOtherSessionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.RevokeOtherSessions is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) VerifyMfa(context.Context, *connect.Request[v1.VerifyMfaRequest]) (*connect.Response[v1.VerifyMfaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.VerifyMfa is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) BeginTotpEnrollment(context.Context, *connect.Request[v1.BeginTotpEnrollmentRequest]) (*connect.Response[v1.BeginTotpEnrollmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.BeginTotpEnrollment is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ConfirmTotpEnrollment(context.Context, *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.ConfirmTotpEnrollmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.ConfirmTotpEnrollment is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RegenerateRecoveryCodes(context.Context, *connect.Request[v1.RegenerateRecoveryCodesRequest]) (*connect.Response[v1.RegenerateRecoveryCodesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.RegenerateRecoveryCodes is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) StartOidcLogin(context.Context, *connect.Request[v1.StartOidcLoginRequest]) (*connect.Response[v1.StartOidcLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.StartOidcLogin is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) CompleteOidcLogin(context.Context, *connect.Request[v1.CompleteOidcLoginRequest]) (*connect.Response[v1.CompleteOidcLoginResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.CompleteOidcLogin is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) StartOidcLink(context.Context, *connect.Request[v1.StartOidcLinkRequest]) (*connect.Response[v1.StartOidcLinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.StartOidcLink is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ListLinkedIdentities(context.Context, *connect.Request[v1.ListLinkedIdentitiesRequest]) (*connect.Response[v1.ListLinkedIdentitiesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.ListLinkedIdentities is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) UnlinkIdentity(context.Context, *connect.Request[v1.UnlinkIdentityRequest]) (*connect.Response[v1.UnlinkIdentityResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.UnlinkIdentity is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) SendAccountDeletionConfirmation(context.Context, *connect.Request[v1.SendAccountDeletionConfirmationRequest]) (*connect.Response[v1.SendAccountDeletionConfirmationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.SendAccountDeletionConfirmation is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) DeleteAccount(context.Context, *connect.Request[v1.DeleteAccountRequest]) (*connect.Response[v1.DeleteAccountResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.DeleteAccount is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) CancelAccountDeletion(context.Context, *connect.Request[v1.CancelAccountDeletionRequest]) (*connect.Response[v1.CancelAccountDeletionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.CancelAccountDeletion is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ExportMyData(context.Context, *connect.Request[v1.ExportMyDataRequest]) (*connect.Response[v1.ExportMyDataResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.ExportMyData is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) RequestMagicLink(context.Context, *connect.Request[v1.RequestMagicLinkRequest]) (*connect.Response[v1.RequestMagicLinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.RequestMagicLink is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) ConsumeMagicLink(context.Context, *connect.Request[v1.ConsumeMagicLinkRequest]) (*connect.Response[v1.ConsumeMagicLinkResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.ConsumeMagicLink is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) AddRole(context.Context, *connect.Request[v1.AddRoleRequest]) (*connect.Response[v1.AddRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.AddRole is not implemented"))
+}
+
+func (UnimplementedAuthServiceHandler) SwitchRole(context.Context, *connect.Request[v1.SwitchRoleRequest]) (*connect.Response[v1.SwitchRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("identity.v1.AuthService.SwitchRole is not implemented"))
+}
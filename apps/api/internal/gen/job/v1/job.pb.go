@@ -7,6 +7,8 @@
 package jobv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/chefnext/chefnext/apps/api/internal/gen/chefnext/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -578,9 +580,10 @@ type CreateJobRequest struct {
 	SalaryRange    string                 `protobuf:"bytes,5,opt,name=salary_range,json=salaryRange,proto3" json:"salary_range,omitempty"`
 	EmploymentType string                 `protobuf:"bytes,6,opt,name=employment_type,json=employmentType,proto3" json:"employment_type,omitempty"`
 	Status         JobStatus              `protobuf:"varint,7,opt,name=status,proto3,enum=job.v1.JobStatus" json:"status,omitempty"`
-	MetadataJson   string                 `protobuf:"bytes,8,opt,name=metadata_json,json=metadataJson,proto3" json:"metadata_json,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Must be valid JSON; checked by the handler
+	MetadataJson  string `protobuf:"bytes,8,opt,name=metadata_json,json=metadataJson,proto3" json:"metadata_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateJobRequest) Reset() {
@@ -713,6 +716,8 @@ func (x *CreateJobResponse) GetJob() *Job {
 	return nil
 }
 
+// UpdateJobRequest changes the given fields; empty values and lists leave a
+// field unchanged
 type UpdateJobRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	JobId          string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
@@ -723,9 +728,10 @@ type UpdateJobRequest struct {
 	SalaryRange    string                 `protobuf:"bytes,6,opt,name=salary_range,json=salaryRange,proto3" json:"salary_range,omitempty"`
 	EmploymentType string                 `protobuf:"bytes,7,opt,name=employment_type,json=employmentType,proto3" json:"employment_type,omitempty"`
 	Status         JobStatus              `protobuf:"varint,8,opt,name=status,proto3,enum=job.v1.JobStatus" json:"status,omitempty"`
-	MetadataJson   string                 `protobuf:"bytes,9,opt,name=metadata_json,json=metadataJson,proto3" json:"metadata_json,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// Must be valid JSON; checked by the handler
+	MetadataJson  string `protobuf:"bytes,9,opt,name=metadata_json,json=metadataJson,proto3" json:"metadata_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateJobRequest) Reset() {
@@ -1573,7 +1579,7 @@ var File_job_v1_job_proto protoreflect.FileDescriptor
 
 const file_job_v1_job_proto_rawDesc = "" +
 	"\n" +
-	"\x10job/v1/job.proto\x12\x06job.v1\"|\n" +
+	"\x10job/v1/job.proto\x12\x06job.v1\x1a\x1bbuf/validate/validate.proto\x1a\x16chefnext/v1/auth.proto\"|\n" +
 	"\x11RestaurantSummary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
 	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x18\n" +
@@ -1620,32 +1626,36 @@ const file_job_v1_job_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\a \x01(\tR\tupdatedAt\x12$\n" +
 	"\x03job\x18\b \x01(\v2\x12.job.v1.JobSummaryR\x03job\x12'\n" +
-	"\x04chef\x18\t \x01(\v2\x13.job.v1.ChefSummaryR\x04chef\"\xab\x02\n" +
-	"\x10CreateJobRequest\x12\x14\n" +
-	"\x05title\x18\x01 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\x12'\n" +
-	"\x0frequired_skills\x18\x03 \x03(\tR\x0erequiredSkills\x12\x1a\n" +
-	"\blocation\x18\x04 \x01(\tR\blocation\x12!\n" +
-	"\fsalary_range\x18\x05 \x01(\tR\vsalaryRange\x12'\n" +
-	"\x0femployment_type\x18\x06 \x01(\tR\x0eemploymentType\x12)\n" +
-	"\x06status\x18\a \x01(\x0e2\x11.job.v1.JobStatusR\x06status\x12#\n" +
-	"\rmetadata_json\x18\b \x01(\tR\fmetadataJson\"2\n" +
+	"\x04chef\x18\t \x01(\v2\x13.job.v1.ChefSummaryR\x04chef\"\xc4\x03\n" +
+	"\x10CreateJobRequest\x12a\n" +
+	"\x05title\x18\x01 \x01(\tBK\xbaHH\xba\x01@\n" +
+	"\x10string.not_blank\x12\x17value must not be blank\x1a\x13this.matches('\\\\S')r\x03\x18\xc8\x01R\x05title\x12*\n" +
+	"\vdescription\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x90NR\vdescription\x129\n" +
+	"\x0frequired_skills\x18\x03 \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x1e\"\x06r\x04\x10\x01\x182R\x0erequiredSkills\x12#\n" +
+	"\blocation\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18dR\blocation\x12*\n" +
+	"\fsalary_range\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18dR\vsalaryRange\x120\n" +
+	"\x0femployment_type\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x182R\x0eemploymentType\x123\n" +
+	"\x06status\x18\a \x01(\x0e2\x11.job.v1.JobStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12.\n" +
+	"\rmetadata_json\x18\b \x01(\tB\t\xbaH\x06r\x04(\x80\x80\x04R\fmetadataJson\"2\n" +
 	"\x11CreateJobResponse\x12\x1d\n" +
-	"\x03job\x18\x01 \x01(\v2\v.job.v1.JobR\x03job\"\xc2\x02\n" +
-	"\x10UpdateJobRequest\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\x12'\n" +
-	"\x0frequired_skills\x18\x04 \x03(\tR\x0erequiredSkills\x12\x1a\n" +
-	"\blocation\x18\x05 \x01(\tR\blocation\x12!\n" +
-	"\fsalary_range\x18\x06 \x01(\tR\vsalaryRange\x12'\n" +
-	"\x0femployment_type\x18\a \x01(\tR\x0eemploymentType\x12)\n" +
-	"\x06status\x18\b \x01(\x0e2\x11.job.v1.JobStatusR\x06status\x12#\n" +
-	"\rmetadata_json\x18\t \x01(\tR\fmetadataJson\"2\n" +
+	"\x03job\x18\x01 \x01(\v2\v.job.v1.JobR\x03job\"\xe8\x03\n" +
+	"\x10UpdateJobRequest\x12\x1f\n" +
+	"\x06job_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x05jobId\x12d\n" +
+	"\x05title\x18\x02 \x01(\tBN\xbaHK\xba\x01@\n" +
+	"\x10string.not_blank\x12\x17value must not be blank\x1a\x13this.matches('\\\\S')\xd8\x01\x01r\x03\x18\xc8\x01R\x05title\x12*\n" +
+	"\vdescription\x18\x03 \x01(\tB\b\xbaH\x05r\x03\x18\x90NR\vdescription\x129\n" +
+	"\x0frequired_skills\x18\x04 \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x1e\"\x06r\x04\x10\x01\x182R\x0erequiredSkills\x12#\n" +
+	"\blocation\x18\x05 \x01(\tB\a\xbaH\x04r\x02\x18dR\blocation\x12*\n" +
+	"\fsalary_range\x18\x06 \x01(\tB\a\xbaH\x04r\x02\x18dR\vsalaryRange\x120\n" +
+	"\x0femployment_type\x18\a \x01(\tB\a\xbaH\x04r\x02\x182R\x0eemploymentType\x123\n" +
+	"\x06status\x18\b \x01(\x0e2\x11.job.v1.JobStatusB\b\xbaH\x05\x82\x01\x02\x10\x01R\x06status\x12.\n" +
+	"\rmetadata_json\x18\t \x01(\tB\t\xbaH\x06r\x04(\x80\x80\x04R\fmetadataJson\"2\n" +
 	"\x11UpdateJobResponse\x12\x1d\n" +
-	"\x03job\x18\x01 \x01(\v2\v.job.v1.JobR\x03job\"&\n" +
-	"\rGetJobRequest\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"/\n" +
+	"\x03job\x18\x01 \x01(\v2\v.job.v1.JobR\x03job\"0\n" +
+	"\rGetJobRequest\x12\x1f\n" +
+	"\x06job_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x05jobId\"/\n" +
 	"\x0eGetJobResponse\x12\x1d\n" +
 	"\x03job\x18\x01 \x01(\v2\v.job.v1.JobR\x03job\"A\n" +
 	"\x11ListMyJobsRequest\x12\x14\n" +
@@ -1654,20 +1664,20 @@ const file_job_v1_job_proto_rawDesc = "" +
 	"\x12ListMyJobsResponse\x12\x1f\n" +
 	"\x04jobs\x18\x01 \x03(\v2\v.job.v1.JobR\x04jobs\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x03R\n" +
-	"totalCount\"\xa0\x01\n" +
-	"\x11SearchJobsRequest\x12\x18\n" +
-	"\akeyword\x18\x01 \x01(\tR\akeyword\x12'\n" +
-	"\x0frequired_skills\x18\x02 \x03(\tR\x0erequiredSkills\x12\x1a\n" +
-	"\blocation\x18\x03 \x01(\tR\blocation\x12\x14\n" +
+	"totalCount\"\xbd\x01\n" +
+	"\x11SearchJobsRequest\x12\"\n" +
+	"\akeyword\x18\x01 \x01(\tB\b\xbaH\x05r\x03\x18\xc8\x01R\akeyword\x121\n" +
+	"\x0frequired_skills\x18\x02 \x03(\tB\b\xbaH\x05\x92\x01\x02\x10\x1eR\x0erequiredSkills\x12#\n" +
+	"\blocation\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18dR\blocation\x12\x14\n" +
 	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x05 \x01(\x05R\x06offset\"V\n" +
 	"\x12SearchJobsResponse\x12\x1f\n" +
 	"\x04jobs\x18\x01 \x03(\v2\v.job.v1.JobR\x04jobs\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x03R\n" +
-	"totalCount\"T\n" +
-	"\x18CreateApplicationRequest\x12\x15\n" +
-	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12!\n" +
-	"\fcover_letter\x18\x02 \x01(\tR\vcoverLetter\"U\n" +
+	"totalCount\"h\n" +
+	"\x18CreateApplicationRequest\x12\x1f\n" +
+	"\x06job_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x05jobId\x12+\n" +
+	"\fcover_letter\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\x88'R\vcoverLetter\"U\n" +
 	"\x19CreateApplicationResponse\x128\n" +
 	"\vapplication\x18\x01 \x01(\v2\x16.job.v1.JobApplicationR\vapplication\"N\n" +
 	"\x1eListApplicationsForChefRequest\x12\x14\n" +
@@ -1679,10 +1689,11 @@ const file_job_v1_job_proto_rawDesc = "" +
 	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\"c\n" +
 	"%ListApplicationsForRestaurantResponse\x12:\n" +
-	"\fapplications\x18\x01 \x03(\v2\x16.job.v1.JobApplicationR\fapplications\"z\n" +
-	"\x1eUpdateApplicationStatusRequest\x12%\n" +
-	"\x0eapplication_id\x18\x01 \x01(\tR\rapplicationId\x121\n" +
-	"\x06status\x18\x02 \x01(\x0e2\x19.job.v1.ApplicationStatusR\x06status\"[\n" +
+	"\fapplications\x18\x01 \x03(\v2\x16.job.v1.JobApplicationR\fapplications\"\x90\x01\n" +
+	"\x1eUpdateApplicationStatusRequest\x12/\n" +
+	"\x0eapplication_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\rapplicationId\x12=\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.job.v1.ApplicationStatusB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x06status\"[\n" +
 	"\x1fUpdateApplicationStatusResponse\x128\n" +
 	"\vapplication\x18\x01 \x01(\v2\x16.job.v1.JobApplicationR\vapplication*n\n" +
 	"\tJobStatus\x12\x1a\n" +
@@ -1694,20 +1705,23 @@ const file_job_v1_job_proto_rawDesc = "" +
 	"\x1eAPPLICATION_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aAPPLICATION_STATUS_PENDING\x10\x01\x12\x1f\n" +
 	"\x1bAPPLICATION_STATUS_ACCEPTED\x10\x02\x12\x1f\n" +
-	"\x1bAPPLICATION_STATUS_REJECTED\x10\x032\x83\x06\n" +
+	"\x1bAPPLICATION_STATUS_REJECTED\x10\x032\xbf\a\n" +
 	"\n" +
-	"JobService\x12@\n" +
-	"\tCreateJob\x12\x18.job.v1.CreateJobRequest\x1a\x19.job.v1.CreateJobResponse\x12@\n" +
-	"\tUpdateJob\x12\x18.job.v1.UpdateJobRequest\x1a\x19.job.v1.UpdateJobResponse\x127\n" +
-	"\x06GetJob\x12\x15.job.v1.GetJobRequest\x1a\x16.job.v1.GetJobResponse\x12C\n" +
+	"JobService\x12U\n" +
+	"\tCreateJob\x12\x18.job.v1.CreateJobRequest\x1a\x19.job.v1.CreateJobResponse\"\x13\x8a\xb5\x18\x0f\x12\x01\x02\x1a\n" +
+	"jobs:write\x12U\n" +
+	"\tUpdateJob\x12\x18.job.v1.UpdateJobRequest\x1a\x19.job.v1.UpdateJobResponse\"\x13\x8a\xb5\x18\x0f\x12\x01\x02\x1a\n" +
+	"jobs:write\x12K\n" +
+	"\x06GetJob\x12\x15.job.v1.GetJobRequest\x1a\x16.job.v1.GetJobResponse\"\x12\x8a\xb5\x18\v\x1a\tjobs:read\x90\x02\x01\x12Z\n" +
 	"\n" +
-	"ListMyJobs\x12\x19.job.v1.ListMyJobsRequest\x1a\x1a.job.v1.ListMyJobsResponse\x12C\n" +
+	"ListMyJobs\x12\x19.job.v1.ListMyJobsRequest\x1a\x1a.job.v1.ListMyJobsResponse\"\x15\x8a\xb5\x18\x0e\x12\x01\x02\x1a\tjobs:read\x90\x02\x01\x12W\n" +
 	"\n" +
-	"SearchJobs\x12\x19.job.v1.SearchJobsRequest\x1a\x1a.job.v1.SearchJobsResponse\x12X\n" +
-	"\x11CreateApplication\x12 .job.v1.CreateApplicationRequest\x1a!.job.v1.CreateApplicationResponse\x12j\n" +
-	"\x17ListApplicationsForChef\x12&.job.v1.ListApplicationsForChefRequest\x1a'.job.v1.ListApplicationsForChefResponse\x12|\n" +
-	"\x1dListApplicationsForRestaurant\x12,.job.v1.ListApplicationsForRestaurantRequest\x1a-.job.v1.ListApplicationsForRestaurantResponse\x12j\n" +
-	"\x17UpdateApplicationStatus\x12&.job.v1.UpdateApplicationStatusRequest\x1a'.job.v1.UpdateApplicationStatusResponseB\x90\x01\n" +
+	"SearchJobs\x12\x19.job.v1.SearchJobsRequest\x1a\x1a.job.v1.SearchJobsResponse\"\x12\x8a\xb5\x18\v\x1a\tjobs:read\x90\x02\x01\x12a\n" +
+	"\x11CreateApplication\x12 .job.v1.CreateApplicationRequest\x1a!.job.v1.CreateApplicationResponse\"\a\x8a\xb5\x18\x03\x12\x01\x01\x12v\n" +
+	"\x17ListApplicationsForChef\x12&.job.v1.ListApplicationsForChefRequest\x1a'.job.v1.ListApplicationsForChefResponse\"\n" +
+	"\x8a\xb5\x18\x03\x12\x01\x01\x90\x02\x01\x12\x9b\x01\n" +
+	"\x1dListApplicationsForRestaurant\x12,.job.v1.ListApplicationsForRestaurantRequest\x1a-.job.v1.ListApplicationsForRestaurantResponse\"\x1d\x8a\xb5\x18\x16\x12\x01\x02\x1a\x11applications:read\x90\x02\x01\x12\x87\x01\n" +
+	"\x17UpdateApplicationStatus\x12&.job.v1.UpdateApplicationStatusRequest\x1a'.job.v1.UpdateApplicationStatusResponse\"\x1b\x8a\xb5\x18\x17\x12\x01\x02\x1a\x12applications:writeB\x90\x01\n" +
 	"\n" +
 	"com.job.v1B\bJobProtoP\x01Z?github.com/chefnext/chefnext/apps/api/internal/gen/job/v1;jobv1\xa2\x02\x03JXX\xaa\x02\x06Job.V1\xca\x02\x06Job\\V1\xe2\x02\x12Job\\V1\\GPBMetadata\xea\x02\aJob::V1b\x06proto3"
 
@@ -97,18 +97,21 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			httpClient,
 			baseURL+JobServiceGetJobProcedure,
 			connect.WithSchema(jobServiceMethods.ByName("GetJob")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		listMyJobs: connect.NewClient[v1.ListMyJobsRequest, v1.ListMyJobsResponse](
 			httpClient,
 			baseURL+JobServiceListMyJobsProcedure,
 			connect.WithSchema(jobServiceMethods.ByName("ListMyJobs")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		searchJobs: connect.NewClient[v1.SearchJobsRequest, v1.SearchJobsResponse](
 			httpClient,
 			baseURL+JobServiceSearchJobsProcedure,
 			connect.WithSchema(jobServiceMethods.ByName("SearchJobs")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		createApplication: connect.NewClient[v1.CreateApplicationRequest, v1.CreateApplicationResponse](
@@ -121,12 +124,14 @@ func NewJobServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...
 			httpClient,
 			baseURL+JobServiceListApplicationsForChefProcedure,
 			connect.WithSchema(jobServiceMethods.ByName("ListApplicationsForChef")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		listApplicationsForRestaurant: connect.NewClient[v1.ListApplicationsForRestaurantRequest, v1.ListApplicationsForRestaurantResponse](
 			httpClient,
 			baseURL+JobServiceListApplicationsForRestaurantProcedure,
 			connect.WithSchema(jobServiceMethods.ByName("ListApplicationsForRestaurant")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		updateApplicationStatus: connect.NewClient[v1.UpdateApplicationStatusRequest, v1.UpdateApplicationStatusResponse](
@@ -232,18 +237,21 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		JobServiceGetJobProcedure,
 		svc.GetJob,
 		connect.WithSchema(jobServiceMethods.ByName("GetJob")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	jobServiceListMyJobsHandler := connect.NewUnaryHandler(
 		JobServiceListMyJobsProcedure,
 		svc.ListMyJobs,
 		connect.WithSchema(jobServiceMethods.ByName("ListMyJobs")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	jobServiceSearchJobsHandler := connect.NewUnaryHandler(
 		JobServiceSearchJobsProcedure,
 		svc.SearchJobs,
 		connect.WithSchema(jobServiceMethods.ByName("SearchJobs")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	jobServiceCreateApplicationHandler := connect.NewUnaryHandler(
@@ -256,12 +264,14 @@ func NewJobServiceHandler(svc JobServiceHandler, opts ...connect.HandlerOption)
 		JobServiceListApplicationsForChefProcedure,
 		svc.ListApplicationsForChef,
 		connect.WithSchema(jobServiceMethods.ByName("ListApplicationsForChef")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	jobServiceListApplicationsForRestaurantHandler := connect.NewUnaryHandler(
 		JobServiceListApplicationsForRestaurantProcedure,
 		svc.ListApplicationsForRestaurant,
 		connect.WithSchema(jobServiceMethods.ByName("ListApplicationsForRestaurant")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	jobServiceUpdateApplicationStatusHandler := connect.NewUnaryHandler(
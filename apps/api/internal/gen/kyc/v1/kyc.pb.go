@@ -0,0 +1,798 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: kyc/v1/kyc.proto
+
+package kycv1
+
+import (
+	_ "github.com/chefnext/chefnext/apps/api/internal/gen/chefnext/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type KycDocumentType int32
+
+const (
+	KycDocumentType_KYC_DOCUMENT_TYPE_UNSPECIFIED           KycDocumentType = 0
+	KycDocumentType_KYC_DOCUMENT_TYPE_BUSINESS_REGISTRATION KycDocumentType = 1
+	KycDocumentType_KYC_DOCUMENT_TYPE_IDENTITY_DOCUMENT     KycDocumentType = 2
+	KycDocumentType_KYC_DOCUMENT_TYPE_PROOF_OF_ADDRESS      KycDocumentType = 3
+)
+
+// Enum value maps for KycDocumentType.
+var (
+	KycDocumentType_name = map[int32]string{
+		0: "KYC_DOCUMENT_TYPE_UNSPECIFIED",
+		1: "KYC_DOCUMENT_TYPE_BUSINESS_REGISTRATION",
+		2: "KYC_DOCUMENT_TYPE_IDENTITY_DOCUMENT",
+		3: "KYC_DOCUMENT_TYPE_PROOF_OF_ADDRESS",
+	}
+	KycDocumentType_value = map[string]int32{
+		"KYC_DOCUMENT_TYPE_UNSPECIFIED":           0,
+		"KYC_DOCUMENT_TYPE_BUSINESS_REGISTRATION": 1,
+		"KYC_DOCUMENT_TYPE_IDENTITY_DOCUMENT":     2,
+		"KYC_DOCUMENT_TYPE_PROOF_OF_ADDRESS":      3,
+	}
+)
+
+func (x KycDocumentType) Enum() *KycDocumentType {
+	p := new(KycDocumentType)
+	*p = x
+	return p
+}
+
+func (x KycDocumentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KycDocumentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_kyc_v1_kyc_proto_enumTypes[0].Descriptor()
+}
+
+func (KycDocumentType) Type() protoreflect.EnumType {
+	return &file_kyc_v1_kyc_proto_enumTypes[0]
+}
+
+func (x KycDocumentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KycDocumentType.Descriptor instead.
+func (KycDocumentType) EnumDescriptor() ([]byte, []int) {
+	return file_kyc_v1_kyc_proto_rawDescGZIP(), []int{0}
+}
+
+type KycSubmissionStatus int32
+
+const (
+	KycSubmissionStatus_KYC_SUBMISSION_STATUS_UNSPECIFIED KycSubmissionStatus = 0
+	KycSubmissionStatus_KYC_SUBMISSION_STATUS_SUBMITTED   KycSubmissionStatus = 1
+	KycSubmissionStatus_KYC_SUBMISSION_STATUS_APPROVED    KycSubmissionStatus = 2
+	KycSubmissionStatus_KYC_SUBMISSION_STATUS_REJECTED    KycSubmissionStatus = 3
+)
+
+// Enum value maps for KycSubmissionStatus.
+var (
+	KycSubmissionStatus_name = map[int32]string{
+		0: "KYC_SUBMISSION_STATUS_UNSPECIFIED",
+		1: "KYC_SUBMISSION_STATUS_SUBMITTED",
+		2: "KYC_SUBMISSION_STATUS_APPROVED",
+		3: "KYC_SUBMISSION_STATUS_REJECTED",
+	}
+	KycSubmissionStatus_value = map[string]int32{
+		"KYC_SUBMISSION_STATUS_UNSPECIFIED": 0,
+		"KYC_SUBMISSION_STATUS_SUBMITTED":   1,
+		"KYC_SUBMISSION_STATUS_APPROVED":    2,
+		"KYC_SUBMISSION_STATUS_REJECTED":    3,
+	}
+)
+
+func (x KycSubmissionStatus) Enum() *KycSubmissionStatus {
+	p := new(KycSubmissionStatus)
+	*p = x
+	return p
+}
+
+func (x KycSubmissionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KycSubmissionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_kyc_v1_kyc_proto_enumTypes[1].Descriptor()
+}
+
+func (KycSubmissionStatus) Type() protoreflect.EnumType {
+	return &file_kyc_v1_kyc_proto_enumTypes[1]
+}
+
+func (x KycSubmissionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KycSubmissionStatus.Descriptor instead.
+func (KycSubmissionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_kyc_v1_kyc_proto_rawDescGZIP(), []int{1}
+}
+
+// KycDocument describes an uploaded document without its content
+type KycDocument struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DocumentType KycDocumentType        `protobuf:"varint,2,opt,name=document_type,json=documentType,proto3,enum=kyc.v1.KycDocumentType" json:"document_type,omitempty"`
+	FileName     string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType  string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes    int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Hex SHA-256 of the original file
+	Sha256        string `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	UploadedAt    string `protobuf:"bytes,7,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KycDocument) Reset() {
+	*x = KycDocument{}
+	mi := &file_kyc_v1_kyc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KycDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KycDocument) ProtoMessage() {}
+
+func (x *KycDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_v1_kyc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KycDocument.ProtoReflect.Descriptor instead.
+func (*KycDocument) Descriptor() ([]byte, []int) {
+	return file_kyc_v1_kyc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KycDocument) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *KycDocument) GetDocumentType() KycDocumentType {
+	if x != nil {
+		return x.DocumentType
+	}
+	return KycDocumentType_KYC_DOCUMENT_TYPE_UNSPECIFIED
+}
+
+func (x *KycDocument) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *KycDocument) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *KycDocument) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *KycDocument) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *KycDocument) GetUploadedAt() string {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return ""
+}
+
+// KycSubmission is one set of details and documents sent for review
+type KycSubmission struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId             string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserEmail          string                 `protobuf:"bytes,3,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	Status             KycSubmissionStatus    `protobuf:"varint,4,opt,name=status,proto3,enum=kyc.v1.KycSubmissionStatus" json:"status,omitempty"`
+	BusinessName       string                 `protobuf:"bytes,5,opt,name=business_name,json=businessName,proto3" json:"business_name,omitempty"`
+	RegistrationNumber string                 `protobuf:"bytes,6,opt,name=registration_number,json=registrationNumber,proto3" json:"registration_number,omitempty"`
+	BusinessAddress    string                 `protobuf:"bytes,7,opt,name=business_address,json=businessAddress,proto3" json:"business_address,omitempty"`
+	RepresentativeName string                 `protobuf:"bytes,8,opt,name=representative_name,json=representativeName,proto3" json:"representative_name,omitempty"`
+	Documents          []*KycDocument         `protobuf:"bytes,9,rep,name=documents,proto3" json:"documents,omitempty"`
+	// Set when the submission was rejected
+	RejectionReasons []string `protobuf:"bytes,10,rep,name=rejection_reasons,json=rejectionReasons,proto3" json:"rejection_reasons,omitempty"`
+	ReviewerNote     string   `protobuf:"bytes,11,opt,name=reviewer_note,json=reviewerNote,proto3" json:"reviewer_note,omitempty"`
+	SubmittedAt      string   `protobuf:"bytes,12,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	// RFC 3339; empty until reviewed
+	ReviewedAt    string `protobuf:"bytes,13,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KycSubmission) Reset() {
+	*x = KycSubmission{}
+	mi := &file_kyc_v1_kyc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KycSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KycSubmission) ProtoMessage() {}
+
+func (x *KycSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_v1_kyc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KycSubmission.ProtoReflect.Descriptor instead.
+func (*KycSubmission) Descriptor() ([]byte, []int) {
+	return file_kyc_v1_kyc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KycSubmission) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetStatus() KycSubmissionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return KycSubmissionStatus_KYC_SUBMISSION_STATUS_UNSPECIFIED
+}
+
+func (x *KycSubmission) GetBusinessName() string {
+	if x != nil {
+		return x.BusinessName
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetRegistrationNumber() string {
+	if x != nil {
+		return x.RegistrationNumber
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetBusinessAddress() string {
+	if x != nil {
+		return x.BusinessAddress
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetRepresentativeName() string {
+	if x != nil {
+		return x.RepresentativeName
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetDocuments() []*KycDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *KycSubmission) GetRejectionReasons() []string {
+	if x != nil {
+		return x.RejectionReasons
+	}
+	return nil
+}
+
+func (x *KycSubmission) GetReviewerNote() string {
+	if x != nil {
+		return x.ReviewerNote
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetReviewedAt() string {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return ""
+}
+
+// KycDocumentUpload is a file attached to a submission
+type KycDocumentUpload struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	DocumentType KycDocumentType        `protobuf:"varint,1,opt,name=document_type,json=documentType,proto3,enum=kyc.v1.KycDocumentType" json:"document_type,omitempty"`
+	FileName     string                 `protobuf:"bytes,2,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// application/pdf, image/jpeg or image/png
+	ContentType string `protobuf:"bytes,3,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// At most 5 MB
+	Content       []byte `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KycDocumentUpload) Reset() {
+	*x = KycDocumentUpload{}
+	mi := &file_kyc_v1_kyc_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KycDocumentUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KycDocumentUpload) ProtoMessage() {}
+
+func (x *KycDocumentUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_v1_kyc_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KycDocumentUpload.ProtoReflect.Descriptor instead.
+func (*KycDocumentUpload) Descriptor() ([]byte, []int) {
+	return file_kyc_v1_kyc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KycDocumentUpload) GetDocumentType() KycDocumentType {
+	if x != nil {
+		return x.DocumentType
+	}
+	return KycDocumentType_KYC_DOCUMENT_TYPE_UNSPECIFIED
+}
+
+func (x *KycDocumentUpload) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *KycDocumentUpload) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *KycDocumentUpload) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+// SubmitKycRequest needs at least a business registration and an identity
+// document
+type SubmitKycRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	BusinessName       string                 `protobuf:"bytes,1,opt,name=business_name,json=businessName,proto3" json:"business_name,omitempty"`
+	RegistrationNumber string                 `protobuf:"bytes,2,opt,name=registration_number,json=registrationNumber,proto3" json:"registration_number,omitempty"`
+	BusinessAddress    string                 `protobuf:"bytes,3,opt,name=business_address,json=businessAddress,proto3" json:"business_address,omitempty"`
+	RepresentativeName string                 `protobuf:"bytes,4,opt,name=representative_name,json=representativeName,proto3" json:"representative_name,omitempty"`
+	Documents          []*KycDocumentUpload   `protobuf:"bytes,5,rep,name=documents,proto3" json:"documents,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SubmitKycRequest) Reset() {
+	*x = SubmitKycRequest{}
+	mi := &file_kyc_v1_kyc_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitKycRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitKycRequest) ProtoMessage() {}
+
+func (x *SubmitKycRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_v1_kyc_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitKycRequest.ProtoReflect.Descriptor instead.
+func (*SubmitKycRequest) Descriptor() ([]byte, []int) {
+	return file_kyc_v1_kyc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubmitKycRequest) GetBusinessName() string {
+	if x != nil {
+		return x.BusinessName
+	}
+	return ""
+}
+
+func (x *SubmitKycRequest) GetRegistrationNumber() string {
+	if x != nil {
+		return x.RegistrationNumber
+	}
+	return ""
+}
+
+func (x *SubmitKycRequest) GetBusinessAddress() string {
+	if x != nil {
+		return x.BusinessAddress
+	}
+	return ""
+}
+
+func (x *SubmitKycRequest) GetRepresentativeName() string {
+	if x != nil {
+		return x.RepresentativeName
+	}
+	return ""
+}
+
+func (x *SubmitKycRequest) GetDocuments() []*KycDocumentUpload {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+// SubmitKycResponse contains the queued submission
+type SubmitKycResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Submission    *KycSubmission         `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+	KycStatus     string                 `protobuf:"bytes,2,opt,name=kyc_status,json=kycStatus,proto3" json:"kyc_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitKycResponse) Reset() {
+	*x = SubmitKycResponse{}
+	mi := &file_kyc_v1_kyc_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitKycResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitKycResponse) ProtoMessage() {}
+
+func (x *SubmitKycResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_v1_kyc_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitKycResponse.ProtoReflect.Descriptor instead.
+func (*SubmitKycResponse) Descriptor() ([]byte, []int) {
+	return file_kyc_v1_kyc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SubmitKycResponse) GetSubmission() *KycSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+func (x *SubmitKycResponse) GetKycStatus() string {
+	if x != nil {
+		return x.KycStatus
+	}
+	return ""
+}
+
+type GetMyKycRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyKycRequest) Reset() {
+	*x = GetMyKycRequest{}
+	mi := &file_kyc_v1_kyc_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyKycRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyKycRequest) ProtoMessage() {}
+
+func (x *GetMyKycRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_v1_kyc_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyKycRequest.ProtoReflect.Descriptor instead.
+func (*GetMyKycRequest) Descriptor() ([]byte, []int) {
+	return file_kyc_v1_kyc_proto_rawDescGZIP(), []int{5}
+}
+
+// GetMyKycResponse contains the verification status
+type GetMyKycResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pending, submitted, verified or rejected
+	KycStatus string `protobuf:"bytes,1,opt,name=kyc_status,json=kycStatus,proto3" json:"kyc_status,omitempty"`
+	// Unset when nothing was submitted yet
+	LatestSubmission *KycSubmission `protobuf:"bytes,2,opt,name=latest_submission,json=latestSubmission,proto3" json:"latest_submission,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetMyKycResponse) Reset() {
+	*x = GetMyKycResponse{}
+	mi := &file_kyc_v1_kyc_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyKycResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyKycResponse) ProtoMessage() {}
+
+func (x *GetMyKycResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kyc_v1_kyc_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyKycResponse.ProtoReflect.Descriptor instead.
+func (*GetMyKycResponse) Descriptor() ([]byte, []int) {
+	return file_kyc_v1_kyc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetMyKycResponse) GetKycStatus() string {
+	if x != nil {
+		return x.KycStatus
+	}
+	return ""
+}
+
+func (x *GetMyKycResponse) GetLatestSubmission() *KycSubmission {
+	if x != nil {
+		return x.LatestSubmission
+	}
+	return nil
+}
+
+var File_kyc_v1_kyc_proto protoreflect.FileDescriptor
+
+const file_kyc_v1_kyc_proto_rawDesc = "" +
+	"\n" +
+	"\x10kyc/v1/kyc.proto\x12\x06kyc.v1\x1a\x16chefnext/v1/auth.proto\"\xf3\x01\n" +
+	"\vKycDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12<\n" +
+	"\rdocument_type\x18\x02 \x01(\x0e2\x17.kyc.v1.KycDocumentTypeR\fdocumentType\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\tsizeBytes\x12\x16\n" +
+	"\x06sha256\x18\x06 \x01(\tR\x06sha256\x12\x1f\n" +
+	"\vuploaded_at\x18\a \x01(\tR\n" +
+	"uploadedAt\"\x87\x04\n" +
+	"\rKycSubmission\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"user_email\x18\x03 \x01(\tR\tuserEmail\x123\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1b.kyc.v1.KycSubmissionStatusR\x06status\x12#\n" +
+	"\rbusiness_name\x18\x05 \x01(\tR\fbusinessName\x12/\n" +
+	"\x13registration_number\x18\x06 \x01(\tR\x12registrationNumber\x12)\n" +
+	"\x10business_address\x18\a \x01(\tR\x0fbusinessAddress\x12/\n" +
+	"\x13representative_name\x18\b \x01(\tR\x12representativeName\x121\n" +
+	"\tdocuments\x18\t \x03(\v2\x13.kyc.v1.KycDocumentR\tdocuments\x12+\n" +
+	"\x11rejection_reasons\x18\n" +
+	" \x03(\tR\x10rejectionReasons\x12#\n" +
+	"\rreviewer_note\x18\v \x01(\tR\freviewerNote\x12!\n" +
+	"\fsubmitted_at\x18\f \x01(\tR\vsubmittedAt\x12\x1f\n" +
+	"\vreviewed_at\x18\r \x01(\tR\n" +
+	"reviewedAt\"\xab\x01\n" +
+	"\x11KycDocumentUpload\x12<\n" +
+	"\rdocument_type\x18\x01 \x01(\x0e2\x17.kyc.v1.KycDocumentTypeR\fdocumentType\x12\x1b\n" +
+	"\tfile_name\x18\x02 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x03 \x01(\tR\vcontentType\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\fR\acontent\"\xfd\x01\n" +
+	"\x10SubmitKycRequest\x12#\n" +
+	"\rbusiness_name\x18\x01 \x01(\tR\fbusinessName\x12/\n" +
+	"\x13registration_number\x18\x02 \x01(\tR\x12registrationNumber\x12)\n" +
+	"\x10business_address\x18\x03 \x01(\tR\x0fbusinessAddress\x12/\n" +
+	"\x13representative_name\x18\x04 \x01(\tR\x12representativeName\x127\n" +
+	"\tdocuments\x18\x05 \x03(\v2\x19.kyc.v1.KycDocumentUploadR\tdocuments\"i\n" +
+	"\x11SubmitKycResponse\x125\n" +
+	"\n" +
+	"submission\x18\x01 \x01(\v2\x15.kyc.v1.KycSubmissionR\n" +
+	"submission\x12\x1d\n" +
+	"\n" +
+	"kyc_status\x18\x02 \x01(\tR\tkycStatus\"\x11\n" +
+	"\x0fGetMyKycRequest\"u\n" +
+	"\x10GetMyKycResponse\x12\x1d\n" +
+	"\n" +
+	"kyc_status\x18\x01 \x01(\tR\tkycStatus\x12B\n" +
+	"\x11latest_submission\x18\x02 \x01(\v2\x15.kyc.v1.KycSubmissionR\x10latestSubmission*\xb2\x01\n" +
+	"\x0fKycDocumentType\x12!\n" +
+	"\x1dKYC_DOCUMENT_TYPE_UNSPECIFIED\x10\x00\x12+\n" +
+	"'KYC_DOCUMENT_TYPE_BUSINESS_REGISTRATION\x10\x01\x12'\n" +
+	"#KYC_DOCUMENT_TYPE_IDENTITY_DOCUMENT\x10\x02\x12&\n" +
+	"\"KYC_DOCUMENT_TYPE_PROOF_OF_ADDRESS\x10\x03*\xa9\x01\n" +
+	"\x13KycSubmissionStatus\x12%\n" +
+	"!KYC_SUBMISSION_STATUS_UNSPECIFIED\x10\x00\x12#\n" +
+	"\x1fKYC_SUBMISSION_STATUS_SUBMITTED\x10\x01\x12\"\n" +
+	"\x1eKYC_SUBMISSION_STATUS_APPROVED\x10\x02\x12\"\n" +
+	"\x1eKYC_SUBMISSION_STATUS_REJECTED\x10\x032\xa2\x01\n" +
+	"\n" +
+	"KycService\x12I\n" +
+	"\tSubmitKyc\x12\x18.kyc.v1.SubmitKycRequest\x1a\x19.kyc.v1.SubmitKycResponse\"\a\x8a\xb5\x18\x03\x12\x01\x02\x12I\n" +
+	"\bGetMyKyc\x12\x17.kyc.v1.GetMyKycRequest\x1a\x18.kyc.v1.GetMyKycResponse\"\n" +
+	"\x8a\xb5\x18\x03\x12\x01\x02\x90\x02\x01B\x90\x01\n" +
+	"\n" +
+	"com.kyc.v1B\bKycProtoP\x01Z?github.com/chefnext/chefnext/apps/api/internal/gen/kyc/v1;kycv1\xa2\x02\x03KXX\xaa\x02\x06Kyc.V1\xca\x02\x06Kyc\\V1\xe2\x02\x12Kyc\\V1\\GPBMetadata\xea\x02\aKyc::V1b\x06proto3"
+
+var (
+	file_kyc_v1_kyc_proto_rawDescOnce sync.Once
+	file_kyc_v1_kyc_proto_rawDescData []byte
+)
+
+func file_kyc_v1_kyc_proto_rawDescGZIP() []byte {
+	file_kyc_v1_kyc_proto_rawDescOnce.Do(func() {
+		file_kyc_v1_kyc_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_kyc_v1_kyc_proto_rawDesc), len(file_kyc_v1_kyc_proto_rawDesc)))
+	})
+	return file_kyc_v1_kyc_proto_rawDescData
+}
+
+var file_kyc_v1_kyc_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_kyc_v1_kyc_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_kyc_v1_kyc_proto_goTypes = []any{
+	(KycDocumentType)(0),      // 0: kyc.v1.KycDocumentType
+	(KycSubmissionStatus)(0),  // 1: kyc.v1.KycSubmissionStatus
+	(*KycDocument)(nil),       // 2: kyc.v1.KycDocument
+	(*KycSubmission)(nil),     // 3: kyc.v1.KycSubmission
+	(*KycDocumentUpload)(nil), // 4: kyc.v1.KycDocumentUpload
+	(*SubmitKycRequest)(nil),  // 5: kyc.v1.SubmitKycRequest
+	(*SubmitKycResponse)(nil), // 6: kyc.v1.SubmitKycResponse
+	(*GetMyKycRequest)(nil),   // 7: kyc.v1.GetMyKycRequest
+	(*GetMyKycResponse)(nil),  // 8: kyc.v1.GetMyKycResponse
+}
+var file_kyc_v1_kyc_proto_depIdxs = []int32{
+	0, // 0: kyc.v1.KycDocument.document_type:type_name -> kyc.v1.KycDocumentType
+	1, // 1: kyc.v1.KycSubmission.status:type_name -> kyc.v1.KycSubmissionStatus
+	2, // 2: kyc.v1.KycSubmission.documents:type_name -> kyc.v1.KycDocument
+	0, // 3: kyc.v1.KycDocumentUpload.document_type:type_name -> kyc.v1.KycDocumentType
+	4, // 4: kyc.v1.SubmitKycRequest.documents:type_name -> kyc.v1.KycDocumentUpload
+	3, // 5: kyc.v1.SubmitKycResponse.submission:type_name -> kyc.v1.KycSubmission
+	3, // 6: kyc.v1.GetMyKycResponse.latest_submission:type_name -> kyc.v1.KycSubmission
+	5, // 7: kyc.v1.KycService.SubmitKyc:input_type -> kyc.v1.SubmitKycRequest
+	7, // 8: kyc.v1.KycService.GetMyKyc:input_type -> kyc.v1.GetMyKycRequest
+	6, // 9: kyc.v1.KycService.SubmitKyc:output_type -> kyc.v1.SubmitKycResponse
+	8, // 10: kyc.v1.KycService.GetMyKyc:output_type -> kyc.v1.GetMyKycResponse
+	9, // [9:11] is the sub-list for method output_type
+	7, // [7:9] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_kyc_v1_kyc_proto_init() }
+func file_kyc_v1_kyc_proto_init() {
+	if File_kyc_v1_kyc_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_kyc_v1_kyc_proto_rawDesc), len(file_kyc_v1_kyc_proto_rawDesc)),
+			NumEnums:      2,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_kyc_v1_kyc_proto_goTypes,
+		DependencyIndexes: file_kyc_v1_kyc_proto_depIdxs,
+		EnumInfos:         file_kyc_v1_kyc_proto_enumTypes,
+		MessageInfos:      file_kyc_v1_kyc_proto_msgTypes,
+	}.Build()
+	File_kyc_v1_kyc_proto = out.File
+	file_kyc_v1_kyc_proto_goTypes = nil
+	file_kyc_v1_kyc_proto_depIdxs = nil
+}
@@ -0,0 +1,142 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: kyc/v1/kyc.proto
+
+package kycv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/chefnext/chefnext/apps/api/internal/gen/kyc/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// KycServiceName is the fully-qualified name of the KycService service.
+	KycServiceName = "kyc.v1.KycService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// KycServiceSubmitKycProcedure is the fully-qualified name of the KycService's SubmitKyc RPC.
+	KycServiceSubmitKycProcedure = "/kyc.v1.KycService/SubmitKyc"
+	// KycServiceGetMyKycProcedure is the fully-qualified name of the KycService's GetMyKyc RPC.
+	KycServiceGetMyKycProcedure = "/kyc.v1.KycService/GetMyKyc"
+)
+
+// KycServiceClient is a client for the kyc.v1.KycService service.
+type KycServiceClient interface {
+	// SubmitKyc sends business registration details and documents for review
+	SubmitKyc(context.Context, *connect.Request[v1.SubmitKycRequest]) (*connect.Response[v1.SubmitKycResponse], error)
+	// GetMyKyc returns the caller's verification status and latest submission
+	GetMyKyc(context.Context, *connect.Request[v1.GetMyKycRequest]) (*connect.Response[v1.GetMyKycResponse], error)
+}
+
+// NewKycServiceClient constructs a client for the kyc.v1.KycService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewKycServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) KycServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	kycServiceMethods := v1.File_kyc_v1_kyc_proto.Services().ByName("KycService").Methods()
+	return &kycServiceClient{
+		submitKyc: connect.NewClient[v1.SubmitKycRequest, v1.SubmitKycResponse](
+			httpClient,
+			baseURL+KycServiceSubmitKycProcedure,
+			connect.WithSchema(kycServiceMethods.ByName("SubmitKyc")),
+			connect.WithClientOptions(opts...),
+		),
+		getMyKyc: connect.NewClient[v1.GetMyKycRequest, v1.GetMyKycResponse](
+			httpClient,
+			baseURL+KycServiceGetMyKycProcedure,
+			connect.WithSchema(kycServiceMethods.ByName("GetMyKyc")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// kycServiceClient implements KycServiceClient.
+type kycServiceClient struct {
+	submitKyc *connect.Client[v1.SubmitKycRequest, v1.SubmitKycResponse]
+	getMyKyc  *connect.Client[v1.GetMyKycRequest, v1.GetMyKycResponse]
+}
+
+// SubmitKyc calls kyc.v1.KycService.SubmitKyc.
+func (c *kycServiceClient) SubmitKyc(ctx context.Context, req *connect.Request[v1.SubmitKycRequest]) (*connect.Response[v1.SubmitKycResponse], error) {
+	return c.submitKyc.CallUnary(ctx, req)
+}
+
+// GetMyKyc calls kyc.v1.KycService.GetMyKyc.
+func (c *kycServiceClient) GetMyKyc(ctx context.Context, req *connect.Request[v1.GetMyKycRequest]) (*connect.Response[v1.GetMyKycResponse], error) {
+	return c.getMyKyc.CallUnary(ctx, req)
+}
+
+// KycServiceHandler is an implementation of the kyc.v1.KycService service.
+type KycServiceHandler interface {
+	// SubmitKyc sends business registration details and documents for review
+	SubmitKyc(context.Context, *connect.Request[v1.SubmitKycRequest]) (*connect.Response[v1.SubmitKycResponse], error)
+	// GetMyKyc returns the caller's verification status and latest submission
+	GetMyKyc(context.Context, *connect.Request[v1.GetMyKycRequest]) (*connect.Response[v1.GetMyKycResponse], error)
+}
+
+// NewKycServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewKycServiceHandler(svc KycServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	kycServiceMethods := v1.File_kyc_v1_kyc_proto.Services().ByName("KycService").Methods()
+	kycServiceSubmitKycHandler := connect.NewUnaryHandler(
+		KycServiceSubmitKycProcedure,
+		svc.SubmitKyc,
+		connect.WithSchema(kycServiceMethods.ByName("SubmitKyc")),
+		connect.WithHandlerOptions(opts...),
+	)
+	kycServiceGetMyKycHandler := connect.NewUnaryHandler(
+		KycServiceGetMyKycProcedure,
+		svc.GetMyKyc,
+		connect.WithSchema(kycServiceMethods.ByName("GetMyKyc")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/kyc.v1.KycService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case KycServiceSubmitKycProcedure:
+			kycServiceSubmitKycHandler.ServeHTTP(w, r)
+		case KycServiceGetMyKycProcedure:
+			kycServiceGetMyKycHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedKycServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedKycServiceHandler struct{}
+
+func (UnimplementedKycServiceHandler) SubmitKyc(context.Context, *connect.Request[v1.SubmitKycRequest]) (*connect.Response[v1.SubmitKycResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kyc.v1.KycService.SubmitKyc is not implemented"))
+}
+
+func (UnimplementedKycServiceHandler) GetMyKyc(context.Context, *connect.Request[v1.GetMyKycRequest]) (*connect.Response[v1.GetMyKycResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("kyc.v1.KycService.GetMyKyc is not implemented"))
+}
@@ -7,6 +7,8 @@
 package restaurantv1
 
 import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/chefnext/chefnext/apps/api/internal/gen/chefnext/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -581,6 +583,8 @@ func (x *GetMyProfileResponse) GetProfile() *RestaurantProfile {
 	return nil
 }
 
+// UpdateProfileRequest changes the given fields; empty values and lists leave
+// a field unchanged
 type UpdateProfileRequest struct {
 	state              protoimpl.MessageState `protogen:"open.v1"`
 	ProfileId          string                 `protobuf:"bytes,1,opt,name=profile_id,json=profileId,proto3" json:"profile_id,omitempty"`
@@ -873,7 +877,7 @@ var File_restaurant_v1_profile_proto protoreflect.FileDescriptor
 
 const file_restaurant_v1_profile_proto_rawDesc = "" +
 	"\n" +
-	"\x1brestaurant/v1/profile.proto\x12\rrestaurant.v1\"\xa0\x04\n" +
+	"\x1brestaurant/v1/profile.proto\x12\rrestaurant.v1\x1a\x1bbuf/validate/validate.proto\x1a\x16chefnext/v1/auth.proto\"\xa0\x04\n" +
 	"\x11RestaurantProfile\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
@@ -892,66 +896,79 @@ const file_restaurant_v1_profile_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x0f \x01(\tR\tupdatedAt\"m\n" +
+	"updated_at\x18\x0f \x01(\tR\tupdatedAt\"\x89\x01\n" +
 	"\x11LearningHighlight\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
-	"\bduration\x18\x03 \x01(\tR\bduration\x12\x16\n" +
-	"\x06detail\x18\x04 \x01(\tR\x06detail\"\xbc\x03\n" +
-	"\x14CreateProfileRequest\x12!\n" +
-	"\fdisplay_name\x18\x01 \x01(\tR\vdisplayName\x12\x18\n" +
-	"\atagline\x18\x02 \x01(\tR\atagline\x12\x1a\n" +
-	"\blocation\x18\x03 \x01(\tR\blocation\x12\x14\n" +
-	"\x05seats\x18\x04 \x01(\x05R\x05seats\x12#\n" +
-	"\rcuisine_types\x18\x05 \x03(\tR\fcuisineTypes\x12)\n" +
-	"\x10mentorship_style\x18\x06 \x01(\tR\x0fmentorshipStyle\x12 \n" +
-	"\vdescription\x18\a \x01(\tR\vdescription\x12)\n" +
-	"\x10culture_keywords\x18\b \x03(\tR\x0fcultureKeywords\x12\x1a\n" +
-	"\bbenefits\x18\t \x03(\tR\bbenefits\x12)\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\x05title\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18dR\x05title\x12#\n" +
+	"\bduration\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x182R\bduration\x12 \n" +
+	"\x06detail\x18\x04 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\x06detail\"\x8c\x05\n" +
+	"\x14CreateProfileRequest\x12m\n" +
+	"\fdisplay_name\x18\x01 \x01(\tBJ\xbaHG\xba\x01@\n" +
+	"\x10string.not_blank\x12\x17value must not be blank\x1a\x13this.matches('\\\\S')r\x02\x18dR\vdisplayName\x12!\n" +
+	"\atagline\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18xR\atagline\x12#\n" +
+	"\blocation\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18dR\blocation\x12 \n" +
+	"\x05seats\x18\x04 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\x90N(\x00R\x05seats\x125\n" +
+	"\rcuisine_types\x18\x05 \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x182R\fcuisineTypes\x123\n" +
+	"\x10mentorship_style\x18\x06 \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x0fmentorshipStyle\x12*\n" +
+	"\vdescription\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\x88'R\vdescription\x12;\n" +
+	"\x10culture_keywords\x18\b \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x182R\x0fcultureKeywords\x12,\n" +
+	"\bbenefits\x18\t \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x18dR\bbenefits\x12;\n" +
 	"\x10support_programs\x18\n" +
-	" \x03(\tR\x0fsupportPrograms\x12Q\n" +
-	"\x13learning_highlights\x18\v \x03(\v2 .restaurant.v1.LearningHighlightR\x12learningHighlights\"S\n" +
+	" \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x18dR\x0fsupportPrograms\x12[\n" +
+	"\x13learning_highlights\x18\v \x03(\v2 .restaurant.v1.LearningHighlightB\b\xbaH\x05\x92\x01\x02\x10\x14R\x12learningHighlights\"S\n" +
 	"\x15CreateProfileResponse\x12:\n" +
-	"\aprofile\x18\x01 \x01(\v2 .restaurant.v1.RestaurantProfileR\aprofile\"2\n" +
-	"\x11GetProfileRequest\x12\x1d\n" +
+	"\aprofile\x18\x01 \x01(\v2 .restaurant.v1.RestaurantProfileR\aprofile\"<\n" +
+	"\x11GetProfileRequest\x12'\n" +
 	"\n" +
-	"profile_id\x18\x01 \x01(\tR\tprofileId\"P\n" +
+	"profile_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprofileId\"P\n" +
 	"\x12GetProfileResponse\x12:\n" +
 	"\aprofile\x18\x01 \x01(\v2 .restaurant.v1.RestaurantProfileR\aprofile\"\x15\n" +
 	"\x13GetMyProfileRequest\"R\n" +
 	"\x14GetMyProfileResponse\x12:\n" +
-	"\aprofile\x18\x01 \x01(\v2 .restaurant.v1.RestaurantProfileR\aprofile\"\xdb\x03\n" +
-	"\x14UpdateProfileRequest\x12\x1d\n" +
+	"\aprofile\x18\x01 \x01(\v2 .restaurant.v1.RestaurantProfileR\aprofile\"\xb8\x05\n" +
+	"\x14UpdateProfileRequest\x12'\n" +
 	"\n" +
-	"profile_id\x18\x01 \x01(\tR\tprofileId\x12!\n" +
-	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12\x18\n" +
-	"\atagline\x18\x03 \x01(\tR\atagline\x12\x1a\n" +
-	"\blocation\x18\x04 \x01(\tR\blocation\x12\x14\n" +
-	"\x05seats\x18\x05 \x01(\x05R\x05seats\x12#\n" +
-	"\rcuisine_types\x18\x06 \x03(\tR\fcuisineTypes\x12)\n" +
-	"\x10mentorship_style\x18\a \x01(\tR\x0fmentorshipStyle\x12 \n" +
-	"\vdescription\x18\b \x01(\tR\vdescription\x12)\n" +
-	"\x10culture_keywords\x18\t \x03(\tR\x0fcultureKeywords\x12\x1a\n" +
+	"profile_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\tprofileId\x12p\n" +
+	"\fdisplay_name\x18\x02 \x01(\tBM\xbaHJ\xba\x01@\n" +
+	"\x10string.not_blank\x12\x17value must not be blank\x1a\x13this.matches('\\\\S')\xd8\x01\x01r\x02\x18dR\vdisplayName\x12!\n" +
+	"\atagline\x18\x03 \x01(\tB\a\xbaH\x04r\x02\x18xR\atagline\x12#\n" +
+	"\blocation\x18\x04 \x01(\tB\a\xbaH\x04r\x02\x18dR\blocation\x12 \n" +
+	"\x05seats\x18\x05 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x18\x90N(\x00R\x05seats\x125\n" +
+	"\rcuisine_types\x18\x06 \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x182R\fcuisineTypes\x123\n" +
+	"\x10mentorship_style\x18\a \x01(\tB\b\xbaH\x05r\x03\x18\xf4\x03R\x0fmentorshipStyle\x12*\n" +
+	"\vdescription\x18\b \x01(\tB\b\xbaH\x05r\x03\x18\x88'R\vdescription\x12;\n" +
+	"\x10culture_keywords\x18\t \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x182R\x0fcultureKeywords\x12,\n" +
 	"\bbenefits\x18\n" +
-	" \x03(\tR\bbenefits\x12)\n" +
-	"\x10support_programs\x18\v \x03(\tR\x0fsupportPrograms\x12Q\n" +
-	"\x13learning_highlights\x18\f \x03(\v2 .restaurant.v1.LearningHighlightR\x12learningHighlights\"S\n" +
+	" \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x18dR\bbenefits\x12;\n" +
+	"\x10support_programs\x18\v \x03(\tB\x10\xbaH\r\x92\x01\n" +
+	"\x10\x14\"\x06r\x04\x10\x01\x18dR\x0fsupportPrograms\x12[\n" +
+	"\x13learning_highlights\x18\f \x03(\v2 .restaurant.v1.LearningHighlightB\b\xbaH\x05\x92\x01\x02\x10\x14R\x12learningHighlights\"S\n" +
 	"\x15UpdateProfileResponse\x12:\n" +
-	"\aprofile\x18\x01 \x01(\v2 .restaurant.v1.RestaurantProfileR\aprofile\"~\n" +
-	"\x15SearchProfilesRequest\x12#\n" +
-	"\rcuisine_types\x18\x01 \x03(\tR\fcuisineTypes\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\aprofile\x18\x01 \x01(\v2 .restaurant.v1.RestaurantProfileR\aprofile\"\x91\x01\n" +
+	"\x15SearchProfilesRequest\x12-\n" +
+	"\rcuisine_types\x18\x01 \x03(\tB\b\xbaH\x05\x92\x01\x02\x10\x14R\fcuisineTypes\x12\x1b\n" +
+	"\x04name\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x18dR\x04name\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
 	"\x06offset\x18\x04 \x01(\x05R\x06offset\"V\n" +
 	"\x16SearchProfilesResponse\x12<\n" +
-	"\bprofiles\x18\x01 \x03(\v2 .restaurant.v1.RestaurantProfileR\bprofiles2\xdd\x03\n" +
-	"\x18RestaurantProfileService\x12Z\n" +
-	"\rCreateProfile\x12#.restaurant.v1.CreateProfileRequest\x1a$.restaurant.v1.CreateProfileResponse\x12Q\n" +
+	"\bprofiles\x18\x01 \x03(\v2 .restaurant.v1.RestaurantProfileR\bprofiles2\x8d\x04\n" +
+	"\x18RestaurantProfileService\x12c\n" +
+	"\rCreateProfile\x12#.restaurant.v1.CreateProfileRequest\x1a$.restaurant.v1.CreateProfileResponse\"\a\x8a\xb5\x18\x03\x12\x01\x02\x12Z\n" +
 	"\n" +
-	"GetProfile\x12 .restaurant.v1.GetProfileRequest\x1a!.restaurant.v1.GetProfileResponse\x12W\n" +
-	"\fGetMyProfile\x12\".restaurant.v1.GetMyProfileRequest\x1a#.restaurant.v1.GetMyProfileResponse\x12Z\n" +
-	"\rUpdateProfile\x12#.restaurant.v1.UpdateProfileRequest\x1a$.restaurant.v1.UpdateProfileResponse\x12]\n" +
-	"\x0eSearchProfiles\x12$.restaurant.v1.SearchProfilesRequest\x1a%.restaurant.v1.SearchProfilesResponseB\xc5\x01\n" +
+	"GetProfile\x12 .restaurant.v1.GetProfileRequest\x1a!.restaurant.v1.GetProfileResponse\"\a\x8a\xb5\x18\x00\x90\x02\x01\x12c\n" +
+	"\fGetMyProfile\x12\".restaurant.v1.GetMyProfileRequest\x1a#.restaurant.v1.GetMyProfileResponse\"\n" +
+	"\x8a\xb5\x18\x03\x12\x01\x02\x90\x02\x01\x12c\n" +
+	"\rUpdateProfile\x12#.restaurant.v1.UpdateProfileRequest\x1a$.restaurant.v1.UpdateProfileResponse\"\a\x8a\xb5\x18\x03\x12\x01\x02\x12f\n" +
+	"\x0eSearchProfiles\x12$.restaurant.v1.SearchProfilesRequest\x1a%.restaurant.v1.SearchProfilesResponse\"\a\x8a\xb5\x18\x00\x90\x02\x01B\xc5\x01\n" +
 	"\x11com.restaurant.v1B\fProfileProtoP\x01ZMgithub.com/chefnext/chefnext/apps/api/internal/gen/restaurant/v1;restaurantv1\xa2\x02\x03RXX\xaa\x02\rRestaurant.V1\xca\x02\rRestaurant\\V1\xe2\x02\x19Restaurant\\V1\\GPBMetadata\xea\x02\x0eRestaurant::V1b\x06proto3"
 
 var (
@@ -81,12 +81,14 @@ func NewRestaurantProfileServiceClient(httpClient connect.HTTPClient, baseURL st
 			httpClient,
 			baseURL+RestaurantProfileServiceGetProfileProcedure,
 			connect.WithSchema(restaurantProfileServiceMethods.ByName("GetProfile")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		getMyProfile: connect.NewClient[v1.GetMyProfileRequest, v1.GetMyProfileResponse](
 			httpClient,
 			baseURL+RestaurantProfileServiceGetMyProfileProcedure,
 			connect.WithSchema(restaurantProfileServiceMethods.ByName("GetMyProfile")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 		updateProfile: connect.NewClient[v1.UpdateProfileRequest, v1.UpdateProfileResponse](
@@ -99,6 +101,7 @@ func NewRestaurantProfileServiceClient(httpClient connect.HTTPClient, baseURL st
 			httpClient,
 			baseURL+RestaurantProfileServiceSearchProfilesProcedure,
 			connect.WithSchema(restaurantProfileServiceMethods.ByName("SearchProfiles")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
 	}
@@ -165,12 +168,14 @@ func NewRestaurantProfileServiceHandler(svc RestaurantProfileServiceHandler, opt
 		RestaurantProfileServiceGetProfileProcedure,
 		svc.GetProfile,
 		connect.WithSchema(restaurantProfileServiceMethods.ByName("GetProfile")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	restaurantProfileServiceGetMyProfileHandler := connect.NewUnaryHandler(
 		RestaurantProfileServiceGetMyProfileProcedure,
 		svc.GetMyProfile,
 		connect.WithSchema(restaurantProfileServiceMethods.ByName("GetMyProfile")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	restaurantProfileServiceUpdateProfileHandler := connect.NewUnaryHandler(
@@ -183,6 +188,7 @@ func NewRestaurantProfileServiceHandler(svc RestaurantProfileServiceHandler, opt
 		RestaurantProfileServiceSearchProfilesProcedure,
 		svc.SearchProfiles,
 		connect.WithSchema(restaurantProfileServiceMethods.ByName("SearchProfiles")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
 	return "/restaurant.v1.RestaurantProfileService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,302 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: restaurant/v1/team.proto
+
+package restaurantv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	v1 "github.com/chefnext/chefnext/apps/api/internal/gen/restaurant/v1"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// RestaurantTeamServiceName is the fully-qualified name of the RestaurantTeamService service.
+	RestaurantTeamServiceName = "restaurant.v1.RestaurantTeamService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// RestaurantTeamServiceListTeamMembersProcedure is the fully-qualified name of the
+	// RestaurantTeamService's ListTeamMembers RPC.
+	RestaurantTeamServiceListTeamMembersProcedure = "/restaurant.v1.RestaurantTeamService/ListTeamMembers"
+	// RestaurantTeamServiceInviteTeamMemberProcedure is the fully-qualified name of the
+	// RestaurantTeamService's InviteTeamMember RPC.
+	RestaurantTeamServiceInviteTeamMemberProcedure = "/restaurant.v1.RestaurantTeamService/InviteTeamMember"
+	// RestaurantTeamServiceListTeamInvitationsProcedure is the fully-qualified name of the
+	// RestaurantTeamService's ListTeamInvitations RPC.
+	RestaurantTeamServiceListTeamInvitationsProcedure = "/restaurant.v1.RestaurantTeamService/ListTeamInvitations"
+	// RestaurantTeamServiceRevokeTeamInvitationProcedure is the fully-qualified name of the
+	// RestaurantTeamService's RevokeTeamInvitation RPC.
+	RestaurantTeamServiceRevokeTeamInvitationProcedure = "/restaurant.v1.RestaurantTeamService/RevokeTeamInvitation"
+	// RestaurantTeamServiceAcceptTeamInvitationProcedure is the fully-qualified name of the
+	// RestaurantTeamService's AcceptTeamInvitation RPC.
+	RestaurantTeamServiceAcceptTeamInvitationProcedure = "/restaurant.v1.RestaurantTeamService/AcceptTeamInvitation"
+	// RestaurantTeamServiceUpdateTeamMemberRoleProcedure is the fully-qualified name of the
+	// RestaurantTeamService's UpdateTeamMemberRole RPC.
+	RestaurantTeamServiceUpdateTeamMemberRoleProcedure = "/restaurant.v1.RestaurantTeamService/UpdateTeamMemberRole"
+	// RestaurantTeamServiceRemoveTeamMemberProcedure is the fully-qualified name of the
+	// RestaurantTeamService's RemoveTeamMember RPC.
+	RestaurantTeamServiceRemoveTeamMemberProcedure = "/restaurant.v1.RestaurantTeamService/RemoveTeamMember"
+)
+
+// RestaurantTeamServiceClient is a client for the restaurant.v1.RestaurantTeamService service.
+type RestaurantTeamServiceClient interface {
+	// ListTeamMembers lists everyone with access to the caller's restaurant
+	ListTeamMembers(context.Context, *connect.Request[v1.ListTeamMembersRequest]) (*connect.Response[v1.ListTeamMembersResponse], error)
+	// InviteTeamMember emails an invitation; owners only
+	InviteTeamMember(context.Context, *connect.Request[v1.InviteTeamMemberRequest]) (*connect.Response[v1.InviteTeamMemberResponse], error)
+	// ListTeamInvitations lists invitations that have not been accepted; owners only
+	ListTeamInvitations(context.Context, *connect.Request[v1.ListTeamInvitationsRequest]) (*connect.Response[v1.ListTeamInvitationsResponse], error)
+	// RevokeTeamInvitation withdraws an open invitation; owners only
+	RevokeTeamInvitation(context.Context, *connect.Request[v1.RevokeTeamInvitationRequest]) (*connect.Response[v1.RevokeTeamInvitationResponse], error)
+	// AcceptTeamInvitation joins the inviting restaurant with the emailed token
+	AcceptTeamInvitation(context.Context, *connect.Request[v1.AcceptTeamInvitationRequest]) (*connect.Response[v1.AcceptTeamInvitationResponse], error)
+	// UpdateTeamMemberRole changes a member's role; owners only
+	UpdateTeamMemberRole(context.Context, *connect.Request[v1.UpdateTeamMemberRoleRequest]) (*connect.Response[v1.UpdateTeamMemberRoleResponse], error)
+	// RemoveTeamMember removes a member; owners only, or any member removing themselves
+	RemoveTeamMember(context.Context, *connect.Request[v1.RemoveTeamMemberRequest]) (*connect.Response[v1.RemoveTeamMemberResponse], error)
+}
+
+// NewRestaurantTeamServiceClient constructs a client for the restaurant.v1.RestaurantTeamService
+// service. By default, it uses the Connect protocol with the binary Protobuf Codec, asks for
+// gzipped responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply
+// the connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewRestaurantTeamServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) RestaurantTeamServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	restaurantTeamServiceMethods := v1.File_restaurant_v1_team_proto.Services().ByName("RestaurantTeamService").Methods()
+	return &restaurantTeamServiceClient{
+		listTeamMembers: connect.NewClient[v1.ListTeamMembersRequest, v1.ListTeamMembersResponse](
+			httpClient,
+			baseURL+RestaurantTeamServiceListTeamMembersProcedure,
+			connect.WithSchema(restaurantTeamServiceMethods.ByName("ListTeamMembers")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		inviteTeamMember: connect.NewClient[v1.InviteTeamMemberRequest, v1.InviteTeamMemberResponse](
+			httpClient,
+			baseURL+RestaurantTeamServiceInviteTeamMemberProcedure,
+			connect.WithSchema(restaurantTeamServiceMethods.ByName("InviteTeamMember")),
+			connect.WithClientOptions(opts...),
+		),
+		listTeamInvitations: connect.NewClient[v1.ListTeamInvitationsRequest, v1.ListTeamInvitationsResponse](
+			httpClient,
+			baseURL+RestaurantTeamServiceListTeamInvitationsProcedure,
+			connect.WithSchema(restaurantTeamServiceMethods.ByName("ListTeamInvitations")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		revokeTeamInvitation: connect.NewClient[v1.RevokeTeamInvitationRequest, v1.RevokeTeamInvitationResponse](
+			httpClient,
+			baseURL+RestaurantTeamServiceRevokeTeamInvitationProcedure,
+			connect.WithSchema(restaurantTeamServiceMethods.ByName("RevokeTeamInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		acceptTeamInvitation: connect.NewClient[v1.AcceptTeamInvitationRequest, v1.AcceptTeamInvitationResponse](
+			httpClient,
+			baseURL+RestaurantTeamServiceAcceptTeamInvitationProcedure,
+			connect.WithSchema(restaurantTeamServiceMethods.ByName("AcceptTeamInvitation")),
+			connect.WithClientOptions(opts...),
+		),
+		updateTeamMemberRole: connect.NewClient[v1.UpdateTeamMemberRoleRequest, v1.UpdateTeamMemberRoleResponse](
+			httpClient,
+			baseURL+RestaurantTeamServiceUpdateTeamMemberRoleProcedure,
+			connect.WithSchema(restaurantTeamServiceMethods.ByName("UpdateTeamMemberRole")),
+			connect.WithClientOptions(opts...),
+		),
+		removeTeamMember: connect.NewClient[v1.RemoveTeamMemberRequest, v1.RemoveTeamMemberResponse](
+			httpClient,
+			baseURL+RestaurantTeamServiceRemoveTeamMemberProcedure,
+			connect.WithSchema(restaurantTeamServiceMethods.ByName("RemoveTeamMember")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// restaurantTeamServiceClient implements RestaurantTeamServiceClient.
+type restaurantTeamServiceClient struct {
+	listTeamMembers      *connect.Client[v1.ListTeamMembersRequest, v1.ListTeamMembersResponse]
+	inviteTeamMember     *connect.Client[v1.InviteTeamMemberRequest, v1.InviteTeamMemberResponse]
+	listTeamInvitations  *connect.Client[v1.ListTeamInvitationsRequest, v1.ListTeamInvitationsResponse]
+	revokeTeamInvitation *connect.Client[v1.RevokeTeamInvitationRequest, v1.RevokeTeamInvitationResponse]
+	acceptTeamInvitation *connect.Client[v1.AcceptTeamInvitationRequest, v1.AcceptTeamInvitationResponse]
+	updateTeamMemberRole *connect.Client[v1.UpdateTeamMemberRoleRequest, v1.UpdateTeamMemberRoleResponse]
+	removeTeamMember     *connect.Client[v1.RemoveTeamMemberRequest, v1.RemoveTeamMemberResponse]
+}
+
+// ListTeamMembers calls restaurant.v1.RestaurantTeamService.ListTeamMembers.
+func (c *restaurantTeamServiceClient) ListTeamMembers(ctx context.Context, req *connect.Request[v1.ListTeamMembersRequest]) (*connect.Response[v1.ListTeamMembersResponse], error) {
+	return c.listTeamMembers.CallUnary(ctx, req)
+}
+
+// InviteTeamMember calls restaurant.v1.RestaurantTeamService.InviteTeamMember.
+func (c *restaurantTeamServiceClient) InviteTeamMember(ctx context.Context, req *connect.Request[v1.InviteTeamMemberRequest]) (*connect.Response[v1.InviteTeamMemberResponse], error) {
+	return c.inviteTeamMember.CallUnary(ctx, req)
+}
+
+// ListTeamInvitations calls restaurant.v1.RestaurantTeamService.ListTeamInvitations.
+func (c *restaurantTeamServiceClient) ListTeamInvitations(ctx context.Context, req *connect.Request[v1.ListTeamInvitationsRequest]) (*connect.Response[v1.ListTeamInvitationsResponse], error) {
+	return c.listTeamInvitations.CallUnary(ctx, req)
+}
+
+// RevokeTeamInvitation calls restaurant.v1.RestaurantTeamService.RevokeTeamInvitation.
+func (c *restaurantTeamServiceClient) RevokeTeamInvitation(ctx context.Context, req *connect.Request[v1.RevokeTeamInvitationRequest]) (*connect.Response[v1.RevokeTeamInvitationResponse], error) {
+	return c.revokeTeamInvitation.CallUnary(ctx, req)
+}
+
+// AcceptTeamInvitation calls restaurant.v1.RestaurantTeamService.AcceptTeamInvitation.
+func (c *restaurantTeamServiceClient) AcceptTeamInvitation(ctx context.Context, req *connect.Request[v1.AcceptTeamInvitationRequest]) (*connect.Response[v1.AcceptTeamInvitationResponse], error) {
+	return c.acceptTeamInvitation.CallUnary(ctx, req)
+}
+
+// UpdateTeamMemberRole calls restaurant.v1.RestaurantTeamService.UpdateTeamMemberRole.
+func (c *restaurantTeamServiceClient) UpdateTeamMemberRole(ctx context.Context, req *connect.Request[v1.UpdateTeamMemberRoleRequest]) (*connect.Response[v1.UpdateTeamMemberRoleResponse], error) {
+	return c.updateTeamMemberRole.CallUnary(ctx, req)
+}
+
+// RemoveTeamMember calls restaurant.v1.RestaurantTeamService.RemoveTeamMember.
+func (c *restaurantTeamServiceClient) RemoveTeamMember(ctx context.Context, req *connect.Request[v1.RemoveTeamMemberRequest]) (*connect.Response[v1.RemoveTeamMemberResponse], error) {
+	return c.removeTeamMember.CallUnary(ctx, req)
+}
+
+// RestaurantTeamServiceHandler is an implementation of the restaurant.v1.RestaurantTeamService
+// service.
+type RestaurantTeamServiceHandler interface {
+	// ListTeamMembers lists everyone with access to the caller's restaurant
+	ListTeamMembers(context.Context, *connect.Request[v1.ListTeamMembersRequest]) (*connect.Response[v1.ListTeamMembersResponse], error)
+	// InviteTeamMember emails an invitation; owners only
+	InviteTeamMember(context.Context, *connect.Request[v1.InviteTeamMemberRequest]) (*connect.Response[v1.InviteTeamMemberResponse], error)
+	// ListTeamInvitations lists invitations that have not been accepted; owners only
+	ListTeamInvitations(context.Context, *connect.Request[v1.ListTeamInvitationsRequest]) (*connect.Response[v1.ListTeamInvitationsResponse], error)
+	// RevokeTeamInvitation withdraws an open invitation; owners only
+	RevokeTeamInvitation(context.Context, *connect.Request[v1.RevokeTeamInvitationRequest]) (*connect.Response[v1.RevokeTeamInvitationResponse], error)
+	// AcceptTeamInvitation joins the inviting restaurant with the emailed token
+	AcceptTeamInvitation(context.Context, *connect.Request[v1.AcceptTeamInvitationRequest]) (*connect.Response[v1.AcceptTeamInvitationResponse], error)
+	// UpdateTeamMemberRole changes a member's role; owners only
+	UpdateTeamMemberRole(context.Context, *connect.Request[v1.UpdateTeamMemberRoleRequest]) (*connect.Response[v1.UpdateTeamMemberRoleResponse], error)
+	// RemoveTeamMember removes a member; owners only, or any member removing themselves
+	RemoveTeamMember(context.Context, *connect.Request[v1.RemoveTeamMemberRequest]) (*connect.Response[v1.RemoveTeamMemberResponse], error)
+}
+
+// NewRestaurantTeamServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewRestaurantTeamServiceHandler(svc RestaurantTeamServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	restaurantTeamServiceMethods := v1.File_restaurant_v1_team_proto.Services().ByName("RestaurantTeamService").Methods()
+	restaurantTeamServiceListTeamMembersHandler := connect.NewUnaryHandler(
+		RestaurantTeamServiceListTeamMembersProcedure,
+		svc.ListTeamMembers,
+		connect.WithSchema(restaurantTeamServiceMethods.ByName("ListTeamMembers")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	restaurantTeamServiceInviteTeamMemberHandler := connect.NewUnaryHandler(
+		RestaurantTeamServiceInviteTeamMemberProcedure,
+		svc.InviteTeamMember,
+		connect.WithSchema(restaurantTeamServiceMethods.ByName("InviteTeamMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	restaurantTeamServiceListTeamInvitationsHandler := connect.NewUnaryHandler(
+		RestaurantTeamServiceListTeamInvitationsProcedure,
+		svc.ListTeamInvitations,
+		connect.WithSchema(restaurantTeamServiceMethods.ByName("ListTeamInvitations")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	restaurantTeamServiceRevokeTeamInvitationHandler := connect.NewUnaryHandler(
+		RestaurantTeamServiceRevokeTeamInvitationProcedure,
+		svc.RevokeTeamInvitation,
+		connect.WithSchema(restaurantTeamServiceMethods.ByName("RevokeTeamInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	restaurantTeamServiceAcceptTeamInvitationHandler := connect.NewUnaryHandler(
+		RestaurantTeamServiceAcceptTeamInvitationProcedure,
+		svc.AcceptTeamInvitation,
+		connect.WithSchema(restaurantTeamServiceMethods.ByName("AcceptTeamInvitation")),
+		connect.WithHandlerOptions(opts...),
+	)
+	restaurantTeamServiceUpdateTeamMemberRoleHandler := connect.NewUnaryHandler(
+		RestaurantTeamServiceUpdateTeamMemberRoleProcedure,
+		svc.UpdateTeamMemberRole,
+		connect.WithSchema(restaurantTeamServiceMethods.ByName("UpdateTeamMemberRole")),
+		connect.WithHandlerOptions(opts...),
+	)
+	restaurantTeamServiceRemoveTeamMemberHandler := connect.NewUnaryHandler(
+		RestaurantTeamServiceRemoveTeamMemberProcedure,
+		svc.RemoveTeamMember,
+		connect.WithSchema(restaurantTeamServiceMethods.ByName("RemoveTeamMember")),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/restaurant.v1.RestaurantTeamService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case RestaurantTeamServiceListTeamMembersProcedure:
+			restaurantTeamServiceListTeamMembersHandler.ServeHTTP(w, r)
+		case RestaurantTeamServiceInviteTeamMemberProcedure:
+			restaurantTeamServiceInviteTeamMemberHandler.ServeHTTP(w, r)
+		case RestaurantTeamServiceListTeamInvitationsProcedure:
+			restaurantTeamServiceListTeamInvitationsHandler.ServeHTTP(w, r)
+		case RestaurantTeamServiceRevokeTeamInvitationProcedure:
+			restaurantTeamServiceRevokeTeamInvitationHandler.ServeHTTP(w, r)
+		case RestaurantTeamServiceAcceptTeamInvitationProcedure:
+			restaurantTeamServiceAcceptTeamInvitationHandler.ServeHTTP(w, r)
+		case RestaurantTeamServiceUpdateTeamMemberRoleProcedure:
+			restaurantTeamServiceUpdateTeamMemberRoleHandler.ServeHTTP(w, r)
+		case RestaurantTeamServiceRemoveTeamMemberProcedure:
+			restaurantTeamServiceRemoveTeamMemberHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedRestaurantTeamServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedRestaurantTeamServiceHandler struct{}
+
+func (UnimplementedRestaurantTeamServiceHandler) ListTeamMembers(context.Context, *connect.Request[v1.ListTeamMembersRequest]) (*connect.Response[v1.ListTeamMembersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("restaurant.v1.RestaurantTeamService.ListTeamMembers is not implemented"))
+}
+
+func (UnimplementedRestaurantTeamServiceHandler) InviteTeamMember(context.Context, *connect.Request[v1.InviteTeamMemberRequest]) (*connect.Response[v1.InviteTeamMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("restaurant.v1.RestaurantTeamService.InviteTeamMember is not implemented"))
+}
+
+func (UnimplementedRestaurantTeamServiceHandler) ListTeamInvitations(context.Context, *connect.Request[v1.ListTeamInvitationsRequest]) (*connect.Response[v1.ListTeamInvitationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("restaurant.v1.RestaurantTeamService.ListTeamInvitations is not implemented"))
+}
+
+func (UnimplementedRestaurantTeamServiceHandler) RevokeTeamInvitation(context.Context, *connect.Request[v1.RevokeTeamInvitationRequest]) (*connect.Response[v1.RevokeTeamInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("restaurant.v1.RestaurantTeamService.RevokeTeamInvitation is not implemented"))
+}
+
+func (UnimplementedRestaurantTeamServiceHandler) AcceptTeamInvitation(context.Context, *connect.Request[v1.AcceptTeamInvitationRequest]) (*connect.Response[v1.AcceptTeamInvitationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("restaurant.v1.RestaurantTeamService.AcceptTeamInvitation is not implemented"))
+}
+
+func (UnimplementedRestaurantTeamServiceHandler) UpdateTeamMemberRole(context.Context, *connect.Request[v1.UpdateTeamMemberRoleRequest]) (*connect.Response[v1.UpdateTeamMemberRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("restaurant.v1.RestaurantTeamService.UpdateTeamMemberRole is not implemented"))
+}
+
+func (UnimplementedRestaurantTeamServiceHandler) RemoveTeamMember(context.Context, *connect.Request[v1.RemoveTeamMemberRequest]) (*connect.Response[v1.RemoveTeamMemberResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("restaurant.v1.RestaurantTeamService.RemoveTeamMember is not implemented"))
+}
@@ -0,0 +1,1011 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: restaurant/v1/team.proto
+
+package restaurantv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "github.com/chefnext/chefnext/apps/api/internal/gen/chefnext/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TeamRole controls what a member can do for the restaurant
+type TeamRole int32
+
+const (
+	TeamRole_TEAM_ROLE_UNSPECIFIED TeamRole = 0
+	// Everything, including managing the team
+	TeamRole_TEAM_ROLE_OWNER TeamRole = 1
+	// Profile, jobs and applications
+	TeamRole_TEAM_ROLE_MANAGER TeamRole = 2
+	// Reviewing applications
+	TeamRole_TEAM_ROLE_RECRUITER TeamRole = 3
+	// Read-only access to jobs and applications
+	TeamRole_TEAM_ROLE_VIEWER TeamRole = 4
+)
+
+// Enum value maps for TeamRole.
+var (
+	TeamRole_name = map[int32]string{
+		0: "TEAM_ROLE_UNSPECIFIED",
+		1: "TEAM_ROLE_OWNER",
+		2: "TEAM_ROLE_MANAGER",
+		3: "TEAM_ROLE_RECRUITER",
+		4: "TEAM_ROLE_VIEWER",
+	}
+	TeamRole_value = map[string]int32{
+		"TEAM_ROLE_UNSPECIFIED": 0,
+		"TEAM_ROLE_OWNER":       1,
+		"TEAM_ROLE_MANAGER":     2,
+		"TEAM_ROLE_RECRUITER":   3,
+		"TEAM_ROLE_VIEWER":      4,
+	}
+)
+
+func (x TeamRole) Enum() *TeamRole {
+	p := new(TeamRole)
+	*p = x
+	return p
+}
+
+func (x TeamRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TeamRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_restaurant_v1_team_proto_enumTypes[0].Descriptor()
+}
+
+func (TeamRole) Type() protoreflect.EnumType {
+	return &file_restaurant_v1_team_proto_enumTypes[0]
+}
+
+func (x TeamRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TeamRole.Descriptor instead.
+func (TeamRole) EnumDescriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{0}
+}
+
+type TeamMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Role          TeamRole               `protobuf:"varint,4,opt,name=role,proto3,enum=restaurant.v1.TeamRole" json:"role,omitempty"`
+	JoinedAt      string                 `protobuf:"bytes,5,opt,name=joined_at,json=joinedAt,proto3" json:"joined_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TeamMember) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TeamMember) GetRole() TeamRole {
+	if x != nil {
+		return x.Role
+	}
+	return TeamRole_TEAM_ROLE_UNSPECIFIED
+}
+
+func (x *TeamMember) GetJoinedAt() string {
+	if x != nil {
+		return x.JoinedAt
+	}
+	return ""
+}
+
+type TeamInvitation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role          TeamRole               `protobuf:"varint,3,opt,name=role,proto3,enum=restaurant.v1.TeamRole" json:"role,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamInvitation) Reset() {
+	*x = TeamInvitation{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamInvitation) ProtoMessage() {}
+
+func (x *TeamInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamInvitation.ProtoReflect.Descriptor instead.
+func (*TeamInvitation) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TeamInvitation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TeamInvitation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *TeamInvitation) GetRole() TeamRole {
+	if x != nil {
+		return x.Role
+	}
+	return TeamRole_TEAM_ROLE_UNSPECIFIED
+}
+
+func (x *TeamInvitation) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *TeamInvitation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListTeamMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamMembersRequest) Reset() {
+	*x = ListTeamMembersRequest{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamMembersRequest) ProtoMessage() {}
+
+func (x *ListTeamMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamMembersRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamMembersRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{2}
+}
+
+type ListTeamMembersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Members       []*TeamMember          `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamMembersResponse) Reset() {
+	*x = ListTeamMembersResponse{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamMembersResponse) ProtoMessage() {}
+
+func (x *ListTeamMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamMembersResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamMembersResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListTeamMembersResponse) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type InviteTeamMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Role          TeamRole               `protobuf:"varint,2,opt,name=role,proto3,enum=restaurant.v1.TeamRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteTeamMemberRequest) Reset() {
+	*x = InviteTeamMemberRequest{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteTeamMemberRequest) ProtoMessage() {}
+
+func (x *InviteTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*InviteTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InviteTeamMemberRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InviteTeamMemberRequest) GetRole() TeamRole {
+	if x != nil {
+		return x.Role
+	}
+	return TeamRole_TEAM_ROLE_UNSPECIFIED
+}
+
+type InviteTeamMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitation    *TeamInvitation        `protobuf:"bytes,1,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InviteTeamMemberResponse) Reset() {
+	*x = InviteTeamMemberResponse{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InviteTeamMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InviteTeamMemberResponse) ProtoMessage() {}
+
+func (x *InviteTeamMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InviteTeamMemberResponse.ProtoReflect.Descriptor instead.
+func (*InviteTeamMemberResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *InviteTeamMemberResponse) GetInvitation() *TeamInvitation {
+	if x != nil {
+		return x.Invitation
+	}
+	return nil
+}
+
+type ListTeamInvitationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamInvitationsRequest) Reset() {
+	*x = ListTeamInvitationsRequest{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamInvitationsRequest) ProtoMessage() {}
+
+func (x *ListTeamInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListTeamInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{6}
+}
+
+type ListTeamInvitationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invitations   []*TeamInvitation      `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTeamInvitationsResponse) Reset() {
+	*x = ListTeamInvitationsResponse{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTeamInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTeamInvitationsResponse) ProtoMessage() {}
+
+func (x *ListTeamInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTeamInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListTeamInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTeamInvitationsResponse) GetInvitations() []*TeamInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+type RevokeTeamInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InvitationId  string                 `protobuf:"bytes,1,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTeamInvitationRequest) Reset() {
+	*x = RevokeTeamInvitationRequest{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTeamInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTeamInvitationRequest) ProtoMessage() {}
+
+func (x *RevokeTeamInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTeamInvitationRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTeamInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RevokeTeamInvitationRequest) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+type RevokeTeamInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTeamInvitationResponse) Reset() {
+	*x = RevokeTeamInvitationResponse{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTeamInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTeamInvitationResponse) ProtoMessage() {}
+
+func (x *RevokeTeamInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTeamInvitationResponse.ProtoReflect.Descriptor instead.
+func (*RevokeTeamInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RevokeTeamInvitationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AcceptTeamInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTeamInvitationRequest) Reset() {
+	*x = AcceptTeamInvitationRequest{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTeamInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTeamInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptTeamInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTeamInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptTeamInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AcceptTeamInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type AcceptTeamInvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *TeamMember            `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptTeamInvitationResponse) Reset() {
+	*x = AcceptTeamInvitationResponse{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptTeamInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptTeamInvitationResponse) ProtoMessage() {}
+
+func (x *AcceptTeamInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptTeamInvitationResponse.ProtoReflect.Descriptor instead.
+func (*AcceptTeamInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AcceptTeamInvitationResponse) GetMember() *TeamMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type UpdateTeamMemberRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	Role          TeamRole               `protobuf:"varint,2,opt,name=role,proto3,enum=restaurant.v1.TeamRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamMemberRoleRequest) Reset() {
+	*x = UpdateTeamMemberRoleRequest{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamMemberRoleRequest) ProtoMessage() {}
+
+func (x *UpdateTeamMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTeamMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateTeamMemberRoleRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+func (x *UpdateTeamMemberRoleRequest) GetRole() TeamRole {
+	if x != nil {
+		return x.Role
+	}
+	return TeamRole_TEAM_ROLE_UNSPECIFIED
+}
+
+type UpdateTeamMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *TeamMember            `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTeamMemberRoleResponse) Reset() {
+	*x = UpdateTeamMemberRoleResponse{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTeamMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTeamMemberRoleResponse) ProtoMessage() {}
+
+func (x *UpdateTeamMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTeamMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTeamMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateTeamMemberRoleResponse) GetMember() *TeamMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type RemoveTeamMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemberId      string                 `protobuf:"bytes,1,opt,name=member_id,json=memberId,proto3" json:"member_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamMemberRequest) Reset() {
+	*x = RemoveTeamMemberRequest{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamMemberRequest) ProtoMessage() {}
+
+func (x *RemoveTeamMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTeamMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTeamMemberRequest) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoveTeamMemberRequest) GetMemberId() string {
+	if x != nil {
+		return x.MemberId
+	}
+	return ""
+}
+
+type RemoveTeamMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTeamMemberResponse) Reset() {
+	*x = RemoveTeamMemberResponse{}
+	mi := &file_restaurant_v1_team_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTeamMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTeamMemberResponse) ProtoMessage() {}
+
+func (x *RemoveTeamMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_restaurant_v1_team_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTeamMemberResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTeamMemberResponse) Descriptor() ([]byte, []int) {
+	return file_restaurant_v1_team_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RemoveTeamMemberResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+var File_restaurant_v1_team_proto protoreflect.FileDescriptor
+
+const file_restaurant_v1_team_proto_rawDesc = "" +
+	"\n" +
+	"\x18restaurant/v1/team.proto\x12\rrestaurant.v1\x1a\x1bbuf/validate/validate.proto\x1a\x16chefnext/v1/auth.proto\"\x95\x01\n" +
+	"\n" +
+	"TeamMember\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12+\n" +
+	"\x04role\x18\x04 \x01(\x0e2\x17.restaurant.v1.TeamRoleR\x04role\x12\x1b\n" +
+	"\tjoined_at\x18\x05 \x01(\tR\bjoinedAt\"\xa1\x01\n" +
+	"\x0eTeamInvitation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12+\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x17.restaurant.v1.TeamRoleR\x04role\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"\x18\n" +
+	"\x16ListTeamMembersRequest\"N\n" +
+	"\x17ListTeamMembersResponse\x123\n" +
+	"\amembers\x18\x01 \x03(\v2\x19.restaurant.v1.TeamMemberR\amembers\"q\n" +
+	"\x17InviteTeamMemberRequest\x12\x1d\n" +
+	"\x05email\x18\x01 \x01(\tB\a\xbaH\x04r\x02`\x01R\x05email\x127\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x17.restaurant.v1.TeamRoleB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04role\"Y\n" +
+	"\x18InviteTeamMemberResponse\x12=\n" +
+	"\n" +
+	"invitation\x18\x01 \x01(\v2\x1d.restaurant.v1.TeamInvitationR\n" +
+	"invitation\"\x1c\n" +
+	"\x1aListTeamInvitationsRequest\"^\n" +
+	"\x1bListTeamInvitationsResponse\x12?\n" +
+	"\vinvitations\x18\x01 \x03(\v2\x1d.restaurant.v1.TeamInvitationR\vinvitations\"L\n" +
+	"\x1bRevokeTeamInvitationRequest\x12-\n" +
+	"\rinvitation_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\finvitationId\"8\n" +
+	"\x1cRevokeTeamInvitationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"3\n" +
+	"\x1bAcceptTeamInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"Q\n" +
+	"\x1cAcceptTeamInvitationResponse\x121\n" +
+	"\x06member\x18\x01 \x01(\v2\x19.restaurant.v1.TeamMemberR\x06member\"}\n" +
+	"\x1bUpdateTeamMemberRoleRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bmemberId\x127\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x17.restaurant.v1.TeamRoleB\n" +
+	"\xbaH\a\x82\x01\x04\x10\x01 \x00R\x04role\"Q\n" +
+	"\x1cUpdateTeamMemberRoleResponse\x121\n" +
+	"\x06member\x18\x01 \x01(\v2\x19.restaurant.v1.TeamMemberR\x06member\"@\n" +
+	"\x17RemoveTeamMemberRequest\x12%\n" +
+	"\tmember_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bmemberId\"4\n" +
+	"\x18RemoveTeamMemberResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess*\x80\x01\n" +
+	"\bTeamRole\x12\x19\n" +
+	"\x15TEAM_ROLE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fTEAM_ROLE_OWNER\x10\x01\x12\x15\n" +
+	"\x11TEAM_ROLE_MANAGER\x10\x02\x12\x17\n" +
+	"\x13TEAM_ROLE_RECRUITER\x10\x03\x12\x14\n" +
+	"\x10TEAM_ROLE_VIEWER\x10\x042\xc9\x06\n" +
+	"\x15RestaurantTeamService\x12l\n" +
+	"\x0fListTeamMembers\x12%.restaurant.v1.ListTeamMembersRequest\x1a&.restaurant.v1.ListTeamMembersResponse\"\n" +
+	"\x8a\xb5\x18\x03\x12\x01\x02\x90\x02\x01\x12l\n" +
+	"\x10InviteTeamMember\x12&.restaurant.v1.InviteTeamMemberRequest\x1a'.restaurant.v1.InviteTeamMemberResponse\"\a\x8a\xb5\x18\x03\x12\x01\x02\x12x\n" +
+	"\x13ListTeamInvitations\x12).restaurant.v1.ListTeamInvitationsRequest\x1a*.restaurant.v1.ListTeamInvitationsResponse\"\n" +
+	"\x8a\xb5\x18\x03\x12\x01\x02\x90\x02\x01\x12x\n" +
+	"\x14RevokeTeamInvitation\x12*.restaurant.v1.RevokeTeamInvitationRequest\x1a+.restaurant.v1.RevokeTeamInvitationResponse\"\a\x8a\xb5\x18\x03\x12\x01\x02\x12x\n" +
+	"\x14AcceptTeamInvitation\x12*.restaurant.v1.AcceptTeamInvitationRequest\x1a+.restaurant.v1.AcceptTeamInvitationResponse\"\a\x8a\xb5\x18\x03\x12\x01\x02\x12x\n" +
+	"\x14UpdateTeamMemberRole\x12*.restaurant.v1.UpdateTeamMemberRoleRequest\x1a+.restaurant.v1.UpdateTeamMemberRoleResponse\"\a\x8a\xb5\x18\x03\x12\x01\x02\x12l\n" +
+	"\x10RemoveTeamMember\x12&.restaurant.v1.RemoveTeamMemberRequest\x1a'.restaurant.v1.RemoveTeamMemberResponse\"\a\x8a\xb5\x18\x03\x12\x01\x02B\xc2\x01\n" +
+	"\x11com.restaurant.v1B\tTeamProtoP\x01ZMgithub.com/chefnext/chefnext/apps/api/internal/gen/restaurant/v1;restaurantv1\xa2\x02\x03RXX\xaa\x02\rRestaurant.V1\xca\x02\rRestaurant\\V1\xe2\x02\x19Restaurant\\V1\\GPBMetadata\xea\x02\x0eRestaurant::V1b\x06proto3"
+
+var (
+	file_restaurant_v1_team_proto_rawDescOnce sync.Once
+	file_restaurant_v1_team_proto_rawDescData []byte
+)
+
+func file_restaurant_v1_team_proto_rawDescGZIP() []byte {
+	file_restaurant_v1_team_proto_rawDescOnce.Do(func() {
+		file_restaurant_v1_team_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_restaurant_v1_team_proto_rawDesc), len(file_restaurant_v1_team_proto_rawDesc)))
+	})
+	return file_restaurant_v1_team_proto_rawDescData
+}
+
+var file_restaurant_v1_team_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_restaurant_v1_team_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_restaurant_v1_team_proto_goTypes = []any{
+	(TeamRole)(0),                        // 0: restaurant.v1.TeamRole
+	(*TeamMember)(nil),                   // 1: restaurant.v1.TeamMember
+	(*TeamInvitation)(nil),               // 2: restaurant.v1.TeamInvitation
+	(*ListTeamMembersRequest)(nil),       // 3: restaurant.v1.ListTeamMembersRequest
+	(*ListTeamMembersResponse)(nil),      // 4: restaurant.v1.ListTeamMembersResponse
+	(*InviteTeamMemberRequest)(nil),      // 5: restaurant.v1.InviteTeamMemberRequest
+	(*InviteTeamMemberResponse)(nil),     // 6: restaurant.v1.InviteTeamMemberResponse
+	(*ListTeamInvitationsRequest)(nil),   // 7: restaurant.v1.ListTeamInvitationsRequest
+	(*ListTeamInvitationsResponse)(nil),  // 8: restaurant.v1.ListTeamInvitationsResponse
+	(*RevokeTeamInvitationRequest)(nil),  // 9: restaurant.v1.RevokeTeamInvitationRequest
+	(*RevokeTeamInvitationResponse)(nil), // 10: restaurant.v1.RevokeTeamInvitationResponse
+	(*AcceptTeamInvitationRequest)(nil),  // 11: restaurant.v1.AcceptTeamInvitationRequest
+	(*AcceptTeamInvitationResponse)(nil), // 12: restaurant.v1.AcceptTeamInvitationResponse
+	(*UpdateTeamMemberRoleRequest)(nil),  // 13: restaurant.v1.UpdateTeamMemberRoleRequest
+	(*UpdateTeamMemberRoleResponse)(nil), // 14: restaurant.v1.UpdateTeamMemberRoleResponse
+	(*RemoveTeamMemberRequest)(nil),      // 15: restaurant.v1.RemoveTeamMemberRequest
+	(*RemoveTeamMemberResponse)(nil),     // 16: restaurant.v1.RemoveTeamMemberResponse
+}
+var file_restaurant_v1_team_proto_depIdxs = []int32{
+	0,  // 0: restaurant.v1.TeamMember.role:type_name -> restaurant.v1.TeamRole
+	0,  // 1: restaurant.v1.TeamInvitation.role:type_name -> restaurant.v1.TeamRole
+	1,  // 2: restaurant.v1.ListTeamMembersResponse.members:type_name -> restaurant.v1.TeamMember
+	0,  // 3: restaurant.v1.InviteTeamMemberRequest.role:type_name -> restaurant.v1.TeamRole
+	2,  // 4: restaurant.v1.InviteTeamMemberResponse.invitation:type_name -> restaurant.v1.TeamInvitation
+	2,  // 5: restaurant.v1.ListTeamInvitationsResponse.invitations:type_name -> restaurant.v1.TeamInvitation
+	1,  // 6: restaurant.v1.AcceptTeamInvitationResponse.member:type_name -> restaurant.v1.TeamMember
+	0,  // 7: restaurant.v1.UpdateTeamMemberRoleRequest.role:type_name -> restaurant.v1.TeamRole
+	1,  // 8: restaurant.v1.UpdateTeamMemberRoleResponse.member:type_name -> restaurant.v1.TeamMember
+	3,  // 9: restaurant.v1.RestaurantTeamService.ListTeamMembers:input_type -> restaurant.v1.ListTeamMembersRequest
+	5,  // 10: restaurant.v1.RestaurantTeamService.InviteTeamMember:input_type -> restaurant.v1.InviteTeamMemberRequest
+	7,  // 11: restaurant.v1.RestaurantTeamService.ListTeamInvitations:input_type -> restaurant.v1.ListTeamInvitationsRequest
+	9,  // 12: restaurant.v1.RestaurantTeamService.RevokeTeamInvitation:input_type -> restaurant.v1.RevokeTeamInvitationRequest
+	11, // 13: restaurant.v1.RestaurantTeamService.AcceptTeamInvitation:input_type -> restaurant.v1.AcceptTeamInvitationRequest
+	13, // 14: restaurant.v1.RestaurantTeamService.UpdateTeamMemberRole:input_type -> restaurant.v1.UpdateTeamMemberRoleRequest
+	15, // 15: restaurant.v1.RestaurantTeamService.RemoveTeamMember:input_type -> restaurant.v1.RemoveTeamMemberRequest
+	4,  // 16: restaurant.v1.RestaurantTeamService.ListTeamMembers:output_type -> restaurant.v1.ListTeamMembersResponse
+	6,  // 17: restaurant.v1.RestaurantTeamService.InviteTeamMember:output_type -> restaurant.v1.InviteTeamMemberResponse
+	8,  // 18: restaurant.v1.RestaurantTeamService.ListTeamInvitations:output_type -> restaurant.v1.ListTeamInvitationsResponse
+	10, // 19: restaurant.v1.RestaurantTeamService.RevokeTeamInvitation:output_type -> restaurant.v1.RevokeTeamInvitationResponse
+	12, // 20: restaurant.v1.RestaurantTeamService.AcceptTeamInvitation:output_type -> restaurant.v1.AcceptTeamInvitationResponse
+	14, // 21: restaurant.v1.RestaurantTeamService.UpdateTeamMemberRole:output_type -> restaurant.v1.UpdateTeamMemberRoleResponse
+	16, // 22: restaurant.v1.RestaurantTeamService.RemoveTeamMember:output_type -> restaurant.v1.RemoveTeamMemberResponse
+	16, // [16:23] is the sub-list for method output_type
+	9,  // [9:16] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_restaurant_v1_team_proto_init() }
+func file_restaurant_v1_team_proto_init() {
+	if File_restaurant_v1_team_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_restaurant_v1_team_proto_rawDesc), len(file_restaurant_v1_team_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_restaurant_v1_team_proto_goTypes,
+		DependencyIndexes: file_restaurant_v1_team_proto_depIdxs,
+		EnumInfos:         file_restaurant_v1_team_proto_enumTypes,
+		MessageInfos:      file_restaurant_v1_team_proto_msgTypes,
+	}.Build()
+	File_restaurant_v1_team_proto = out.File
+	file_restaurant_v1_team_proto_goTypes = nil
+	file_restaurant_v1_team_proto_depIdxs = nil
+}
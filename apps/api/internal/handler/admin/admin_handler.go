@@ -0,0 +1,364 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	adminv1 "github.com/chefnext/chefnext/apps/api/internal/gen/admin/v1"
+	"github.com/chefnext/chefnext/apps/api/internal/gen/admin/v1/adminv1connect"
+	identityv1 "github.com/chefnext/chefnext/apps/api/internal/gen/identity/v1"
+	kycv1 "github.com/chefnext/chefnext/apps/api/internal/gen/kyc/v1"
+	audithandler "github.com/chefnext/chefnext/apps/api/internal/handler/audit"
+	kychandler "github.com/chefnext/chefnext/apps/api/internal/handler/kyc"
+	"github.com/chefnext/chefnext/apps/api/internal/middleware"
+	adminusecase "github.com/chefnext/chefnext/apps/api/internal/usecase/admin"
+	"github.com/chefnext/chefnext/apps/api/internal/usecase/auditlog"
+	kycusecase "github.com/chefnext/chefnext/apps/api/internal/usecase/kyc"
+	"github.com/google/uuid"
+)
+
+// Handler implements the AdminService RPCs. Access is restricted to admins by
+// the auth policies of the RPCs.
+type Handler struct {
+	service      *adminusecase.Service
+	kycService   *kycusecase.Service
+	auditService *auditlog.Service
+}
+
+// NewAdminHandler wires an admin handler implementation. KYC decisions go
+// through the admin service so they are audited; reads use kycService directly.
+func NewAdminHandler(service *adminusecase.Service, kycService *kycusecase.Service, auditService *auditlog.Service) adminv1connect.AdminServiceHandler {
+	return &Handler{service: service, kycService: kycService, auditService: auditService}
+}
+
+func (h *Handler) SearchUsers(ctx context.Context, req *connect.Request[adminv1.SearchUsersRequest]) (*connect.Response[adminv1.SearchUsersResponse], error) {
+	input := adminusecase.SearchUsersInput{
+		Email:         req.Msg.GetEmail(),
+		SuspendedOnly: req.Msg.GetSuspendedOnly(),
+		Limit:         req.Msg.GetLimit(),
+		Offset:        req.Msg.GetOffset(),
+	}
+	if req.Msg.GetRole() != identityv1.UserRole_USER_ROLE_UNSPECIFIED {
+		role, err := fromProtoRole(req.Msg.GetRole())
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		input.Role = role
+	}
+
+	out, err := h.service.SearchUsers(ctx, input)
+	if err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	users := make([]*adminv1.User, 0, len(out.Users))
+	for _, user := range out.Users {
+		users = append(users, toProtoUser(user))
+	}
+
+	return connect.NewResponse(&adminv1.SearchUsersResponse{
+		Users:      users,
+		TotalCount: out.Total,
+	}), nil
+}
+
+func (h *Handler) GetUser(ctx context.Context, req *connect.Request[adminv1.GetUserRequest]) (*connect.Response[adminv1.GetUserResponse], error) {
+	userID, err := parseUUID(req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.service.GetUser(ctx, userID)
+	if err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	return connect.NewResponse(&adminv1.GetUserResponse{User: toProtoUser(user)}), nil
+}
+
+func (h *Handler) ChangeUserRole(ctx context.Context, req *connect.Request[adminv1.ChangeUserRoleRequest]) (*connect.Response[adminv1.ChangeUserRoleResponse], error) {
+	userID, err := parseUUID(req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := fromProtoRole(req.Msg.GetRole())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	user, err := h.service.ChangeUserRole(ctx, adminusecase.ChangeUserRoleInput{
+		UserID: userID,
+		Role:   role,
+		Reason: strings.TrimSpace(req.Msg.GetReason()),
+	})
+	if err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	return connect.NewResponse(&adminv1.ChangeUserRoleResponse{User: toProtoUser(user)}), nil
+}
+
+func (h *Handler) SuspendUser(ctx context.Context, req *connect.Request[adminv1.SuspendUserRequest]) (*connect.Response[adminv1.SuspendUserResponse], error) {
+	userID, err := parseUUID(req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.service.SuspendUser(ctx, adminusecase.UserActionInput{
+		UserID: userID,
+		Reason: strings.TrimSpace(req.Msg.GetReason()),
+	})
+	if err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	return connect.NewResponse(&adminv1.SuspendUserResponse{User: toProtoUser(user)}), nil
+}
+
+func (h *Handler) ReactivateUser(ctx context.Context, req *connect.Request[adminv1.ReactivateUserRequest]) (*connect.Response[adminv1.ReactivateUserResponse], error) {
+	userID, err := parseUUID(req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := h.service.ReactivateUser(ctx, adminusecase.UserActionInput{
+		UserID: userID,
+		Reason: strings.TrimSpace(req.Msg.GetReason()),
+	})
+	if err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	return connect.NewResponse(&adminv1.ReactivateUserResponse{User: toProtoUser(user)}), nil
+}
+
+func (h *Handler) ForceLogout(ctx context.Context, req *connect.Request[adminv1.ForceLogoutRequest]) (*connect.Response[adminv1.ForceLogoutResponse], error) {
+	userID, err := parseUUID(req.Msg.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.service.ForceLogout(ctx, adminusecase.UserActionInput{
+		UserID: userID,
+		Reason: strings.TrimSpace(req.Msg.GetReason()),
+	}); err != nil {
+		return nil, mapAdminError(err)
+	}
+
+	return connect.NewResponse(&adminv1.ForceLogoutResponse{Success: true}), nil
+}
+
+func (h *Handler) ListAuditEvents(ctx context.Context, req *connect.Request[adminv1.ListAuditEventsRequest]) (*connect.Response[adminv1.ListAuditEventsResponse], error) {
+	input, err := audithandler.ListInputFromFilter(req.Msg.GetTargetType(), req.Msg.GetTargetId(), req.Msg.GetActorUserId())
+	if err != nil {
+		return nil, err
+	}
+	if raw := strings.TrimSpace(req.Msg.GetRestaurantId()); raw != "" {
+		restaurantID, err := parseUUID(raw)
+		if err != nil {
+			return nil, err
+		}
+		input.RestaurantID = restaurantID
+	}
+	input.Limit = req.Msg.GetLimit()
+	input.Offset = req.Msg.GetOffset()
+
+	out, err := h.auditService.List(ctx, input)
+	if err != nil {
+		return nil, audithandler.MapAuditError(err)
+	}
+
+	return connect.NewResponse(&adminv1.ListAuditEventsResponse{
+		Events:     audithandler.ToProtoEvents(out.Events),
+		TotalCount: out.Total,
+	}), nil
+}
+
+func (h *Handler) ListKycSubmissions(ctx context.Context, req *connect.Request[adminv1.ListKycSubmissionsRequest]) (*connect.Response[adminv1.ListKycSubmissionsResponse], error) {
+	out, err := h.kycService.ListSubmissions(ctx, kycusecase.ListSubmissionsInput{
+		Status: kychandler.FromProtoSubmissionStatus(req.Msg.GetStatus()),
+		Limit:  req.Msg.GetLimit(),
+		Offset: req.Msg.GetOffset(),
+	})
+	if err != nil {
+		return nil, kychandler.MapKYCError(err)
+	}
+
+	submissions := make([]*kycv1.KycSubmission, 0, len(out.Submissions))
+	for _, submission := range out.Submissions {
+		submissions = append(submissions, kychandler.ToProtoSubmission(submission))
+	}
+
+	return connect.NewResponse(&adminv1.ListKycSubmissionsResponse{
+		Submissions: submissions,
+		TotalCount:  out.Total,
+	}), nil
+}
+
+func (h *Handler) GetKycSubmission(ctx context.Context, req *connect.Request[adminv1.GetKycSubmissionRequest]) (*connect.Response[adminv1.GetKycSubmissionResponse], error) {
+	submissionID, err := parseUUID(req.Msg.GetSubmissionId())
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := h.kycService.GetSubmission(ctx, submissionID)
+	if err != nil {
+		return nil, kychandler.MapKYCError(err)
+	}
+
+	return connect.NewResponse(&adminv1.GetKycSubmissionResponse{
+		Submission: kychandler.ToProtoSubmission(submission),
+	}), nil
+}
+
+func (h *Handler) GetKycDocument(ctx context.Context, req *connect.Request[adminv1.GetKycDocumentRequest]) (*connect.Response[adminv1.GetKycDocumentResponse], error) {
+	documentID, err := parseUUID(req.Msg.GetDocumentId())
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := h.kycService.GetDocument(ctx, documentID)
+	if err != nil {
+		return nil, kychandler.MapKYCError(err)
+	}
+
+	return connect.NewResponse(&adminv1.GetKycDocumentResponse{
+		Document: kychandler.ToProtoDocument(content.Document),
+		Content:  content.Data,
+	}), nil
+}
+
+func (h *Handler) ApproveKyc(ctx context.Context, req *connect.Request[adminv1.ApproveKycRequest]) (*connect.Response[adminv1.ApproveKycResponse], error) {
+	actor, err := actorFromRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	submissionID, err := parseUUID(req.Msg.GetSubmissionId())
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := h.service.ApproveKYC(ctx, actor, adminusecase.KYCReviewInput{
+		SubmissionID: submissionID,
+		Note:         req.Msg.GetNote(),
+	})
+	if err != nil {
+		return nil, kychandler.MapKYCError(err)
+	}
+
+	return connect.NewResponse(&adminv1.ApproveKycResponse{
+		Submission: kychandler.ToProtoSubmission(submission),
+	}), nil
+}
+
+func (h *Handler) RejectKyc(ctx context.Context, req *connect.Request[adminv1.RejectKycRequest]) (*connect.Response[adminv1.RejectKycResponse], error) {
+	actor, err := actorFromRequest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	submissionID, err := parseUUID(req.Msg.GetSubmissionId())
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := h.service.RejectKYC(ctx, actor, adminusecase.KYCReviewInput{
+		SubmissionID: submissionID,
+		Reasons:      req.Msg.GetReasons(),
+		Note:         req.Msg.GetNote(),
+	})
+	if err != nil {
+		return nil, kychandler.MapKYCError(err)
+	}
+
+	return connect.NewResponse(&adminv1.RejectKycResponse{
+		Submission: kychandler.ToProtoSubmission(submission),
+	}), nil
+}
+
+func mapAdminError(err error) error {
+	switch {
+	case errors.Is(err, adminusecase.ErrUserNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, adminusecase.ErrInvalidRole):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, adminusecase.ErrProtectedAccount):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case errors.Is(err, adminusecase.ErrAlreadySuspended), errors.Is(err, adminusecase.ErrNotSuspended):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func actorFromRequest(ctx context.Context) (adminusecase.Actor, error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return adminusecase.Actor{}, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user context"))
+	}
+
+	return adminusecase.Actor{UserID: userID}, nil
+}
+
+func parseUUID(raw string) (uuid.UUID, error) {
+	userID, err := uuid.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return userID, nil
+}
+
+func fromProtoRole(role identityv1.UserRole) (string, error) {
+	switch role {
+	case identityv1.UserRole_USER_ROLE_CHEF:
+		return adminusecase.RoleChef, nil
+	case identityv1.UserRole_USER_ROLE_RESTAURANT:
+		return adminusecase.RoleRestaurant, nil
+	case identityv1.UserRole_USER_ROLE_ADMIN:
+		return adminusecase.RoleAdmin, nil
+	default:
+		return "", adminusecase.ErrInvalidRole
+	}
+}
+
+func toProtoRole(role string) identityv1.UserRole {
+	switch role {
+	case adminusecase.RoleChef:
+		return identityv1.UserRole_USER_ROLE_CHEF
+	case adminusecase.RoleRestaurant:
+		return identityv1.UserRole_USER_ROLE_RESTAURANT
+	case adminusecase.RoleAdmin:
+		return identityv1.UserRole_USER_ROLE_ADMIN
+	default:
+		return identityv1.UserRole_USER_ROLE_UNSPECIFIED
+	}
+}
+
+func toProtoUser(user *adminusecase.User) *adminv1.User {
+	if user == nil {
+		return nil
+	}
+
+	protoUser := &adminv1.User{
+		Id:               user.ID.String(),
+		Email:            user.Email,
+		Role:             toProtoRole(user.Role),
+		Roles:            make([]identityv1.UserRole, 0, len(user.Roles)),
+		KycStatus:        user.KYCStatus,
+		EmailVerified:    user.EmailVerified,
+		SuspensionReason: user.SuspensionReason,
+		CreatedAt:        user.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	for _, role := range user.Roles {
+		protoUser.Roles = append(protoUser.Roles, toProtoRole(role))
+	}
+	if user.SuspendedAt != nil {
+		protoUser.SuspendedAt = user.SuspendedAt.UTC().Format(time.RFC3339)
+	}
+	return protoUser
+}
@@ -0,0 +1,118 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	auditv1 "github.com/chefnext/chefnext/apps/api/internal/gen/audit/v1"
+	"github.com/chefnext/chefnext/apps/api/internal/gen/audit/v1/auditv1connect"
+	"github.com/chefnext/chefnext/apps/api/internal/middleware"
+	"github.com/chefnext/chefnext/apps/api/internal/usecase/auditlog"
+	"github.com/google/uuid"
+)
+
+// Handler implements the AuditService RPCs.
+type Handler struct {
+	service *auditlog.Service
+}
+
+// NewAuditHandler wires an audit log handler implementation.
+func NewAuditHandler(service *auditlog.Service) auditv1connect.AuditServiceHandler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) ListAuditEvents(ctx context.Context, req *connect.Request[auditv1.ListAuditEventsRequest]) (*connect.Response[auditv1.ListAuditEventsResponse], error) {
+	userID, err := middleware.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input, err := ListInputFromFilter(req.Msg.GetTargetType(), req.Msg.GetTargetId(), req.Msg.GetActorUserId())
+	if err != nil {
+		return nil, err
+	}
+	input.Limit = req.Msg.GetLimit()
+	input.Offset = req.Msg.GetOffset()
+
+	out, err := h.service.ListForRestaurant(ctx, userID, input)
+	if err != nil {
+		return nil, MapAuditError(err)
+	}
+
+	return connect.NewResponse(&auditv1.ListAuditEventsResponse{
+		Events:     ToProtoEvents(out.Events),
+		TotalCount: out.Total,
+	}), nil
+}
+
+// ListInputFromFilter parses the filters shared by the restaurant and admin
+// audit log RPCs.
+func ListInputFromFilter(targetType, targetID, actorUserID string) (auditlog.ListInput, error) {
+	input := auditlog.ListInput{TargetType: strings.TrimSpace(targetType)}
+
+	var err error
+	if input.TargetID, err = parseOptionalUUID(targetID); err != nil {
+		return auditlog.ListInput{}, err
+	}
+	if input.ActorUserID, err = parseOptionalUUID(actorUserID); err != nil {
+		return auditlog.ListInput{}, err
+	}
+	return input, nil
+}
+
+// MapAuditError converts audit log use case errors to Connect errors.
+func MapAuditError(err error) error {
+	switch {
+	case errors.Is(err, auditlog.ErrNotMember):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, auditlog.ErrForbidden):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// ToProtoEvents converts audit events for the audit and admin APIs.
+func ToProtoEvents(events []*auditlog.Event) []*auditv1.AuditEvent {
+	protoEvents := make([]*auditv1.AuditEvent, 0, len(events))
+	for _, event := range events {
+		protoEvents = append(protoEvents, &auditv1.AuditEvent{
+			Id:           event.ID.String(),
+			ActorUserId:  optionalUUID(event.ActorUserID),
+			ActorRole:    event.ActorRole,
+			ApiKeyId:     optionalUUID(event.APIKeyID),
+			Procedure:    event.Procedure,
+			Action:       event.Action,
+			TargetType:   event.TargetType,
+			TargetId:     event.TargetID.String(),
+			RestaurantId: optionalUUID(event.RestaurantID),
+			ChangesJson:  string(event.Changes),
+			IpAddress:    event.IPAddress,
+			RequestId:    event.RequestID,
+			CreatedAt:    event.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return protoEvents
+}
+
+func parseOptionalUUID(raw string) (uuid.UUID, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return uuid.Nil, nil
+	}
+	id, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return id, nil
+}
+
+func optionalUUID(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+	return id.String()
+}
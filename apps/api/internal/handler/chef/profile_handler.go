@@ -26,7 +26,7 @@ func NewProfileHandler(service *chefprofile.Service) chefv1connect.ChefProfileSe
 
 // CreateProfile creates a new chef profile for the authenticated chef.
 func (h *ProfileHandler) CreateProfile(ctx context.Context, req *connect.Request[chefv1.CreateProfileRequest]) (*connect.Response[chefv1.CreateProfileResponse], error) {
-	userID, err := h.requireRole(ctx, "CHEF")
+	userID, err := middleware.RequireUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -77,7 +77,7 @@ func (h *ProfileHandler) GetProfile(ctx context.Context, req *connect.Request[ch
 
 // GetMyProfile returns the authenticated chef's profile.
 func (h *ProfileHandler) GetMyProfile(ctx context.Context, _ *connect.Request[chefv1.GetMyProfileRequest]) (*connect.Response[chefv1.GetMyProfileResponse], error) {
-	userID, err := h.requireRole(ctx, "CHEF")
+	userID, err := middleware.RequireUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +92,7 @@ func (h *ProfileHandler) GetMyProfile(ctx context.Context, _ *connect.Request[ch
 
 // UpdateProfile updates fields on the chef profile.
 func (h *ProfileHandler) UpdateProfile(ctx context.Context, req *connect.Request[chefv1.UpdateProfileRequest]) (*connect.Response[chefv1.UpdateProfileResponse], error) {
-	userID, err := h.requireRole(ctx, "CHEF")
+	userID, err := middleware.RequireUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -164,19 +164,6 @@ func (h *ProfileHandler) SearchProfiles(ctx context.Context, req *connect.Reques
 	}), nil
 }
 
-func (h *ProfileHandler) requireRole(ctx context.Context, role string) (uuid.UUID, error) {
-	userID, userRole, err := h.getUserContext(ctx)
-	if err != nil {
-		return uuid.UUID{}, err
-	}
-
-	if userRole != role {
-		return uuid.UUID{}, connect.NewError(connect.CodePermissionDenied, errors.New("insufficient role"))
-	}
-
-	return userID, nil
-}
-
 func (h *ProfileHandler) getUserContext(ctx context.Context) (uuid.UUID, string, error) {
 	userID, ok := middleware.GetUserID(ctx)
 	if !ok {
@@ -255,7 +242,7 @@ func mapChefError(err error) error {
 	case errors.Is(err, chefprofile.ErrProfileNotFound):
 		return connect.NewError(connect.CodeNotFound, err)
 	case errors.Is(err, chefprofile.ErrInvalidSkillTreeJSON):
-		return connect.NewError(connect.CodeInvalidArgument, err)
+		return middleware.NewFieldError("skill_tree_json", err)
 	case errors.Is(err, chefprofile.ErrUnauthorizedProfileAccess):
 		return connect.NewError(connect.CodePermissionDenied, err)
 	default:
@@ -2,20 +2,42 @@ package identity
 
 import (
 	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	identityv1 "github.com/chefnext/chefnext/apps/api/internal/gen/identity/v1"
 	"github.com/chefnext/chefnext/apps/api/internal/gen/identity/v1/identityv1connect"
 	"github.com/chefnext/chefnext/apps/api/internal/middleware"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/oidc"
 	"github.com/chefnext/chefnext/apps/api/internal/usecase/identity"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 // AuthHandler implements the AuthService
 type AuthHandler struct {
-	registerUseCase      *identity.RegisterUseCase
-	loginUseCase         *identity.LoginUseCase
-	refreshTokenUseCase  *identity.RefreshTokenUseCase
-	logoutUseCase        *identity.LogoutUseCase
+	registerUseCase              *identity.RegisterUseCase
+	loginUseCase                 *identity.LoginUseCase
+	refreshTokenUseCase          *identity.RefreshTokenUseCase
+	logoutUseCase                *identity.LogoutUseCase
+	getMeUseCase                 *identity.GetMeUseCase
+	sendVerificationEmailUseCase *identity.SendVerificationEmailUseCase
+	verifyEmailUseCase           *identity.VerifyEmailUseCase
+	requestPasswordResetUseCase  *identity.RequestPasswordResetUseCase
+	resetPasswordUseCase         *identity.ResetPasswordUseCase
+	changePasswordUseCase        *identity.ChangePasswordUseCase
+	sessionsUseCase              *identity.SessionsUseCase
+	verifyMFAUseCase             *identity.VerifyMFAUseCase
+	mfaEnrollmentUseCase         *identity.MFAEnrollmentUseCase
+	oidcLoginUseCase             *identity.OIDCLoginUseCase
+	accountDeletionUseCase       *identity.AccountDeletionUseCase
+	exportDataUseCase            *identity.ExportDataUseCase
+	magicLinkUseCase             *identity.MagicLinkUseCase
+	rolesUseCase                 *identity.RolesUseCase
 }
 
 // NewAuthHandler creates a new auth handler
@@ -24,12 +46,40 @@ func NewAuthHandler(
 	loginUseCase *identity.LoginUseCase,
 	refreshTokenUseCase *identity.RefreshTokenUseCase,
 	logoutUseCase *identity.LogoutUseCase,
+	getMeUseCase *identity.GetMeUseCase,
+	sendVerificationEmailUseCase *identity.SendVerificationEmailUseCase,
+	verifyEmailUseCase *identity.VerifyEmailUseCase,
+	requestPasswordResetUseCase *identity.RequestPasswordResetUseCase,
+	resetPasswordUseCase *identity.ResetPasswordUseCase,
+	changePasswordUseCase *identity.ChangePasswordUseCase,
+	sessionsUseCase *identity.SessionsUseCase,
+	verifyMFAUseCase *identity.VerifyMFAUseCase,
+	mfaEnrollmentUseCase *identity.MFAEnrollmentUseCase,
+	oidcLoginUseCase *identity.OIDCLoginUseCase,
+	accountDeletionUseCase *identity.AccountDeletionUseCase,
+	exportDataUseCase *identity.ExportDataUseCase,
+	magicLinkUseCase *identity.MagicLinkUseCase,
+	rolesUseCase *identity.RolesUseCase,
 ) identityv1connect.AuthServiceHandler {
 	return &AuthHandler{
-		registerUseCase:     registerUseCase,
-		loginUseCase:        loginUseCase,
-		refreshTokenUseCase: refreshTokenUseCase,
-		logoutUseCase:       logoutUseCase,
+		registerUseCase:              registerUseCase,
+		loginUseCase:                 loginUseCase,
+		refreshTokenUseCase:          refreshTokenUseCase,
+		logoutUseCase:                logoutUseCase,
+		getMeUseCase:                 getMeUseCase,
+		sendVerificationEmailUseCase: sendVerificationEmailUseCase,
+		verifyEmailUseCase:           verifyEmailUseCase,
+		requestPasswordResetUseCase:  requestPasswordResetUseCase,
+		resetPasswordUseCase:         resetPasswordUseCase,
+		changePasswordUseCase:        changePasswordUseCase,
+		sessionsUseCase:              sessionsUseCase,
+		verifyMFAUseCase:             verifyMFAUseCase,
+		mfaEnrollmentUseCase:         mfaEnrollmentUseCase,
+		oidcLoginUseCase:             oidcLoginUseCase,
+		accountDeletionUseCase:       accountDeletionUseCase,
+		exportDataUseCase:            exportDataUseCase,
+		magicLinkUseCase:             magicLinkUseCase,
+		rolesUseCase:                 rolesUseCase,
 	}
 }
 
@@ -51,8 +101,13 @@ func (h *AuthHandler) Register(ctx context.Context, req *connect.Request[identit
 		Email:    req.Msg.Email,
 		Password: req.Msg.Password,
 		Role:     role,
+		Session:  sessionMetadata(ctx, req, req.Msg.DeviceLabel),
 	})
 	if err != nil {
+		var policyErr *auth.PasswordPolicyError
+		if errors.As(err, &policyErr) {
+			return nil, passwordPolicyError(policyErr, "password")
+		}
 		if err == identity.ErrEmailAlreadyExists {
 			return nil, connect.NewError(connect.CodeAlreadyExists, err)
 		}
@@ -62,23 +117,13 @@ func (h *AuthHandler) Register(ctx context.Context, req *connect.Request[identit
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	// Convert role string to enum
-	var roleEnum identityv1.UserRole
-	switch output.Role {
-	case "CHEF":
-		roleEnum = identityv1.UserRole_USER_ROLE_CHEF
-	case "RESTAURANT":
-		roleEnum = identityv1.UserRole_USER_ROLE_RESTAURANT
-	default:
-		roleEnum = identityv1.UserRole_USER_ROLE_UNSPECIFIED
-	}
-
 	return connect.NewResponse(&identityv1.RegisterResponse{
-		UserId:       output.UserID.String(),
-		Email:        output.Email,
-		Role:         roleEnum,
-		AccessToken:  output.AccessToken,
-		RefreshToken: output.RefreshToken,
+		UserId:                output.UserID.String(),
+		Email:                 output.Email,
+		Role:                  userRoleToProto(output.Role),
+		AccessToken:           output.AccessToken,
+		RefreshToken:          output.RefreshToken,
+		VerificationEmailSent: output.VerificationEmailSent,
 	}), nil
 }
 
@@ -87,31 +132,36 @@ func (h *AuthHandler) Login(ctx context.Context, req *connect.Request[identityv1
 	output, err := h.loginUseCase.Execute(ctx, identity.LoginInput{
 		Email:    req.Msg.Email,
 		Password: req.Msg.Password,
+		Role:     userRoleFromProto(req.Msg.Role),
+		Session:  sessionMetadata(ctx, req, req.Msg.DeviceLabel),
 	})
 	if err != nil {
 		if err == identity.ErrInvalidCredentials {
 			return nil, connect.NewError(connect.CodeUnauthenticated, err)
 		}
+		if err == identity.ErrRoleNotGranted {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		if err == identity.ErrAccountSuspended {
+			return nil, accountSuspendedError(err)
+		}
+		var lockedErr *auth.LoginLockedError
+		if errors.As(err, &lockedErr) {
+			return nil, loginLockedError(lockedErr)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
 
-	// Convert role string to enum
-	var roleEnum identityv1.UserRole
-	switch output.Role {
-	case "CHEF":
-		roleEnum = identityv1.UserRole_USER_ROLE_CHEF
-	case "RESTAURANT":
-		roleEnum = identityv1.UserRole_USER_ROLE_RESTAURANT
-	default:
-		roleEnum = identityv1.UserRole_USER_ROLE_UNSPECIFIED
-	}
-
 	return connect.NewResponse(&identityv1.LoginResponse{
-		UserId:       output.UserID.String(),
-		Email:        output.Email,
-		Role:         roleEnum,
-		AccessToken:  output.AccessToken,
-		RefreshToken: output.RefreshToken,
+		UserId:                output.UserID.String(),
+		Email:                 output.Email,
+		Role:                  userRoleToProto(output.Role),
+		AccessToken:           output.AccessToken,
+		RefreshToken:          output.RefreshToken,
+		MfaRequired:           output.MFARequired,
+		MfaToken:              output.MFAToken,
+		MfaEnrollmentRequired: output.MFAEnrollmentRequired,
+		Roles:                 userRolesToProto(output.Roles),
 	}), nil
 }
 
@@ -119,8 +169,15 @@ func (h *AuthHandler) Login(ctx context.Context, req *connect.Request[identityv1
 func (h *AuthHandler) RefreshToken(ctx context.Context, req *connect.Request[identityv1.RefreshTokenRequest]) (*connect.Response[identityv1.RefreshTokenResponse], error) {
 	output, err := h.refreshTokenUseCase.Execute(ctx, identity.RefreshTokenInput{
 		RefreshToken: req.Msg.RefreshToken,
+		Session:      sessionMetadata(ctx, req, ""),
 	})
 	if err != nil {
+		if errors.Is(err, auth.ErrRefreshTokenReused) {
+			return nil, refreshTokenReusedError(err)
+		}
+		if err == identity.ErrAccountSuspended {
+			return nil, accountSuspendedError(err)
+		}
 		return nil, connect.NewError(connect.CodeUnauthenticated, err)
 	}
 
@@ -132,8 +189,10 @@ func (h *AuthHandler) RefreshToken(ctx context.Context, req *connect.Request[ide
 
 // Logout handles user logout
 func (h *AuthHandler) Logout(ctx context.Context, req *connect.Request[identityv1.LogoutRequest]) (*connect.Response[identityv1.LogoutResponse], error) {
+	accessTokenID, _ := middleware.GetTokenID(ctx)
 	output, err := h.logoutUseCase.Execute(ctx, identity.LogoutInput{
-		RefreshToken: req.Msg.RefreshToken,
+		RefreshToken:  req.Msg.RefreshToken,
+		AccessTokenID: accessTokenID,
 	})
 	if err != nil {
 		return nil, connect.NewError(connect.CodeInternal, err)
@@ -146,36 +205,810 @@ func (h *AuthHandler) Logout(ctx context.Context, req *connect.Request[identityv
 
 // GetMe returns the current authenticated user's information
 func (h *AuthHandler) GetMe(ctx context.Context, req *connect.Request[identityv1.GetMeRequest]) (*connect.Response[identityv1.GetMeResponse], error) {
-	// Get user information from context (populated by auth interceptor)
+	// Get user ID from context (populated by auth interceptor)
 	userID, ok := middleware.GetUserID(ctx)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
-	email, ok := middleware.GetUserEmail(ctx)
+	activeRole, _ := middleware.GetUserRole(ctx)
+	output, err := h.getMeUseCase.Execute(ctx, identity.GetMeInput{UserID: userID, ActiveRole: activeRole})
+	if err != nil {
+		if err == identity.ErrUserNotFound {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := &identityv1.GetMeResponse{
+		UserId:        output.UserID.String(),
+		Email:         output.Email,
+		Role:          userRoleToProto(output.Role),
+		Roles:         userRolesToProto(output.Roles),
+		EmailVerified: output.EmailVerified,
+	}
+	if output.DeletionScheduledFor != nil {
+		resp.DeletionScheduledFor = output.DeletionScheduledFor.UTC().Format(time.RFC3339)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// SendVerificationEmail sends a new email verification link to the current user
+func (h *AuthHandler) SendVerificationEmail(ctx context.Context, req *connect.Request[identityv1.SendVerificationEmailRequest]) (*connect.Response[identityv1.SendVerificationEmailResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	output, err := h.sendVerificationEmailUseCase.Execute(ctx, identity.SendVerificationEmailInput{
+		UserID: userID,
+	})
+	if err != nil {
+		switch err {
+		case identity.ErrUserNotFound:
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case identity.ErrEmailAlreadyVerified:
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.SendVerificationEmailResponse{
+		Success: output.Success,
+	}), nil
+}
+
+// VerifyEmail confirms the user's email address using a verification token
+func (h *AuthHandler) VerifyEmail(ctx context.Context, req *connect.Request[identityv1.VerifyEmailRequest]) (*connect.Response[identityv1.VerifyEmailResponse], error) {
+	output, err := h.verifyEmailUseCase.Execute(ctx, identity.VerifyEmailInput{
+		Token: req.Msg.Token,
+	})
+	if err != nil {
+		if err == auth.ErrInvalidOneTimeToken {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.VerifyEmailResponse{
+		Success: output.Success,
+	}), nil
+}
+
+// RequestPasswordReset mails a password reset link
+func (h *AuthHandler) RequestPasswordReset(ctx context.Context, req *connect.Request[identityv1.RequestPasswordResetRequest]) (*connect.Response[identityv1.RequestPasswordResetResponse], error) {
+	output, err := h.requestPasswordResetUseCase.Execute(ctx, identity.RequestPasswordResetInput{
+		Email: req.Msg.Email,
+	})
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.RequestPasswordResetResponse{
+		Success: output.Success,
+	}), nil
+}
+
+// ResetPassword sets a new password using a password reset token
+func (h *AuthHandler) ResetPassword(ctx context.Context, req *connect.Request[identityv1.ResetPasswordRequest]) (*connect.Response[identityv1.ResetPasswordResponse], error) {
+	output, err := h.resetPasswordUseCase.Execute(ctx, identity.ResetPasswordInput{
+		Token:       req.Msg.Token,
+		NewPassword: req.Msg.NewPassword,
+	})
+	if err != nil {
+		var policyErr *auth.PasswordPolicyError
+		if errors.As(err, &policyErr) {
+			return nil, passwordPolicyError(policyErr, "new_password")
+		}
+		if err == identity.ErrPasswordRequired || err == auth.ErrInvalidOneTimeToken {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.ResetPasswordResponse{
+		Success: output.Success,
+	}), nil
+}
+
+// ChangePassword replaces the current user's password
+func (h *AuthHandler) ChangePassword(ctx context.Context, req *connect.Request[identityv1.ChangePasswordRequest]) (*connect.Response[identityv1.ChangePasswordResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
 
-	role, ok := middleware.GetUserRole(ctx)
+	output, err := h.changePasswordUseCase.Execute(ctx, identity.ChangePasswordInput{
+		UserID:          userID,
+		CurrentPassword: req.Msg.CurrentPassword,
+		NewPassword:     req.Msg.NewPassword,
+		Session:         sessionMetadata(ctx, req, ""),
+		MFA:             middleware.GetMFA(ctx),
+	})
+	if err != nil {
+		var policyErr *auth.PasswordPolicyError
+		if errors.As(err, &policyErr) {
+			return nil, passwordPolicyError(policyErr, "new_password")
+		}
+		switch err {
+		case identity.ErrPasswordRequired:
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case identity.ErrInvalidCredentials:
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		case identity.ErrUserNotFound:
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.ChangePasswordResponse{
+		AccessToken:  output.AccessToken,
+		RefreshToken: output.RefreshToken,
+	}), nil
+}
+
+// ListSessions returns the current user's active sessions
+func (h *AuthHandler) ListSessions(ctx context.Context, req *connect.Request[identityv1.ListSessionsRequest]) (*connect.Response[identityv1.ListSessionsResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
 	if !ok {
 		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
 	}
+	currentSessionID, _ := middleware.GetSessionID(ctx)
 
-	// Convert role string to enum
-	var roleEnum identityv1.UserRole
+	sessions, err := h.sessionsUseCase.ListSessions(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoSessions := make([]*identityv1.Session, 0, len(sessions))
+	for _, session := range sessions {
+		protoSessions = append(protoSessions, &identityv1.Session{
+			SessionId:   session.ID,
+			DeviceLabel: session.Metadata.DeviceLabel,
+			IpAddress:   session.Metadata.IPAddress,
+			UserAgent:   session.Metadata.UserAgent,
+			CreatedAt:   session.CreatedAt.UTC().Format(time.RFC3339),
+			LastUsedAt:  session.LastUsedAt.UTC().Format(time.RFC3339),
+			Current:     session.ID == currentSessionID,
+		})
+	}
+
+	return connect.NewResponse(&identityv1.ListSessionsResponse{
+		Sessions: protoSessions,
+	}), nil
+}
+
+// RevokeSession signs out one of the current user's sessions
+func (h *AuthHandler) RevokeSession(ctx context.Context, req *connect.Request[identityv1.RevokeSessionRequest]) (*connect.Response[identityv1.RevokeSessionResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if err := h.sessionsUseCase.RevokeSession(ctx, userID, req.Msg.SessionId); err != nil {
+		if err == auth.ErrSessionNotFound {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.RevokeSessionResponse{
+		Success: true,
+	}), nil
+}
+
+// RevokeOtherSessions signs out every session except the calling one
+func (h *AuthHandler) RevokeOtherSessions(ctx context.Context, req *connect.Request[identityv1.RevokeOtherSessionsRequest]) (*connect.Response[identityv1.RevokeOtherSessionsResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	currentSessionID, ok := middleware.GetSessionID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("access token is not bound to a session"))
+	}
+
+	if err := h.sessionsUseCase.RevokeOtherSessions(ctx, userID, currentSessionID); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.RevokeOtherSessionsResponse{
+		Success: true,
+	}), nil
+}
+
+// VerifyMfa completes a two-step login with a TOTP or recovery code
+func (h *AuthHandler) VerifyMfa(ctx context.Context, req *connect.Request[identityv1.VerifyMfaRequest]) (*connect.Response[identityv1.VerifyMfaResponse], error) {
+	output, err := h.verifyMFAUseCase.Execute(ctx, identity.VerifyMFAInput{
+		MFAToken: req.Msg.MfaToken,
+		Code:     req.Msg.Code,
+		Session:  sessionMetadata(ctx, req, req.Msg.DeviceLabel),
+	})
+	if err != nil {
+		switch err {
+		case auth.ErrInvalidOneTimeToken:
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case identity.ErrInvalidMFACode, identity.ErrMFANotEnabled:
+			return nil, connect.NewError(connect.CodeUnauthenticated, identity.ErrInvalidMFACode)
+		case identity.ErrAccountSuspended:
+			return nil, accountSuspendedError(err)
+		}
+		var lockedErr *auth.LoginLockedError
+		if errors.As(err, &lockedErr) {
+			return nil, loginLockedError(lockedErr)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.VerifyMfaResponse{
+		UserId:                 output.UserID.String(),
+		Email:                  output.Email,
+		Role:                   userRoleToProto(output.Role),
+		Roles:                  userRolesToProto(output.Roles),
+		AccessToken:            output.AccessToken,
+		RefreshToken:           output.RefreshToken,
+		RecoveryCodesRemaining: int32(output.RecoveryCodesRemaining),
+	}), nil
+}
+
+// BeginTotpEnrollment generates a TOTP secret for the current user
+func (h *AuthHandler) BeginTotpEnrollment(ctx context.Context, req *connect.Request[identityv1.BeginTotpEnrollmentRequest]) (*connect.Response[identityv1.BeginTotpEnrollmentResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	output, err := h.mfaEnrollmentUseCase.BeginTOTPEnrollment(ctx, userID)
+	if err != nil {
+		return nil, mfaEnrollmentError(err)
+	}
+
+	return connect.NewResponse(&identityv1.BeginTotpEnrollmentResponse{
+		Secret:     output.Secret,
+		OtpauthUri: output.OTPAuthURI,
+	}), nil
+}
+
+// ConfirmTotpEnrollment activates TOTP and returns the recovery codes
+func (h *AuthHandler) ConfirmTotpEnrollment(ctx context.Context, req *connect.Request[identityv1.ConfirmTotpEnrollmentRequest]) (*connect.Response[identityv1.ConfirmTotpEnrollmentResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+	sessionID, _ := middleware.GetSessionID(ctx)
+
+	output, err := h.mfaEnrollmentUseCase.ConfirmTOTPEnrollment(ctx, identity.ConfirmTOTPEnrollmentInput{
+		UserID:    userID,
+		SessionID: sessionID,
+		Code:      req.Msg.Code,
+		Session:   sessionMetadata(ctx, req, ""),
+	})
+	if err != nil {
+		return nil, mfaEnrollmentError(err)
+	}
+
+	return connect.NewResponse(&identityv1.ConfirmTotpEnrollmentResponse{
+		RecoveryCodes: output.RecoveryCodes,
+		AccessToken:   output.AccessToken,
+		RefreshToken:  output.RefreshToken,
+	}), nil
+}
+
+// RegenerateRecoveryCodes replaces the current user's recovery codes
+func (h *AuthHandler) RegenerateRecoveryCodes(ctx context.Context, req *connect.Request[identityv1.RegenerateRecoveryCodesRequest]) (*connect.Response[identityv1.RegenerateRecoveryCodesResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	codes, err := h.mfaEnrollmentUseCase.RegenerateRecoveryCodes(ctx, userID, req.Msg.Code)
+	if err != nil {
+		return nil, mfaEnrollmentError(err)
+	}
+
+	return connect.NewResponse(&identityv1.RegenerateRecoveryCodesResponse{
+		RecoveryCodes: codes,
+	}), nil
+}
+
+// StartOidcLogin returns the identity provider URL to redirect the user to
+func (h *AuthHandler) StartOidcLogin(ctx context.Context, req *connect.Request[identityv1.StartOidcLoginRequest]) (*connect.Response[identityv1.StartOidcLoginResponse], error) {
+	var role string
+	switch req.Msg.Role {
+	case identityv1.UserRole_USER_ROLE_CHEF:
+		role = "CHEF"
+	case identityv1.UserRole_USER_ROLE_RESTAURANT:
+		role = "RESTAURANT"
+	case identityv1.UserRole_USER_ROLE_ADMIN:
+		return nil, connect.NewError(connect.CodeInvalidArgument, identity.ErrInvalidRole)
+	}
+
+	authorizationURL, err := h.oidcLoginUseCase.StartLogin(ctx, identity.StartOIDCLoginInput{
+		Provider: req.Msg.Provider,
+		Role:     role,
+	})
+	if err != nil {
+		return nil, oidcError(err)
+	}
+
+	return connect.NewResponse(&identityv1.StartOidcLoginResponse{
+		AuthorizationUrl: authorizationURL,
+	}), nil
+}
+
+// CompleteOidcLogin handles the identity provider callback
+func (h *AuthHandler) CompleteOidcLogin(ctx context.Context, req *connect.Request[identityv1.CompleteOidcLoginRequest]) (*connect.Response[identityv1.CompleteOidcLoginResponse], error) {
+	output, err := h.oidcLoginUseCase.CompleteLogin(ctx, identity.CompleteOIDCLoginInput{
+		State:   req.Msg.State,
+		Code:    req.Msg.Code,
+		Session: sessionMetadata(ctx, req, req.Msg.DeviceLabel),
+	})
+	if err != nil {
+		return nil, oidcError(err)
+	}
+
+	response := &identityv1.CompleteOidcLoginResponse{
+		AccountCreated: output.AccountCreated,
+		Linked:         output.Linked,
+		Provider:       output.Provider,
+	}
+	if login := output.Login; login != nil {
+		response.UserId = login.UserID.String()
+		response.Email = login.Email
+		response.Role = userRoleToProto(login.Role)
+		response.Roles = userRolesToProto(login.Roles)
+		response.AccessToken = login.AccessToken
+		response.RefreshToken = login.RefreshToken
+		response.MfaRequired = login.MFARequired
+		response.MfaToken = login.MFAToken
+		response.MfaEnrollmentRequired = login.MFAEnrollmentRequired
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// StartOidcLink starts linking an identity provider to the current user
+func (h *AuthHandler) StartOidcLink(ctx context.Context, req *connect.Request[identityv1.StartOidcLinkRequest]) (*connect.Response[identityv1.StartOidcLinkResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	authorizationURL, err := h.oidcLoginUseCase.StartLogin(ctx, identity.StartOIDCLoginInput{
+		Provider:   req.Msg.Provider,
+		LinkUserID: userID,
+	})
+	if err != nil {
+		return nil, oidcError(err)
+	}
+
+	return connect.NewResponse(&identityv1.StartOidcLinkResponse{
+		AuthorizationUrl: authorizationURL,
+	}), nil
+}
+
+// ListLinkedIdentities lists the identity providers linked to the current user
+func (h *AuthHandler) ListLinkedIdentities(ctx context.Context, req *connect.Request[identityv1.ListLinkedIdentitiesRequest]) (*connect.Response[identityv1.ListLinkedIdentitiesResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	identities, err := h.oidcLoginUseCase.ListIdentities(ctx, userID)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	protoIdentities := make([]*identityv1.LinkedIdentity, 0, len(identities))
+	for _, linked := range identities {
+		protoIdentity := &identityv1.LinkedIdentity{
+			Provider: linked.Provider,
+			Email:    linked.Email.String,
+			LinkedAt: linked.CreatedAt.Time.UTC().Format(time.RFC3339),
+		}
+		if linked.LastLoginAt.Valid {
+			protoIdentity.LastLoginAt = linked.LastLoginAt.Time.UTC().Format(time.RFC3339)
+		}
+		protoIdentities = append(protoIdentities, protoIdentity)
+	}
+
+	return connect.NewResponse(&identityv1.ListLinkedIdentitiesResponse{
+		Identities: protoIdentities,
+	}), nil
+}
+
+// UnlinkIdentity removes a linked identity provider from the current user
+func (h *AuthHandler) UnlinkIdentity(ctx context.Context, req *connect.Request[identityv1.UnlinkIdentityRequest]) (*connect.Response[identityv1.UnlinkIdentityResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if err := h.oidcLoginUseCase.UnlinkIdentity(ctx, userID, req.Msg.Provider); err != nil {
+		if err == identity.ErrIdentityNotLinked {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.UnlinkIdentityResponse{
+		Success: true,
+	}), nil
+}
+
+// SendAccountDeletionConfirmation mails the current user a link that confirms account deletion
+func (h *AuthHandler) SendAccountDeletionConfirmation(ctx context.Context, req *connect.Request[identityv1.SendAccountDeletionConfirmationRequest]) (*connect.Response[identityv1.SendAccountDeletionConfirmationResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if err := h.accountDeletionUseCase.SendDeletionConfirmation(ctx, userID); err != nil {
+		if err == identity.ErrUserNotFound {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.SendAccountDeletionConfirmationResponse{
+		Success: true,
+	}), nil
+}
+
+// DeleteAccount schedules the current user's account for deletion
+func (h *AuthHandler) DeleteAccount(ctx context.Context, req *connect.Request[identityv1.DeleteAccountRequest]) (*connect.Response[identityv1.DeleteAccountResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	output, err := h.accountDeletionUseCase.RequestDeletion(ctx, identity.RequestDeletionInput{
+		UserID:            userID,
+		Password:          req.Msg.Password,
+		MFACode:           req.Msg.MfaCode,
+		ConfirmationToken: req.Msg.ConfirmationToken,
+	})
+	if err != nil {
+		switch err {
+		case identity.ErrInvalidCredentials:
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		case identity.ErrDeletionAlreadyScheduled, identity.ErrSoleRestaurantOwner:
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case identity.ErrUserNotFound:
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.DeleteAccountResponse{
+		DeletionScheduledFor: output.ScheduledFor.UTC().Format(time.RFC3339),
+	}), nil
+}
+
+// CancelAccountDeletion keeps the current user's account
+func (h *AuthHandler) CancelAccountDeletion(ctx context.Context, req *connect.Request[identityv1.CancelAccountDeletionRequest]) (*connect.Response[identityv1.CancelAccountDeletionResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if err := h.accountDeletionUseCase.CancelDeletion(ctx, userID); err != nil {
+		if err == identity.ErrDeletionNotScheduled {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.CancelAccountDeletionResponse{
+		Success: true,
+	}), nil
+}
+
+// ExportMyData returns the current user's data as a JSON archive
+func (h *AuthHandler) ExportMyData(ctx context.Context, req *connect.Request[identityv1.ExportMyDataRequest]) (*connect.Response[identityv1.ExportMyDataResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	output, err := h.exportDataUseCase.Execute(ctx, userID)
+	if err != nil {
+		if err == identity.ErrUserNotFound {
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.ExportMyDataResponse{
+		FileName:    output.FileName,
+		ContentType: "application/json",
+		Archive:     output.Archive,
+	}), nil
+}
+
+// RequestMagicLink mails a sign-in link bound to the requesting device
+func (h *AuthHandler) RequestMagicLink(ctx context.Context, req *connect.Request[identityv1.RequestMagicLinkRequest]) (*connect.Response[identityv1.RequestMagicLinkResponse], error) {
+	output, err := h.magicLinkUseCase.Request(ctx, identity.RequestMagicLinkInput{
+		Email: req.Msg.Email,
+	})
+	if err != nil {
+		var throttledErr *auth.ThrottledError
+		if errors.As(err, &throttledErr) {
+			return nil, throttledError(throttledErr, "MAGIC_LINK_THROTTLED")
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.RequestMagicLinkResponse{
+		DeviceBinding: output.DeviceBinding,
+	}), nil
+}
+
+// ConsumeMagicLink signs in with a mailed link
+func (h *AuthHandler) ConsumeMagicLink(ctx context.Context, req *connect.Request[identityv1.ConsumeMagicLinkRequest]) (*connect.Response[identityv1.ConsumeMagicLinkResponse], error) {
+	output, err := h.magicLinkUseCase.Consume(ctx, identity.ConsumeMagicLinkInput{
+		Token:         req.Msg.Token,
+		DeviceBinding: req.Msg.DeviceBinding,
+		Session:       sessionMetadata(ctx, req, req.Msg.DeviceLabel),
+	})
+	if err != nil {
+		switch err {
+		case auth.ErrInvalidOneTimeToken:
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		case auth.ErrTokenBindingMismatch:
+			return nil, magicLinkDeviceMismatchError(err)
+		case identity.ErrAccountSuspended:
+			return nil, accountSuspendedError(err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.ConsumeMagicLinkResponse{
+		UserId:                output.UserID.String(),
+		Email:                 output.Email,
+		Role:                  userRoleToProto(output.Role),
+		AccessToken:           output.AccessToken,
+		RefreshToken:          output.RefreshToken,
+		MfaRequired:           output.MFARequired,
+		MfaToken:              output.MFAToken,
+		MfaEnrollmentRequired: output.MFAEnrollmentRequired,
+		Roles:                 userRolesToProto(output.Roles),
+	}), nil
+}
+
+// AddRole grants the current user another role
+func (h *AuthHandler) AddRole(ctx context.Context, req *connect.Request[identityv1.AddRoleRequest]) (*connect.Response[identityv1.AddRoleResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	roles, err := h.rolesUseCase.AddRole(ctx, userID, userRoleFromProto(req.Msg.Role))
+	if err != nil {
+		switch err {
+		case identity.ErrInvalidRole:
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		case identity.ErrRoleAlreadyGranted:
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		case identity.ErrAdminRolesFixed:
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		case identity.ErrUserNotFound:
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&identityv1.AddRoleResponse{
+		Roles: userRolesToProto(roles),
+	}), nil
+}
+
+// SwitchRole reissues the current session's tokens acting as another role
+func (h *AuthHandler) SwitchRole(ctx context.Context, req *connect.Request[identityv1.SwitchRoleRequest]) (*connect.Response[identityv1.SwitchRoleResponse], error) {
+	userID, ok := middleware.GetUserID(ctx)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	role := userRoleFromProto(req.Msg.Role)
+	output, err := h.rolesUseCase.SwitchRole(ctx, identity.SwitchRoleInput{
+		UserID:       userID,
+		RefreshToken: req.Msg.RefreshToken,
+		Role:         role,
+		Session:      sessionMetadata(ctx, req, ""),
+	})
+	if err != nil {
+		if errors.Is(err, auth.ErrRefreshTokenReused) {
+			return nil, refreshTokenReusedError(err)
+		}
+		switch err {
+		case identity.ErrRoleNotGranted:
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		case identity.ErrAccountSuspended:
+			return nil, accountSuspendedError(err)
+		case identity.ErrUserNotFound:
+			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+		}
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	return connect.NewResponse(&identityv1.SwitchRoleResponse{
+		Role:         userRoleToProto(role),
+		AccessToken:  output.AccessToken,
+		RefreshToken: output.RefreshToken,
+	}), nil
+}
+
+func oidcError(err error) error {
+	switch {
+	case errors.Is(err, oidc.ErrUnknownProvider), errors.Is(err, identity.ErrInvalidRole):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, oidc.ErrInvalidState), errors.Is(err, oidc.ErrInvalidIDToken), errors.Is(err, oidc.ErrCodeExchange):
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	case errors.Is(err, identity.ErrOIDCAccountExists), errors.Is(err, identity.ErrIdentityAlreadyLinked), errors.Is(err, identity.ErrOIDCEmailRequired), errors.Is(err, identity.ErrOIDCEmailNotVerified):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case errors.Is(err, oidc.ErrDiscoveryMismatch):
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, identity.ErrAccountSuspended):
+		return accountSuspendedError(err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+func mfaEnrollmentError(err error) error {
+	switch err {
+	case identity.ErrInvalidMFACode:
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case identity.ErrMFAAlreadyEnabled, identity.ErrMFAEnrollmentNotStarted, identity.ErrMFANotEnabled:
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case identity.ErrUserNotFound:
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
+func userRoleToProto(role string) identityv1.UserRole {
 	switch role {
 	case "CHEF":
-		roleEnum = identityv1.UserRole_USER_ROLE_CHEF
+		return identityv1.UserRole_USER_ROLE_CHEF
 	case "RESTAURANT":
-		roleEnum = identityv1.UserRole_USER_ROLE_RESTAURANT
+		return identityv1.UserRole_USER_ROLE_RESTAURANT
+	case "ADMIN":
+		return identityv1.UserRole_USER_ROLE_ADMIN
 	default:
-		roleEnum = identityv1.UserRole_USER_ROLE_UNSPECIFIED
+		return identityv1.UserRole_USER_ROLE_UNSPECIFIED
 	}
+}
 
-	return connect.NewResponse(&identityv1.GetMeResponse{
-		UserId: userID.String(),
-		Email:  email,
-		Role:   roleEnum,
-	}), nil
+func userRolesToProto(roles []string) []identityv1.UserRole {
+	protoRoles := make([]identityv1.UserRole, 0, len(roles))
+	for _, role := range roles {
+		protoRoles = append(protoRoles, userRoleToProto(role))
+	}
+	return protoRoles
+}
+
+// userRoleFromProto maps UNSPECIFIED to "", which callers treat as no choice
+func userRoleFromProto(role identityv1.UserRole) string {
+	switch role {
+	case identityv1.UserRole_USER_ROLE_CHEF:
+		return "CHEF"
+	case identityv1.UserRole_USER_ROLE_RESTAURANT:
+		return "RESTAURANT"
+	case identityv1.UserRole_USER_ROLE_ADMIN:
+		return "ADMIN"
+	default:
+		return ""
+	}
+}
+
+// sessionMetadata captures the client details stored alongside a session
+func sessionMetadata(ctx context.Context, req connect.AnyRequest, deviceLabel string) auth.SessionMetadata {
+	return auth.SessionMetadata{
+		DeviceLabel: strings.TrimSpace(deviceLabel),
+		IPAddress:   middleware.ClientIP(ctx, req.Peer().Addr),
+		UserAgent:   req.Header().Get("User-Agent"),
+	}
+}
+
+// accountSuspendedError reports a suspended account with a reason clients can
+// match on to show a support contact instead of a login retry
+func accountSuspendedError(err error) error {
+	connectErr := connect.NewError(connect.CodePermissionDenied, err)
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.ErrorInfo{
+		Reason: "ACCOUNT_SUSPENDED",
+		Domain: "chefnext.identity",
+	}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// refreshTokenReusedError reports refresh token reuse with a code distinct from
+// ordinary expiry so clients can force a re-login and warn the user
+func refreshTokenReusedError(err error) error {
+	connectErr := connect.NewError(connect.CodePermissionDenied, err)
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.ErrorInfo{
+		Reason: "REFRESH_TOKEN_REUSED",
+		Domain: "chefnext.identity",
+	}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// passwordPolicyError reports every policy violation as a BadRequest field
+// violation so signup and password forms can show them next to the field
+func passwordPolicyError(err *auth.PasswordPolicyError, field string) error {
+	connectErr := connect.NewError(connect.CodeInvalidArgument, err)
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(err.Violations))
+	for _, violation := range err.Violations {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: violation.Description,
+			Reason:      violation.Reason,
+		})
+	}
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.BadRequest{
+		FieldViolations: violations,
+	}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// loginLockedError reports a login lockout with the delay clients should wait
+// before retrying, both as RetryInfo and as ErrorInfo metadata
+func loginLockedError(err *auth.LoginLockedError) error {
+	retryAfter := err.RetryAfter.Round(time.Second)
+	connectErr := connect.NewError(connect.CodeResourceExhausted, err)
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryAfter),
+	}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.ErrorInfo{
+		Reason:   "LOGIN_LOCKED",
+		Domain:   "chefnext.identity",
+		Metadata: map[string]string{"retry_after_seconds": strconv.FormatInt(int64(retryAfter.Seconds()), 10)},
+	}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	connectErr.Meta().Set("Retry-After", strconv.FormatInt(int64(retryAfter.Seconds()), 10))
+	return connectErr
+}
+
+// throttledError reports a throttled request with the delay clients should
+// wait, like loginLockedError but with a flow-specific reason
+func throttledError(err *auth.ThrottledError, reason string) error {
+	retryAfter := err.RetryAfter.Round(time.Second)
+	connectErr := connect.NewError(connect.CodeResourceExhausted, err)
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryAfter),
+	}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   "chefnext.identity",
+		Metadata: map[string]string{"retry_after_seconds": strconv.FormatInt(int64(retryAfter.Seconds()), 10)},
+	}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	connectErr.Meta().Set("Retry-After", strconv.FormatInt(int64(retryAfter.Seconds()), 10))
+	return connectErr
+}
+
+// magicLinkDeviceMismatchError tells clients the link was opened on another
+// device so they can ask the user to request a new one here
+func magicLinkDeviceMismatchError(err error) error {
+	connectErr := connect.NewError(connect.CodeUnauthenticated, err)
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.ErrorInfo{
+		Reason: "MAGIC_LINK_DEVICE_MISMATCH",
+		Domain: "chefnext.identity",
+	}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
 }
@@ -27,14 +27,14 @@ func NewJobHandler(service *jobusecase.Service) jobv1connect.JobServiceHandler {
 }
 
 func (h *Handler) CreateJob(ctx context.Context, req *connect.Request[jobv1.CreateJobRequest]) (*connect.Response[jobv1.CreateJobResponse], error) {
-	userID, err := h.requireRole(ctx, "RESTAURANT")
+	userID, err := middleware.RequireUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	metadata, err := metadataFromString(req.Msg.GetMetadataJson())
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		return nil, middleware.NewFieldError("metadata_json", err)
 	}
 
 	input := jobusecase.CreateJobInput{
@@ -64,7 +64,7 @@ func (h *Handler) CreateJob(ctx context.Context, req *connect.Request[jobv1.Crea
 }
 
 func (h *Handler) UpdateJob(ctx context.Context, req *connect.Request[jobv1.UpdateJobRequest]) (*connect.Response[jobv1.UpdateJobResponse], error) {
-	userID, err := h.requireRole(ctx, "RESTAURANT")
+	userID, err := middleware.RequireUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -78,7 +78,7 @@ func (h *Handler) UpdateJob(ctx context.Context, req *connect.Request[jobv1.Upda
 	if raw := req.Msg.GetMetadataJson(); strings.TrimSpace(raw) != "" {
 		parsed, err := metadataFromString(raw)
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+			return nil, middleware.NewFieldError("metadata_json", err)
 		}
 		metadata = &parsed
 	}
@@ -129,7 +129,7 @@ func (h *Handler) GetJob(ctx context.Context, req *connect.Request[jobv1.GetJobR
 }
 
 func (h *Handler) ListMyJobs(ctx context.Context, req *connect.Request[jobv1.ListMyJobsRequest]) (*connect.Response[jobv1.ListMyJobsResponse], error) {
-	userID, err := h.requireRole(ctx, "RESTAURANT")
+	userID, err := middleware.RequireUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +173,7 @@ func (h *Handler) SearchJobs(ctx context.Context, req *connect.Request[jobv1.Sea
 }
 
 func (h *Handler) CreateApplication(ctx context.Context, req *connect.Request[jobv1.CreateApplicationRequest]) (*connect.Response[jobv1.CreateApplicationResponse], error) {
-	userID, err := h.requireRole(ctx, "CHEF")
+	userID, err := middleware.RequireUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -197,7 +197,7 @@ func (h *Handler) CreateApplication(ctx context.Context, req *connect.Request[jo
 }
 
 func (h *Handler) ListApplicationsForChef(ctx context.Context, req *connect.Request[jobv1.ListApplicationsForChefRequest]) (*connect.Response[jobv1.ListApplicationsForChefResponse], error) {
-	userID, err := h.requireRole(ctx, "CHEF")
+	userID, err := middleware.RequireUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -211,7 +211,7 @@ func (h *Handler) ListApplicationsForChef(ctx context.Context, req *connect.Requ
 }
 
 func (h *Handler) ListApplicationsForRestaurant(ctx context.Context, req *connect.Request[jobv1.ListApplicationsForRestaurantRequest]) (*connect.Response[jobv1.ListApplicationsForRestaurantResponse], error) {
-	userID, err := h.requireRole(ctx, "RESTAURANT")
+	userID, err := middleware.RequireUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -225,7 +225,7 @@ func (h *Handler) ListApplicationsForRestaurant(ctx context.Context, req *connec
 }
 
 func (h *Handler) UpdateApplicationStatus(ctx context.Context, req *connect.Request[jobv1.UpdateApplicationStatusRequest]) (*connect.Response[jobv1.UpdateApplicationStatusResponse], error) {
-	userID, err := h.requireRole(ctx, "RESTAURANT")
+	userID, err := middleware.RequireUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -430,7 +430,7 @@ func metadataFromString(raw string) (json.RawMessage, error) {
 
 func mapJobError(err error) error {
 	switch {
-	case errors.Is(err, jobusecase.ErrRestaurantProfileMissing), errors.Is(err, jobusecase.ErrChefProfileMissing), errors.Is(err, jobusecase.ErrJobNotPublished):
+	case errors.Is(err, jobusecase.ErrRestaurantProfileMissing), errors.Is(err, jobusecase.ErrChefProfileMissing), errors.Is(err, jobusecase.ErrJobNotPublished), errors.Is(err, jobusecase.ErrEmailNotVerified), errors.Is(err, jobusecase.ErrRestaurantNotVerified):
 		return connect.NewError(connect.CodeFailedPrecondition, err)
 	case errors.Is(err, jobusecase.ErrJobNotFound), errors.Is(err, jobusecase.ErrApplicationNotFound):
 		return connect.NewError(connect.CodeNotFound, err)
@@ -443,19 +443,6 @@ func mapJobError(err error) error {
 	}
 }
 
-func (h *Handler) requireRole(ctx context.Context, role string) (uuid.UUID, error) {
-	userID, actualRole, err := h.getUserContext(ctx)
-	if err != nil {
-		return uuid.Nil, err
-	}
-
-	if actualRole != role {
-		return uuid.Nil, connect.NewError(connect.CodePermissionDenied, errors.New("insufficient role"))
-	}
-
-	return userID, nil
-}
-
 func (h *Handler) getUserContext(ctx context.Context) (uuid.UUID, string, error) {
 	userID, ok := middleware.GetUserID(ctx)
 	if !ok {
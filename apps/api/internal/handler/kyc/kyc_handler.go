@@ -0,0 +1,204 @@
+package kyc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	kycv1 "github.com/chefnext/chefnext/apps/api/internal/gen/kyc/v1"
+	"github.com/chefnext/chefnext/apps/api/internal/gen/kyc/v1/kycv1connect"
+	"github.com/chefnext/chefnext/apps/api/internal/middleware"
+	kycusecase "github.com/chefnext/chefnext/apps/api/internal/usecase/kyc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// Handler implements the KycService RPCs.
+type Handler struct {
+	service *kycusecase.Service
+}
+
+// NewKycHandler wires a KYC handler implementation.
+func NewKycHandler(service *kycusecase.Service) kycv1connect.KycServiceHandler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) SubmitKyc(ctx context.Context, req *connect.Request[kycv1.SubmitKycRequest]) (*connect.Response[kycv1.SubmitKycResponse], error) {
+	userID, err := middleware.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	documents := make([]kycusecase.DocumentUpload, 0, len(req.Msg.GetDocuments()))
+	for _, document := range req.Msg.GetDocuments() {
+		documents = append(documents, kycusecase.DocumentUpload{
+			DocumentType: FromProtoDocumentType(document.GetDocumentType()),
+			FileName:     document.GetFileName(),
+			ContentType:  document.GetContentType(),
+			Data:         document.GetContent(),
+		})
+	}
+
+	submission, err := h.service.Submit(ctx, userID, kycusecase.SubmitInput{
+		BusinessName:       req.Msg.GetBusinessName(),
+		RegistrationNumber: req.Msg.GetRegistrationNumber(),
+		BusinessAddress:    req.Msg.GetBusinessAddress(),
+		RepresentativeName: req.Msg.GetRepresentativeName(),
+		Documents:          documents,
+	})
+	if err != nil {
+		return nil, MapKYCError(err)
+	}
+
+	return connect.NewResponse(&kycv1.SubmitKycResponse{
+		Submission: ToProtoSubmission(submission),
+		KycStatus:  kycusecase.StatusSubmitted,
+	}), nil
+}
+
+func (h *Handler) GetMyKyc(ctx context.Context, req *connect.Request[kycv1.GetMyKycRequest]) (*connect.Response[kycv1.GetMyKycResponse], error) {
+	userID, err := middleware.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.service.GetStatus(ctx, userID)
+	if err != nil {
+		return nil, MapKYCError(err)
+	}
+
+	resp := &kycv1.GetMyKycResponse{KycStatus: out.Status}
+	if out.LatestSubmission != nil {
+		resp.LatestSubmission = ToProtoSubmission(out.LatestSubmission)
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// MapKYCError converts KYC use case errors to Connect errors.
+func MapKYCError(err error) error {
+	var validationErr *kycusecase.ValidationError
+	switch {
+	case errors.As(err, &validationErr):
+		return validationError(validationErr)
+	case errors.Is(err, kycusecase.ErrRejectionReasonRequired):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, kycusecase.ErrSubmissionNotFound), errors.Is(err, kycusecase.ErrDocumentNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, kycusecase.ErrSubmissionPending), errors.Is(err, kycusecase.ErrAlreadyVerified), errors.Is(err, kycusecase.ErrSubmissionAlreadyClosed):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+// validationError reports every invalid field as a BadRequest field violation
+func validationError(err *kycusecase.ValidationError) error {
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(err.Violations))
+	for _, violation := range err.Violations {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.Field,
+			Description: violation.Description,
+		})
+	}
+	return middleware.NewFieldViolationError(err, violations...)
+}
+
+// ToProtoSubmission converts a submission for the KYC and admin APIs.
+func ToProtoSubmission(submission *kycusecase.Submission) *kycv1.KycSubmission {
+	if submission == nil {
+		return nil
+	}
+
+	documents := make([]*kycv1.KycDocument, 0, len(submission.Documents))
+	for _, document := range submission.Documents {
+		documents = append(documents, ToProtoDocument(document))
+	}
+
+	protoSubmission := &kycv1.KycSubmission{
+		Id:                 submission.ID.String(),
+		UserId:             submission.UserID.String(),
+		UserEmail:          submission.UserEmail,
+		Status:             ToProtoSubmissionStatus(submission.Status),
+		BusinessName:       submission.BusinessName,
+		RegistrationNumber: submission.RegistrationNumber,
+		BusinessAddress:    submission.BusinessAddress,
+		RepresentativeName: submission.RepresentativeName,
+		Documents:          documents,
+		RejectionReasons:   submission.RejectionReasons,
+		ReviewerNote:       submission.ReviewerNote,
+		SubmittedAt:        submission.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if submission.ReviewedAt != nil {
+		protoSubmission.ReviewedAt = submission.ReviewedAt.UTC().Format(time.RFC3339)
+	}
+	return protoSubmission
+}
+
+// ToProtoDocument converts document metadata.
+func ToProtoDocument(document *kycusecase.Document) *kycv1.KycDocument {
+	return &kycv1.KycDocument{
+		Id:           document.ID.String(),
+		DocumentType: toProtoDocumentType(document.DocumentType),
+		FileName:     document.FileName,
+		ContentType:  document.ContentType,
+		SizeBytes:    document.SizeBytes,
+		Sha256:       document.SHA256,
+		UploadedAt:   document.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// ToProtoSubmissionStatus converts a stored submission status.
+func ToProtoSubmissionStatus(status string) kycv1.KycSubmissionStatus {
+	switch status {
+	case kycusecase.SubmissionSubmitted:
+		return kycv1.KycSubmissionStatus_KYC_SUBMISSION_STATUS_SUBMITTED
+	case kycusecase.SubmissionApproved:
+		return kycv1.KycSubmissionStatus_KYC_SUBMISSION_STATUS_APPROVED
+	case kycusecase.SubmissionRejected:
+		return kycv1.KycSubmissionStatus_KYC_SUBMISSION_STATUS_REJECTED
+	default:
+		return kycv1.KycSubmissionStatus_KYC_SUBMISSION_STATUS_UNSPECIFIED
+	}
+}
+
+// FromProtoSubmissionStatus converts a status filter; UNSPECIFIED maps to "".
+func FromProtoSubmissionStatus(status kycv1.KycSubmissionStatus) string {
+	switch status {
+	case kycv1.KycSubmissionStatus_KYC_SUBMISSION_STATUS_SUBMITTED:
+		return kycusecase.SubmissionSubmitted
+	case kycv1.KycSubmissionStatus_KYC_SUBMISSION_STATUS_APPROVED:
+		return kycusecase.SubmissionApproved
+	case kycv1.KycSubmissionStatus_KYC_SUBMISSION_STATUS_REJECTED:
+		return kycusecase.SubmissionRejected
+	default:
+		return ""
+	}
+}
+
+// FromProtoDocumentType converts a document type; unknown types map to "" and
+// are rejected by the use case.
+func FromProtoDocumentType(documentType kycv1.KycDocumentType) string {
+	switch documentType {
+	case kycv1.KycDocumentType_KYC_DOCUMENT_TYPE_BUSINESS_REGISTRATION:
+		return kycusecase.DocumentBusinessRegistration
+	case kycv1.KycDocumentType_KYC_DOCUMENT_TYPE_IDENTITY_DOCUMENT:
+		return kycusecase.DocumentIdentity
+	case kycv1.KycDocumentType_KYC_DOCUMENT_TYPE_PROOF_OF_ADDRESS:
+		return kycusecase.DocumentProofOfAddress
+	default:
+		return ""
+	}
+}
+
+func toProtoDocumentType(documentType string) kycv1.KycDocumentType {
+	switch documentType {
+	case kycusecase.DocumentBusinessRegistration:
+		return kycv1.KycDocumentType_KYC_DOCUMENT_TYPE_BUSINESS_REGISTRATION
+	case kycusecase.DocumentIdentity:
+		return kycv1.KycDocumentType_KYC_DOCUMENT_TYPE_IDENTITY_DOCUMENT
+	case kycusecase.DocumentProofOfAddress:
+		return kycv1.KycDocumentType_KYC_DOCUMENT_TYPE_PROOF_OF_ADDRESS
+	default:
+		return kycv1.KycDocumentType_KYC_DOCUMENT_TYPE_UNSPECIFIED
+	}
+}
@@ -0,0 +1,127 @@
+package restaurant
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	restaurantv1 "github.com/chefnext/chefnext/apps/api/internal/gen/restaurant/v1"
+	"github.com/chefnext/chefnext/apps/api/internal/gen/restaurant/v1/restaurantv1connect"
+	"github.com/chefnext/chefnext/apps/api/internal/middleware"
+	"github.com/chefnext/chefnext/apps/api/internal/usecase/apikey"
+	"github.com/google/uuid"
+)
+
+// APIKeyHandler implements ApiKeyService.
+type APIKeyHandler struct {
+	service *apikey.Service
+}
+
+// NewAPIKeyHandler wires the API key handler into Connect.
+func NewAPIKeyHandler(service *apikey.Service) restaurantv1connect.ApiKeyServiceHandler {
+	return &APIKeyHandler{service: service}
+}
+
+// CreateApiKey issues a key for the caller's restaurant.
+func (h *APIKeyHandler) CreateApiKey(ctx context.Context, req *connect.Request[restaurantv1.CreateApiKeyRequest]) (*connect.Response[restaurantv1.CreateApiKeyResponse], error) {
+	userID, err := middleware.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	input := apikey.CreateInput{
+		Name:   req.Msg.GetName(),
+		Scopes: req.Msg.GetScopes(),
+	}
+	if raw := strings.TrimSpace(req.Msg.GetExpiresAt()); raw != "" {
+		expiresAt, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, apikey.ErrInvalidExpiry)
+		}
+		input.ExpiresAt = &expiresAt
+	}
+
+	created, err := h.service.Create(ctx, userID, input)
+	if err != nil {
+		return nil, mapAPIKeyError(err)
+	}
+
+	return connect.NewResponse(&restaurantv1.CreateApiKeyResponse{
+		ApiKey: toProtoAPIKey(created.Key),
+		Secret: created.Secret,
+	}), nil
+}
+
+// ListApiKeys lists the caller's restaurant's keys.
+func (h *APIKeyHandler) ListApiKeys(ctx context.Context, _ *connect.Request[restaurantv1.ListApiKeysRequest]) (*connect.Response[restaurantv1.ListApiKeysResponse], error) {
+	userID, err := middleware.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := h.service.List(ctx, userID)
+	if err != nil {
+		return nil, mapAPIKeyError(err)
+	}
+
+	resp := &restaurantv1.ListApiKeysResponse{
+		ApiKeys: make([]*restaurantv1.ApiKey, 0, len(keys)),
+	}
+	for _, key := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, toProtoAPIKey(key))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RevokeApiKey disables a key.
+func (h *APIKeyHandler) RevokeApiKey(ctx context.Context, req *connect.Request[restaurantv1.RevokeApiKeyRequest]) (*connect.Response[restaurantv1.RevokeApiKeyResponse], error) {
+	userID, err := middleware.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID, err := uuid.Parse(req.Msg.GetApiKeyId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.service.Revoke(ctx, userID, keyID); err != nil {
+		return nil, mapAPIKeyError(err)
+	}
+
+	return connect.NewResponse(&restaurantv1.RevokeApiKeyResponse{Success: true}), nil
+}
+
+func mapAPIKeyError(err error) error {
+	switch {
+	case errors.Is(err, apikey.ErrInvalidName), errors.Is(err, apikey.ErrInvalidScopes), errors.Is(err, apikey.ErrInvalidExpiry):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, apikey.ErrKeyNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	default:
+		return mapTeamError(err)
+	}
+}
+
+func toProtoAPIKey(key *apikey.Key) *restaurantv1.ApiKey {
+	return &restaurantv1.ApiKey{
+		Id:              key.ID.String(),
+		Name:            key.Name,
+		Prefix:          key.Prefix,
+		Scopes:          key.Scopes,
+		CreatedByUserId: key.CreatedBy.String(),
+		ExpiresAt:       formatOptionalTime(key.ExpiresAt),
+		LastUsedAt:      formatOptionalTime(key.LastUsedAt),
+		RevokedAt:       formatOptionalTime(key.RevokedAt),
+		CreatedAt:       key.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
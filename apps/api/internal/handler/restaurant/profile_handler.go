@@ -26,7 +26,7 @@ func NewProfileHandler(service *restaurantprofile.Service) restaurantv1connect.R
 
 // CreateProfile registers a new restaurant profile.
 func (h *ProfileHandler) CreateProfile(ctx context.Context, req *connect.Request[restaurantv1.CreateProfileRequest]) (*connect.Response[restaurantv1.CreateProfileResponse], error) {
-	userID, err := h.requireRole(ctx, "RESTAURANT")
+	userID, err := middleware.RequireUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +74,7 @@ func (h *ProfileHandler) GetProfile(ctx context.Context, req *connect.Request[re
 
 // GetMyProfile returns the authenticated restaurant's profile.
 func (h *ProfileHandler) GetMyProfile(ctx context.Context, _ *connect.Request[restaurantv1.GetMyProfileRequest]) (*connect.Response[restaurantv1.GetMyProfileResponse], error) {
-	userID, err := h.requireRole(ctx, "RESTAURANT")
+	userID, err := middleware.RequireUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +89,7 @@ func (h *ProfileHandler) GetMyProfile(ctx context.Context, _ *connect.Request[re
 
 // UpdateProfile modifies restaurant profile fields.
 func (h *ProfileHandler) UpdateProfile(ctx context.Context, req *connect.Request[restaurantv1.UpdateProfileRequest]) (*connect.Response[restaurantv1.UpdateProfileResponse], error) {
-	userID, err := h.requireRole(ctx, "RESTAURANT")
+	userID, err := middleware.RequireUserID(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -158,19 +158,6 @@ func (h *ProfileHandler) SearchProfiles(ctx context.Context, req *connect.Reques
 	}), nil
 }
 
-func (h *ProfileHandler) requireRole(ctx context.Context, role string) (uuid.UUID, error) {
-	userID, userRole, err := h.getUserContext(ctx)
-	if err != nil {
-		return uuid.UUID{}, err
-	}
-
-	if userRole != role {
-		return uuid.UUID{}, connect.NewError(connect.CodePermissionDenied, errors.New("insufficient role"))
-	}
-
-	return userID, nil
-}
-
 func (h *ProfileHandler) getUserContext(ctx context.Context) (uuid.UUID, string, error) {
 	userID, ok := middleware.GetUserID(ctx)
 	if !ok {
@@ -243,12 +230,10 @@ func toProto(profile *restaurantprofile.Profile) *restaurantv1.RestaurantProfile
 
 func mapRestaurantError(err error) error {
 	switch {
-	case errors.Is(err, restaurantprofile.ErrProfileAlreadyExists):
+	case errors.Is(err, restaurantprofile.ErrProfileAlreadyExists), errors.Is(err, restaurantprofile.ErrAlreadyTeamMember):
 		return connect.NewError(connect.CodeAlreadyExists, err)
 	case errors.Is(err, restaurantprofile.ErrProfileNotFound):
 		return connect.NewError(connect.CodeNotFound, err)
-	case errors.Is(err, restaurantprofile.ErrInvalidName):
-		return connect.NewError(connect.CodeInvalidArgument, err)
 	case errors.Is(err, restaurantprofile.ErrUnauthorizedProfileAccess):
 		return connect.NewError(connect.CodePermissionDenied, err)
 	default:
@@ -0,0 +1,231 @@
+package restaurant
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"connectrpc.com/connect"
+	restaurantv1 "github.com/chefnext/chefnext/apps/api/internal/gen/restaurant/v1"
+	"github.com/chefnext/chefnext/apps/api/internal/gen/restaurant/v1/restaurantv1connect"
+	"github.com/chefnext/chefnext/apps/api/internal/middleware"
+	"github.com/chefnext/chefnext/apps/api/internal/usecase/restaurantteam"
+	"github.com/google/uuid"
+)
+
+// TeamHandler implements RestaurantTeamService.
+type TeamHandler struct {
+	service *restaurantteam.Service
+}
+
+// NewTeamHandler wires the team handler into Connect.
+func NewTeamHandler(service *restaurantteam.Service) restaurantv1connect.RestaurantTeamServiceHandler {
+	return &TeamHandler{service: service}
+}
+
+// ListTeamMembers lists the caller's restaurant team.
+func (h *TeamHandler) ListTeamMembers(ctx context.Context, _ *connect.Request[restaurantv1.ListTeamMembersRequest]) (*connect.Response[restaurantv1.ListTeamMembersResponse], error) {
+	userID, err := middleware.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	members, err := h.service.ListMembers(ctx, userID)
+	if err != nil {
+		return nil, mapTeamError(err)
+	}
+
+	resp := &restaurantv1.ListTeamMembersResponse{
+		Members: make([]*restaurantv1.TeamMember, 0, len(members)),
+	}
+	for _, member := range members {
+		resp.Members = append(resp.Members, toProtoTeamMember(member))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// InviteTeamMember emails an invitation to join the caller's restaurant.
+func (h *TeamHandler) InviteTeamMember(ctx context.Context, req *connect.Request[restaurantv1.InviteTeamMemberRequest]) (*connect.Response[restaurantv1.InviteTeamMemberResponse], error) {
+	userID, err := middleware.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitation, err := h.service.InviteMember(ctx, userID, restaurantteam.InviteInput{
+		Email: req.Msg.GetEmail(),
+		Role:  fromProtoTeamRole(req.Msg.GetRole()),
+	})
+	if err != nil {
+		return nil, mapTeamError(err)
+	}
+
+	return connect.NewResponse(&restaurantv1.InviteTeamMemberResponse{
+		Invitation: toProtoTeamInvitation(invitation),
+	}), nil
+}
+
+// ListTeamInvitations lists the caller's restaurant's open invitations.
+func (h *TeamHandler) ListTeamInvitations(ctx context.Context, _ *connect.Request[restaurantv1.ListTeamInvitationsRequest]) (*connect.Response[restaurantv1.ListTeamInvitationsResponse], error) {
+	userID, err := middleware.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := h.service.ListInvitations(ctx, userID)
+	if err != nil {
+		return nil, mapTeamError(err)
+	}
+
+	resp := &restaurantv1.ListTeamInvitationsResponse{
+		Invitations: make([]*restaurantv1.TeamInvitation, 0, len(invitations)),
+	}
+	for _, invitation := range invitations {
+		resp.Invitations = append(resp.Invitations, toProtoTeamInvitation(invitation))
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// RevokeTeamInvitation withdraws an open invitation.
+func (h *TeamHandler) RevokeTeamInvitation(ctx context.Context, req *connect.Request[restaurantv1.RevokeTeamInvitationRequest]) (*connect.Response[restaurantv1.RevokeTeamInvitationResponse], error) {
+	userID, err := middleware.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitationID, err := uuid.Parse(req.Msg.GetInvitationId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.service.RevokeInvitation(ctx, userID, invitationID); err != nil {
+		return nil, mapTeamError(err)
+	}
+
+	return connect.NewResponse(&restaurantv1.RevokeTeamInvitationResponse{Success: true}), nil
+}
+
+// AcceptTeamInvitation joins the inviting restaurant.
+func (h *TeamHandler) AcceptTeamInvitation(ctx context.Context, req *connect.Request[restaurantv1.AcceptTeamInvitationRequest]) (*connect.Response[restaurantv1.AcceptTeamInvitationResponse], error) {
+	userID, err := middleware.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := h.service.AcceptInvitation(ctx, userID, req.Msg.GetToken())
+	if err != nil {
+		return nil, mapTeamError(err)
+	}
+
+	return connect.NewResponse(&restaurantv1.AcceptTeamInvitationResponse{
+		Member: toProtoTeamMember(member),
+	}), nil
+}
+
+// UpdateTeamMemberRole changes a member's role.
+func (h *TeamHandler) UpdateTeamMemberRole(ctx context.Context, req *connect.Request[restaurantv1.UpdateTeamMemberRoleRequest]) (*connect.Response[restaurantv1.UpdateTeamMemberRoleResponse], error) {
+	userID, err := middleware.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := uuid.Parse(req.Msg.GetMemberId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	member, err := h.service.UpdateMemberRole(ctx, userID, memberID, fromProtoTeamRole(req.Msg.GetRole()))
+	if err != nil {
+		return nil, mapTeamError(err)
+	}
+
+	return connect.NewResponse(&restaurantv1.UpdateTeamMemberRoleResponse{
+		Member: toProtoTeamMember(member),
+	}), nil
+}
+
+// RemoveTeamMember removes a member from the team.
+func (h *TeamHandler) RemoveTeamMember(ctx context.Context, req *connect.Request[restaurantv1.RemoveTeamMemberRequest]) (*connect.Response[restaurantv1.RemoveTeamMemberResponse], error) {
+	userID, err := middleware.RequireUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	memberID, err := uuid.Parse(req.Msg.GetMemberId())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := h.service.RemoveMember(ctx, userID, memberID); err != nil {
+		return nil, mapTeamError(err)
+	}
+
+	return connect.NewResponse(&restaurantv1.RemoveTeamMemberResponse{Success: true}), nil
+}
+
+func mapTeamError(err error) error {
+	switch {
+	case errors.Is(err, restaurantteam.ErrInvalidRole), errors.Is(err, restaurantteam.ErrInvalidEmail):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.Is(err, restaurantteam.ErrNotMember), errors.Is(err, restaurantteam.ErrMemberNotFound), errors.Is(err, restaurantteam.ErrInvitationNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, restaurantteam.ErrForbidden), errors.Is(err, restaurantteam.ErrInvitationEmailInvalid), errors.Is(err, restaurantteam.ErrNotRestaurantAccount):
+		return connect.NewError(connect.CodePermissionDenied, err)
+	case errors.Is(err, restaurantteam.ErrAlreadyMember):
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	case errors.Is(err, restaurantteam.ErrLastOwner), errors.Is(err, restaurantteam.ErrInvitationInvalid):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	default:
+		return connect.NewError(connect.CodeInternal, err)
+	}
+}
+
+func toProtoTeamMember(member *restaurantteam.Member) *restaurantv1.TeamMember {
+	return &restaurantv1.TeamMember{
+		Id:       member.ID.String(),
+		UserId:   member.UserID.String(),
+		Email:    member.Email,
+		Role:     toProtoTeamRole(member.Role),
+		JoinedAt: member.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func toProtoTeamInvitation(invitation *restaurantteam.Invitation) *restaurantv1.TeamInvitation {
+	return &restaurantv1.TeamInvitation{
+		Id:        invitation.ID.String(),
+		Email:     invitation.Email,
+		Role:      toProtoTeamRole(invitation.Role),
+		ExpiresAt: invitation.ExpiresAt.UTC().Format(time.RFC3339),
+		CreatedAt: invitation.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func toProtoTeamRole(role string) restaurantv1.TeamRole {
+	switch role {
+	case restaurantteam.RoleOwner:
+		return restaurantv1.TeamRole_TEAM_ROLE_OWNER
+	case restaurantteam.RoleManager:
+		return restaurantv1.TeamRole_TEAM_ROLE_MANAGER
+	case restaurantteam.RoleRecruiter:
+		return restaurantv1.TeamRole_TEAM_ROLE_RECRUITER
+	case restaurantteam.RoleViewer:
+		return restaurantv1.TeamRole_TEAM_ROLE_VIEWER
+	default:
+		return restaurantv1.TeamRole_TEAM_ROLE_UNSPECIFIED
+	}
+}
+
+// fromProtoTeamRole maps UNSPECIFIED to "", which the service rejects.
+func fromProtoTeamRole(role restaurantv1.TeamRole) string {
+	switch role {
+	case restaurantv1.TeamRole_TEAM_ROLE_OWNER:
+		return restaurantteam.RoleOwner
+	case restaurantv1.TeamRole_TEAM_ROLE_MANAGER:
+		return restaurantteam.RoleManager
+	case restaurantv1.TeamRole_TEAM_ROLE_RECRUITER:
+		return restaurantteam.RoleRecruiter
+	case restaurantv1.TeamRole_TEAM_ROLE_VIEWER:
+		return restaurantteam.RoleViewer
+	default:
+		return ""
+	}
+}
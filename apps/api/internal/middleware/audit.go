@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
+)
+
+// AuditInterceptor is a Connect interceptor that stores who is making the
+// request, and through which procedure, for audit events recorded by use cases
+//
+// It reads the caller from the context, so it must run after AuthInterceptor.
+type AuditInterceptor struct{}
+
+// NewAuditInterceptor creates a new audit context interceptor
+func NewAuditInterceptor() *AuditInterceptor {
+	return &AuditInterceptor{}
+}
+
+// WrapUnary wraps unary RPCs with audit context
+func (i *AuditInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		ctx = withAuditRequest(ctx, req.Spec().Procedure, req.Header(), req.Peer().Addr)
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient wraps streaming client RPCs with audit context
+func (i *AuditInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		return next(ctx, spec)
+	}
+}
+
+// WrapStreamingHandler wraps streaming handler RPCs with audit context
+func (i *AuditInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx = withAuditRequest(ctx, conn.Spec().Procedure, conn.RequestHeader(), conn.Peer().Addr)
+		return next(ctx, conn)
+	}
+}
+
+func withAuditRequest(ctx context.Context, procedure string, header http.Header, peerAddr string) context.Context {
+	request := audit.Request{
+		Procedure: procedure,
+		IPAddress: ClientIP(ctx, peerAddr),
+		RequestID: header.Get(RequestIDHeader),
+	}
+	if userID, ok := GetUserID(ctx); ok {
+		request.ActorUserID = userID
+	}
+	if role, ok := GetUserRole(ctx); ok {
+		request.ActorRole = role
+	}
+	if keyID, ok := GetAPIKeyID(ctx); ok {
+		request.APIKeyID = keyID
+	}
+	return audit.WithRequest(ctx, request)
+}
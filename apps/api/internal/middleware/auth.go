@@ -2,47 +2,51 @@ package middleware
 
 import (
 	"context"
+	"errors"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
 )
 
-// AuthInterceptor is a Connect interceptor that validates JWT tokens
+var (
+	ErrTokenRevoked         = errors.New("token has been revoked")
+	ErrAPIKeyScopeMissing   = errors.New("api key is missing the scope for this endpoint")
+	ErrAPIKeyNotAllowedHere = errors.New("api keys cannot call this endpoint")
+)
+
+// APIKeyAuthenticator resolves an API key to the principal it acts for
+type APIKeyAuthenticator interface {
+	Authenticate(ctx context.Context, key string) (*auth.APIKeyPrincipal, error)
+}
+
+// AuthInterceptor is a Connect interceptor that validates JWT tokens and API keys
 type AuthInterceptor struct {
-	jwtManager *auth.JWTManager
+	jwtManager  *auth.JWTManager
+	revocations *auth.RevocationList
+	apiKeys     APIKeyAuthenticator
+	cache       *revocationCache
 }
 
 // NewAuthInterceptor creates a new auth interceptor
-func NewAuthInterceptor(jwtManager *auth.JWTManager) *AuthInterceptor {
+func NewAuthInterceptor(jwtManager *auth.JWTManager, revocations *auth.RevocationList, apiKeys APIKeyAuthenticator) *AuthInterceptor {
 	return &AuthInterceptor{
-		jwtManager: jwtManager,
+		jwtManager:  jwtManager,
+		revocations: revocations,
+		apiKeys:     apiKeys,
+		cache:       newRevocationCache(),
 	}
 }
 
 // WrapUnary wraps unary RPCs with authentication
 func (i *AuthInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		// Skip authentication for public endpoints
-		if isPublicEndpoint(req.Spec().Procedure) {
-			return next(ctx, req)
-		}
-
-		// Extract token from Authorization header
-		token := extractToken(req.Header().Get("Authorization"))
-		if token == "" {
-			return nil, connect.NewError(connect.CodeUnauthenticated, nil)
-		}
-
-		// Verify access token
-		claims, err := i.jwtManager.VerifyAccessToken(token)
+		ctx, err := i.authorize(ctx, req.Spec(), req.Header().Get("Authorization"))
 		if err != nil {
-			return nil, connect.NewError(connect.CodeUnauthenticated, err)
+			return nil, err
 		}
 
-		// Store user information in context
-		ctx = WithUserContext(ctx, claims)
-
 		return next(ctx, req)
 	}
 }
@@ -57,23 +61,126 @@ func (i *AuthInterceptor) WrapStreamingClient(next connect.StreamingClientFunc)
 // WrapStreamingHandler wraps streaming handler RPCs with authentication
 func (i *AuthInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
 	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
-		// Extract token from Authorization header
-		token := extractToken(conn.RequestHeader().Get("Authorization"))
-		if token == "" {
-			return connect.NewError(connect.CodeUnauthenticated, nil)
+		ctx, err := i.authorize(ctx, conn.Spec(), conn.RequestHeader().Get("Authorization"))
+		if err != nil {
+			return err
 		}
 
-		// Verify access token
-		claims, err := i.jwtManager.VerifyAccessToken(token)
+		return next(ctx, conn)
+	}
+}
+
+// authorize enforces the procedure's (chefnext.v1.auth) policy and returns a
+// context carrying the caller's identity; procedures without a policy are
+// closed to everyone
+func (i *AuthInterceptor) authorize(ctx context.Context, spec connect.Spec, authHeader string) (context.Context, error) {
+	policy, ok := authPolicyFor(spec)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, ErrNoAuthPolicy)
+	}
+	if policy.GetPublic() {
+		return ctx, nil
+	}
+
+	ctx, err := i.authenticate(ctx, policy.GetApiKeyScope(), authHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	if roles := policyRoles(policy); len(roles) > 0 {
+		if err := checkRole(ctx, roles...); err != nil {
+			return nil, err
+		}
+	}
+
+	return ctx, nil
+}
+
+// authenticate verifies the bearer token and returns a context carrying the
+// caller's identity
+// apiKeyScope: the scope API keys need for the procedure; empty rejects API keys
+func (i *AuthInterceptor) authenticate(ctx context.Context, apiKeyScope, authHeader string) (context.Context, error) {
+	// Extract token from Authorization header
+	token := extractToken(authHeader)
+	if token == "" {
+		return nil, connect.NewError(connect.CodeUnauthenticated, nil)
+	}
+
+	if auth.IsAPIKey(token) {
+		principal, err := i.authenticateAPIKey(ctx, apiKeyScope, token)
 		if err != nil {
-			return connect.NewError(connect.CodeUnauthenticated, err)
+			return nil, err
 		}
+		return WithAPIKeyContext(ctx, principal), nil
+	}
 
-		// Store user information in context
-		ctx = WithUserContext(ctx, claims)
+	claims, err := i.authenticateJWT(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return WithUserContext(ctx, claims), nil
+}
 
-		return next(ctx, conn)
+// authenticateAPIKey resolves the key and checks it was granted the scope the
+// procedure needs; procedures without a scope are closed to API keys
+func (i *AuthInterceptor) authenticateAPIKey(ctx context.Context, scope, key string) (*auth.APIKeyPrincipal, error) {
+	if scope == "" || i.apiKeys == nil {
+		return nil, connect.NewError(connect.CodePermissionDenied, ErrAPIKeyNotAllowedHere)
+	}
+
+	principal, err := i.apiKeys.Authenticate(ctx, key)
+	if errors.Is(err, auth.ErrInvalidAPIKey) {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+
+	if !principal.HasScope(scope) {
+		return nil, connect.NewError(connect.CodePermissionDenied, ErrAPIKeyScopeMissing)
+	}
+
+	return principal, nil
+}
+
+// authenticateJWT verifies the access token and checks it has not been revoked
+func (i *AuthInterceptor) authenticateJWT(ctx context.Context, token string) (*auth.Claims, error) {
+	// Verify access token
+	claims, err := i.jwtManager.VerifyAccessToken(token)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+
+	// Reject tokens revoked by logout, session revocation or a password change
+	revoked, err := i.isRevoked(ctx, claims)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, err)
+	}
+	if revoked {
+		return nil, connect.NewError(connect.CodeUnauthenticated, ErrTokenRevoked)
+	}
+
+	return claims, nil
+}
+
+// isRevoked consults the local cache before asking Redis
+func (i *AuthInterceptor) isRevoked(ctx context.Context, claims *auth.Claims) (bool, error) {
+	now := time.Now()
+	if revoked, ok := i.cache.get(claims.ID, now); ok {
+		return revoked, nil
+	}
+
+	revoked, err := i.revocations.IsRevoked(ctx, claims)
+	if err != nil {
+		return false, err
+	}
+
+	expiresAt := now.Add(revocationCacheTTL)
+	if claims.ExpiresAt != nil {
+		expiresAt = claims.ExpiresAt.Time
 	}
+	i.cache.set(claims.ID, revoked, expiresAt, now)
+	return revoked, nil
 }
 
 // extractToken extracts the token from the Authorization header
@@ -90,19 +197,3 @@ func extractToken(authHeader string) string {
 
 	return parts[1]
 }
-
-// isPublicEndpoint checks if the endpoint is public (does not require authentication)
-func isPublicEndpoint(procedure string) bool {
-	publicEndpoints := []string{
-		"/identity.v1.AuthService/Register",
-		"/identity.v1.AuthService/Login",
-	}
-
-	for _, endpoint := range publicEndpoints {
-		if procedure == endpoint {
-			return true
-		}
-	}
-
-	return false
-}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	chefnextv1 "github.com/chefnext/chefnext/apps/api/internal/gen/chefnext/v1"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var (
+	ErrNoAuthPolicy      = errors.New("procedure has no (chefnext.v1.auth) policy")
+	ErrInvalidAuthPolicy = errors.New("procedure has an invalid (chefnext.v1.auth) policy")
+)
+
+// authPolicyFor returns the (chefnext.v1.auth) option declared on the called
+// method in its proto definition
+func authPolicyFor(spec connect.Spec) (*chefnextv1.AuthPolicy, bool) {
+	method, ok := spec.Schema.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, false
+	}
+	return methodAuthPolicy(method)
+}
+
+func methodAuthPolicy(method protoreflect.MethodDescriptor) (*chefnextv1.AuthPolicy, bool) {
+	options, ok := method.Options().(*descriptorpb.MethodOptions)
+	if !ok || !proto.HasExtension(options, chefnextv1.E_Auth) {
+		return nil, false
+	}
+	policy, ok := proto.GetExtension(options, chefnextv1.E_Auth).(*chefnextv1.AuthPolicy)
+	return policy, ok
+}
+
+// policyRoles returns the roles a policy allows in the form used by tokens
+func policyRoles(policy *chefnextv1.AuthPolicy) []string {
+	roles := make([]string, 0, len(policy.GetRoles()))
+	for _, role := range policy.GetRoles() {
+		roles = append(roles, strings.TrimPrefix(role.String(), "ROLE_"))
+	}
+	return roles
+}
+
+// CheckAuthPolicies verifies that every method of the named services declares
+// a usable auth policy, so an RPC can never be exposed by forgetting one
+// serviceNames: fully-qualified service names (e.g., "job.v1.JobService")
+func CheckAuthPolicies(serviceNames ...string) error {
+	var errs []error
+	for _, name := range serviceNames {
+		descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return fmt.Errorf("service %s: %w", name, err)
+		}
+		service, ok := descriptor.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return fmt.Errorf("%s is not a service", name)
+		}
+
+		methods := service.Methods()
+		for i := range methods.Len() {
+			if err := checkAuthPolicy(methods.Get(i)); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func checkAuthPolicy(method protoreflect.MethodDescriptor) error {
+	policy, ok := methodAuthPolicy(method)
+	if !ok {
+		return fmt.Errorf("%s: %w", method.FullName(), ErrNoAuthPolicy)
+	}
+	if policy.GetPublic() && (len(policy.GetRoles()) > 0 || policy.GetApiKeyScope() != "") {
+		return fmt.Errorf("%s: %w: public methods cannot require roles or scopes", method.FullName(), ErrInvalidAuthPolicy)
+	}
+	if scope := policy.GetApiKeyScope(); scope != "" && !slices.Contains(auth.APIKeyScopes, scope) {
+		return fmt.Errorf("%s: %w: unknown api key scope %q", method.FullName(), ErrInvalidAuthPolicy, scope)
+	}
+	for _, role := range policy.GetRoles() {
+		if role == chefnextv1.Role_ROLE_UNSPECIFIED {
+			return fmt.Errorf("%s: %w: unspecified role", method.FullName(), ErrInvalidAuthPolicy)
+		}
+	}
+	return nil
+}
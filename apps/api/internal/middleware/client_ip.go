@@ -0,0 +1,110 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+type clientIPKey struct{}
+
+// NewClientIPMiddleware returns a HTTP middleware that resolves the caller's
+// IP address once per request and stores it in the request context
+//
+// X-Forwarded-For and X-Real-IP are only honoured when the connection comes
+// from one of trustedProxies, since anyone else can set them to anything.
+// X-Forwarded-For is walked from the right, skipping trusted proxies, so
+// addresses prepended by the client are ignored.
+func NewClientIPMiddleware(trustedProxies []netip.Prefix) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := resolveClientIP(r.Header, r.RemoteAddr, trustedProxies)
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIPKey{}, ip)))
+		})
+	}
+}
+
+// ClientIP returns the caller's IP address resolved by the client IP
+// middleware, falling back to the peer address of the connection
+func ClientIP(ctx context.Context, peerAddr string) string {
+	if ip, ok := ctx.Value(clientIPKey{}).(string); ok && ip != "" {
+		return ip
+	}
+	return peerHost(peerAddr)
+}
+
+// ParseTrustedProxies parses IP addresses and CIDR prefixes of trusted proxies
+func ParseTrustedProxies(values []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(values))
+	for _, value := range values {
+		if strings.Contains(value, "/") {
+			prefix, err := netip.ParsePrefix(value)
+			if err != nil {
+				return nil, err
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(value)
+		if err != nil {
+			return nil, err
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes, nil
+}
+
+func resolveClientIP(header http.Header, peerAddr string, trustedProxies []netip.Prefix) string {
+	peer := peerHost(peerAddr)
+	if !isTrustedProxy(peer, trustedProxies) {
+		return peer
+	}
+
+	if forwarded := header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+		hops := strings.Split(strings.Join(forwarded, ","), ",")
+		client := peer
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if _, err := netip.ParseAddr(hop); err != nil {
+				break
+			}
+			client = hop
+			if !isTrustedProxy(hop, trustedProxies) {
+				break
+			}
+		}
+		return client
+	}
+
+	if realIP := strings.TrimSpace(header.Get("X-Real-IP")); realIP != "" {
+		if _, err := netip.ParseAddr(realIP); err == nil {
+			return realIP
+		}
+	}
+	return peer
+}
+
+func isTrustedProxy(ip string, trustedProxies []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func peerHost(peerAddr string) string {
+	host, _, err := net.SplitHostPort(peerAddr)
+	if err != nil {
+		return peerAddr
+	}
+	return host
+}
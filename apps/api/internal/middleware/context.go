@@ -2,7 +2,9 @@ package middleware
 
 import (
 	"context"
+	"errors"
 
+	"connectrpc.com/connect"
 	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
 	"github.com/google/uuid"
 )
@@ -14,6 +16,11 @@ const (
 	userIDKey    contextKey = "user_id"
 	userEmailKey contextKey = "user_email"
 	userRoleKey  contextKey = "user_role"
+	userRolesKey contextKey = "user_roles"
+	sessionIDKey contextKey = "session_id"
+	tokenIDKey   contextKey = "token_id"
+	mfaKey       contextKey = "mfa"
+	apiKeyIDKey  contextKey = "api_key_id"
 )
 
 // WithUserContext stores user information in context
@@ -21,6 +28,24 @@ func WithUserContext(ctx context.Context, claims *auth.Claims) context.Context {
 	ctx = context.WithValue(ctx, userIDKey, claims.UserID)
 	ctx = context.WithValue(ctx, userEmailKey, claims.Email)
 	ctx = context.WithValue(ctx, userRoleKey, claims.Role)
+	ctx = context.WithValue(ctx, userRolesKey, claims.GrantedRoles())
+	ctx = context.WithValue(ctx, sessionIDKey, claims.SessionID)
+	ctx = context.WithValue(ctx, tokenIDKey, claims.ID)
+	ctx = context.WithValue(ctx, mfaKey, claims.MFA)
+	return ctx
+}
+
+// WithAPIKeyContext stores the identity an API key acts for in context. Keys
+// count as MFA-verified because they can only be created from a session that
+// already satisfied the MFA policy, and always act in the RESTAURANT role
+// whatever role their creator currently has active
+func WithAPIKeyContext(ctx context.Context, principal *auth.APIKeyPrincipal) context.Context {
+	ctx = context.WithValue(ctx, userIDKey, principal.UserID)
+	ctx = context.WithValue(ctx, userEmailKey, principal.Email)
+	ctx = context.WithValue(ctx, userRoleKey, "RESTAURANT")
+	ctx = context.WithValue(ctx, userRolesKey, principal.Roles)
+	ctx = context.WithValue(ctx, mfaKey, true)
+	ctx = context.WithValue(ctx, apiKeyIDKey, principal.KeyID)
 	return ctx
 }
 
@@ -30,14 +55,54 @@ func GetUserID(ctx context.Context) (uuid.UUID, bool) {
 	return userID, ok
 }
 
+// RequireUserID retrieves the caller's user ID for handlers of authenticated
+// RPCs; roles are enforced by the method's auth policy before the handler runs
+func RequireUserID(ctx context.Context) (uuid.UUID, error) {
+	userID, ok := GetUserID(ctx)
+	if !ok {
+		return uuid.Nil, connect.NewError(connect.CodeUnauthenticated, errors.New("missing user context"))
+	}
+	return userID, nil
+}
+
 // GetUserEmail retrieves user email from context
 func GetUserEmail(ctx context.Context) (string, bool) {
 	email, ok := ctx.Value(userEmailKey).(string)
 	return email, ok
 }
 
-// GetUserRole retrieves user role from context
+// GetUserRole retrieves the user's active role from context
 func GetUserRole(ctx context.Context) (string, bool) {
 	role, ok := ctx.Value(userRoleKey).(string)
 	return role, ok
 }
+
+// GetUserRoles retrieves every role granted to the user from context
+func GetUserRoles(ctx context.Context) ([]string, bool) {
+	roles, ok := ctx.Value(userRolesKey).([]string)
+	return roles, ok
+}
+
+// GetSessionID retrieves the session ID of the access token from context
+func GetSessionID(ctx context.Context) (string, bool) {
+	sessionID, ok := ctx.Value(sessionIDKey).(string)
+	return sessionID, ok && sessionID != ""
+}
+
+// GetTokenID retrieves the jti of the access token from context
+func GetTokenID(ctx context.Context) (string, bool) {
+	tokenID, ok := ctx.Value(tokenIDKey).(string)
+	return tokenID, ok && tokenID != ""
+}
+
+// GetMFA reports whether the access token's session passed a second factor
+func GetMFA(ctx context.Context) bool {
+	mfa, _ := ctx.Value(mfaKey).(bool)
+	return mfa
+}
+
+// GetAPIKeyID retrieves the API key the request was authenticated with
+func GetAPIKeyID(ctx context.Context) (uuid.UUID, bool) {
+	keyID, ok := ctx.Value(apiKeyIDKey).(uuid.UUID)
+	return keyID, ok
+}
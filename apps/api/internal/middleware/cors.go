@@ -44,7 +44,8 @@ func NewCORSMiddleware(origins []string) func(http.Handler) http.Handler {
 				}
 				w.Header().Set("Access-Control-Allow-Origin", value)
 				w.Header().Set("Access-Control-Allow-Methods", "GET,POST,PUT,PATCH,DELETE,OPTIONS")
-				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+				w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Traceparent, Tracestate, Idempotency-Key, X-Request-Id")
+				w.Header().Set("Access-Control-Expose-Headers", "RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset, RateLimit-Policy, Retry-After, Idempotent-Replayed, X-Request-Id")
 				w.Header().Set("Access-Control-Max-Age", "300")
 				w.Header().Add("Vary", "Origin")
 			}
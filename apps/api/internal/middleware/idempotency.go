@@ -0,0 +1,206 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"connectrpc.com/connect"
+	chefnextv1 "github.com/chefnext/chefnext/apps/api/internal/gen/chefnext/v1"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/idempotency"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	// IdempotencyKeyHeader carries the client-chosen key of a mutating request
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks responses replayed from an earlier request
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+var (
+	ErrIdempotencyKeyTooLong = errors.New("idempotency key must be at most 255 characters")
+)
+
+// IdempotencyInterceptor is a Connect interceptor that makes retried mutating
+// RPCs safe: a request sent again with the same Idempotency-Key gets the stored
+// response of the first one instead of running twice
+//
+// Keys are scoped to the caller and procedure, so it must run after
+// AuthInterceptor; unauthenticated calls, RPCs marked NO_SIDE_EFFECTS and RPCs
+// marked (chefnext.v1.sensitive_response) ignore the header, the last so tokens
+// and secrets are never kept in Redis or handed out twice. Only successful
+// responses are stored, so failed requests can be retried with the same key.
+type IdempotencyInterceptor struct {
+	store *idempotency.Store
+	log   *slog.Logger
+}
+
+// NewIdempotencyInterceptor creates a new idempotency key interceptor
+func NewIdempotencyInterceptor(store *idempotency.Store, log *slog.Logger) *IdempotencyInterceptor {
+	return &IdempotencyInterceptor{
+		store: store,
+		log:   log,
+	}
+}
+
+// WrapUnary wraps unary RPCs with idempotency key handling
+func (i *IdempotencyInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		key := req.Header().Get(IdempotencyKeyHeader)
+		if key == "" || req.Spec().IdempotencyLevel == connect.IdempotencyNoSideEffects || hasSensitiveResponse(req.Spec()) {
+			return next(ctx, req)
+		}
+		caller, ok := idempotencyCaller(ctx)
+		if !ok {
+			return next(ctx, req)
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			return nil, connect.NewError(connect.CodeInvalidArgument, ErrIdempotencyKeyTooLong)
+		}
+
+		msg, ok := req.Any().(proto.Message)
+		if !ok {
+			return next(ctx, req)
+		}
+		fingerprint, err := requestFingerprint(msg)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+
+		keyHash := sha256.Sum256([]byte(key))
+		storeKey := fmt.Sprintf("%s:%s:%s", caller, req.Spec().Procedure, hex.EncodeToString(keyHash[:]))
+
+		stored, found, err := i.store.Begin(ctx, storeKey, fingerprint)
+		switch {
+		case errors.Is(err, idempotency.ErrKeyReused):
+			return nil, idempotencyError(connect.CodeInvalidArgument, err, "IDEMPOTENCY_KEY_REUSED")
+		case errors.Is(err, idempotency.ErrInProgress):
+			return nil, idempotencyError(connect.CodeAborted, err, "IDEMPOTENCY_KEY_IN_PROGRESS")
+		case err != nil:
+			return nil, connect.NewError(connect.CodeUnavailable, err)
+		case found:
+			return replayResponse(req.Spec(), stored)
+		}
+
+		// The outcome must be recorded even if the client has gone away
+		storeCtx := context.WithoutCancel(ctx)
+
+		resp, err := next(ctx, req)
+		if err != nil {
+			if releaseErr := i.store.Release(storeCtx, storeKey, fingerprint); releaseErr != nil {
+				i.log.WarnContext(ctx, "release idempotency key failed", slog.Any("error", releaseErr))
+			}
+			return nil, err
+		}
+
+		if err := i.complete(storeCtx, storeKey, fingerprint, resp); err != nil {
+			// The change is already made; a retry after the claim expires would
+			// run it again, which is no worse than not sending a key
+			i.log.ErrorContext(ctx, "store idempotent response failed", slog.String("procedure", req.Spec().Procedure), slog.Any("error", err))
+		}
+		return resp, nil
+	}
+}
+
+// WrapStreamingClient wraps streaming client RPCs with idempotency key handling
+func (i *IdempotencyInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		return next(ctx, spec)
+	}
+}
+
+// WrapStreamingHandler leaves streaming RPCs alone since their responses
+// cannot be replayed
+func (i *IdempotencyInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, conn)
+	}
+}
+
+func (i *IdempotencyInterceptor) complete(ctx context.Context, key, fingerprint string, resp connect.AnyResponse) error {
+	msg, ok := resp.Any().(proto.Message)
+	if !ok {
+		return fmt.Errorf("response %T is not a proto message", resp.Any())
+	}
+	body, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return i.store.Complete(ctx, key, fingerprint, body)
+}
+
+// idempotencyCaller scopes keys to the API key or user making the request
+func idempotencyCaller(ctx context.Context) (string, bool) {
+	if keyID, ok := GetAPIKeyID(ctx); ok {
+		return "api_key:" + keyID.String(), true
+	}
+	if userID, ok := GetUserID(ctx); ok {
+		return "user:" + userID.String(), true
+	}
+	return "", false
+}
+
+// hasSensitiveResponse reports whether the called method is marked
+// (chefnext.v1.sensitive_response) in its proto definition
+func hasSensitiveResponse(spec connect.Spec) bool {
+	method, ok := spec.Schema.(protoreflect.MethodDescriptor)
+	if !ok {
+		return false
+	}
+	options, ok := method.Options().(*descriptorpb.MethodOptions)
+	if !ok {
+		return false
+	}
+	sensitive, _ := proto.GetExtension(options, chefnextv1.E_SensitiveResponse).(bool)
+	return sensitive
+}
+
+// requestFingerprint identifies the request body, so a key reused for a
+// different request can be told apart from a retry
+func requestFingerprint(msg proto.Message) (string, error) {
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// replayResponse decodes a stored response as the method's output message;
+// Connect serializes it like the response the handler originally returned
+func replayResponse(spec connect.Spec, body []byte) (connect.AnyResponse, error) {
+	method, ok := spec.Schema.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("no schema for %s", spec.Procedure))
+	}
+
+	msg := dynamicpb.NewMessage(method.Output())
+	if err := proto.Unmarshal(body, msg); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	resp := connect.NewResponse(msg)
+	resp.Header().Set(IdempotentReplayedHeader, "true")
+	return resp, nil
+}
+
+func idempotencyError(code connect.Code, err error, reason string) error {
+	connectErr := connect.NewError(code, err)
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: "chefnext.api",
+	}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
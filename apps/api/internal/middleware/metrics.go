@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/metrics"
+)
+
+// MetricsInterceptor is a Connect interceptor that records request counts,
+// result codes and latency per procedure
+//
+// It should run first so requests rejected by later interceptors are counted.
+type MetricsInterceptor struct {
+	metrics *metrics.Metrics
+}
+
+// NewMetricsInterceptor creates a new RPC metrics interceptor
+func NewMetricsInterceptor(metrics *metrics.Metrics) *MetricsInterceptor {
+	return &MetricsInterceptor{
+		metrics: metrics,
+	}
+}
+
+// WrapUnary wraps unary RPCs with metrics
+func (i *MetricsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+		resp, err := next(ctx, req)
+		i.metrics.ObserveRPC(req.Spec().Procedure, resultCode(err), time.Since(start))
+		return resp, err
+	}
+}
+
+// WrapStreamingClient wraps streaming client RPCs with metrics
+func (i *MetricsInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		return next(ctx, spec)
+	}
+}
+
+// WrapStreamingHandler wraps streaming handler RPCs with metrics
+func (i *MetricsInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		err := next(ctx, conn)
+		i.metrics.ObserveRPC(conn.Spec().Procedure, resultCode(err), time.Since(start))
+		return err
+	}
+}
+
+// resultCode labels an RPC outcome with its Connect code, or "ok"
+func resultCode(err error) string {
+	if err == nil {
+		return "ok"
+	}
+	return connect.CodeOf(err).String()
+}
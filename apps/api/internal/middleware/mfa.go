@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+var (
+	ErrMFAEnrollmentRequired = errors.New("two-factor authentication must be set up for this account")
+)
+
+// MFAInterceptor is a Connect interceptor that holds users whose role requires
+// MFA to the enrollment endpoints until their session has passed a second factor
+//
+// It must run after AuthInterceptor so the user's claims are in the context.
+type MFAInterceptor struct {
+	policy *auth.MFAPolicy
+}
+
+// NewMFAInterceptor creates a new MFA enforcement interceptor
+func NewMFAInterceptor(policy *auth.MFAPolicy) *MFAInterceptor {
+	return &MFAInterceptor{
+		policy: policy,
+	}
+}
+
+// WrapUnary wraps unary RPCs with MFA enforcement
+func (i *MFAInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.check(ctx, req.Spec()); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient wraps streaming client RPCs with MFA enforcement
+func (i *MFAInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		return next(ctx, spec)
+	}
+}
+
+// WrapStreamingHandler wraps streaming handler RPCs with MFA enforcement
+func (i *MFAInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := i.check(ctx, conn.Spec()); err != nil {
+			return err
+		}
+		return next(ctx, conn)
+	}
+}
+
+func (i *MFAInterceptor) check(ctx context.Context, spec connect.Spec) error {
+	roles, ok := GetUserRoles(ctx)
+	if !ok || !i.policy.Requires(roles...) || GetMFA(ctx) {
+		return nil
+	}
+	// Methods marked allow_before_mfa stay reachable so MFA can be set up
+	if policy, ok := authPolicyFor(spec); ok && policy.GetAllowBeforeMfa() {
+		return nil
+	}
+
+	connectErr := connect.NewError(connect.CodePermissionDenied, ErrMFAEnrollmentRequired)
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.ErrorInfo{
+		Reason: "MFA_ENROLLMENT_REQUIRED",
+		Domain: "chefnext.identity",
+	}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
@@ -2,75 +2,114 @@ package middleware
 
 import (
 	"context"
-	"sync"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
 
 	"connectrpc.com/connect"
-	"golang.org/x/time/rate"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/metrics"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/ratelimit"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
-// RateLimitInterceptor is a Connect interceptor that implements rate limiting
+var (
+	ErrRateLimited = errors.New("rate limit exceeded")
+)
+
+// RateLimitInterceptor is a Connect interceptor that rate limits callers with
+// limits shared across replicas through Redis
+//
+// Callers are keyed by API key, then user, then client IP, so it must run after
+// AuthInterceptor. Procedures with their own policy get their own bucket; all
+// others share the default one. Every response carries RateLimit-* headers and
+// rejected calls also carry Retry-After.
+//
+// The client IP variant from NewClientIPRateLimitInterceptor runs ahead of
+// AuthInterceptor instead, so requests with bad credentials still count
+// against their client IP. It only sets headers on rejected calls so that the
+// per-caller limits stay the ones reported to clients.
 type RateLimitInterceptor struct {
-	limiters map[string]*rate.Limiter
-	mu       sync.RWMutex
-	rps      rate.Limit // requests per second
-	burst    int        // burst size
+	limiter       *ratelimit.Limiter
+	defaultPolicy ratelimit.Policy
+	policies      map[string]ratelimit.Policy
+	identify      func(ctx context.Context, peerAddr string) string
+	bucket        string
+	quiet         bool
+	metrics       *metrics.Metrics
+	log           *slog.Logger
 }
 
 // NewRateLimitInterceptor creates a new rate limiting interceptor
-// rps: requests per second allowed
-// burst: maximum burst size
-func NewRateLimitInterceptor(rps float64, burst int) *RateLimitInterceptor {
+// policies: per-procedure overrides of defaultPolicy, keyed by full procedure name
+func NewRateLimitInterceptor(limiter *ratelimit.Limiter, defaultPolicy ratelimit.Policy, policies map[string]ratelimit.Policy, metrics *metrics.Metrics, log *slog.Logger) *RateLimitInterceptor {
 	return &RateLimitInterceptor{
-		limiters: make(map[string]*rate.Limiter),
-		rps:      rate.Limit(rps),
-		burst:    burst,
+		limiter:       limiter,
+		defaultPolicy: defaultPolicy,
+		policies:      policies,
+		identify:      rateLimitIdentifier,
+		bucket:        "default",
+		metrics:       metrics,
+		log:           log,
 	}
 }
 
-// getLimiter returns the rate limiter for a given identifier (e.g., user ID or IP)
-func (i *RateLimitInterceptor) getLimiter(identifier string) *rate.Limiter {
-	i.mu.RLock()
-	limiter, exists := i.limiters[identifier]
-	i.mu.RUnlock()
-
-	if exists {
-		return limiter
+// NewClientIPRateLimitInterceptor creates a rate limiting interceptor that
+// keys every call by client IP alone, whether or not it is authenticated
+func NewClientIPRateLimitInterceptor(limiter *ratelimit.Limiter, policy ratelimit.Policy, metrics *metrics.Metrics, log *slog.Logger) *RateLimitInterceptor {
+	return &RateLimitInterceptor{
+		limiter:       limiter,
+		defaultPolicy: policy,
+		identify:      clientIPIdentifier,
+		bucket:        "client_ip",
+		quiet:         true,
+		metrics:       metrics,
+		log:           log,
 	}
+}
 
-	i.mu.Lock()
-	defer i.mu.Unlock()
-
-	// Double-check after acquiring write lock
-	limiter, exists = i.limiters[identifier]
-	if exists {
-		return limiter
+// rateLimitIdentifier keys API keys separately from their creator, authenticated
+// callers by user ID and anonymous ones by client IP
+func rateLimitIdentifier(ctx context.Context, peerAddr string) string {
+	if keyID, ok := GetAPIKeyID(ctx); ok {
+		return "api_key:" + keyID.String()
+	}
+	if userID, ok := GetUserID(ctx); ok {
+		return "user:" + userID.String()
 	}
+	return clientIPIdentifier(ctx, peerAddr)
+}
 
-	// Create new limiter for this identifier
-	limiter = rate.NewLimiter(i.rps, i.burst)
-	i.limiters[identifier] = limiter
-	return limiter
+// clientIPIdentifier keys callers by client IP only
+func clientIPIdentifier(ctx context.Context, peerAddr string) string {
+	return "ip:" + ClientIP(ctx, peerAddr)
 }
 
 // WrapUnary wraps unary RPCs with rate limiting
 func (i *RateLimitInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		// Try to get user ID from context, fall back to "anonymous" if not authenticated
-		userID, ok := GetUserID(ctx)
-		identifier := "anonymous"
-		if ok {
-			identifier = userID.String()
+		result, policy, err := i.check(ctx, req.Spec().Procedure, req.Peer().Addr)
+		if err != nil {
+			return nil, err
 		}
 
-		// Get limiter for this identifier
-		limiter := i.getLimiter(identifier)
-
-		// Check if request is allowed
-		if !limiter.Allow() {
-			return nil, connect.NewError(connect.CodeResourceExhausted, nil)
+		resp, err := next(ctx, req)
+		if result == nil || i.quiet {
+			return resp, err
 		}
-
-		return next(ctx, req)
+		if err != nil {
+			var connectErr *connect.Error
+			if errors.As(err, &connectErr) {
+				setRateLimitHeaders(connectErr.Meta(), result, policy)
+			}
+			return nil, err
+		}
+		setRateLimitHeaders(resp.Header(), result, policy)
+		return resp, nil
 	}
 }
 
@@ -84,21 +123,59 @@ func (i *RateLimitInterceptor) WrapStreamingClient(next connect.StreamingClientF
 // WrapStreamingHandler wraps streaming handler RPCs with rate limiting
 func (i *RateLimitInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
 	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
-		// Try to get user ID from context, fall back to "anonymous" if not authenticated
-		userID, ok := GetUserID(ctx)
-		identifier := "anonymous"
-		if ok {
-			identifier = userID.String()
+		result, policy, err := i.check(ctx, conn.Spec().Procedure, conn.Peer().Addr)
+		if err != nil {
+			return err
 		}
-
-		// Get limiter for this identifier
-		limiter := i.getLimiter(identifier)
-
-		// Check if request is allowed
-		if !limiter.Allow() {
-			return connect.NewError(connect.CodeResourceExhausted, nil)
+		if result != nil && !i.quiet {
+			setRateLimitHeaders(conn.ResponseHeader(), result, policy)
 		}
 
 		return next(ctx, conn)
 	}
 }
+
+// check counts the request and returns a ResourceExhausted error once the
+// caller is over the limit. A nil result means the limiter was unavailable and
+// the request was let through, since a Redis outage must not take the API down.
+func (i *RateLimitInterceptor) check(ctx context.Context, procedure, peerAddr string) (*ratelimit.Result, ratelimit.Policy, error) {
+	policy, bucket := i.defaultPolicy, i.bucket
+	if procedurePolicy, ok := i.policies[procedure]; ok {
+		policy, bucket = procedurePolicy, procedure
+	}
+
+	key := bucket + ":" + i.identify(ctx, peerAddr)
+	result, err := i.limiter.Allow(ctx, key, policy)
+	if err != nil {
+		i.log.WarnContext(ctx, "rate limiter unavailable", slog.String("procedure", procedure), slog.Any("error", err))
+		return nil, policy, nil
+	}
+	if result.Allowed {
+		return result, policy, nil
+	}
+	i.metrics.RateLimited(bucket)
+
+	connectErr := connect.NewError(connect.CodeResourceExhausted, ErrRateLimited)
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(result.RetryAfter),
+	}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	setRateLimitHeaders(connectErr.Meta(), result, policy)
+	connectErr.Meta().Set("Retry-After", strconv.FormatInt(ceilSeconds(result.RetryAfter), 10))
+	return nil, policy, connectErr
+}
+
+// setRateLimitHeaders sets the RateLimit header fields of the IETF httpapi
+// draft: the burst limit, what is left of it and when it is fully restored
+func setRateLimitHeaders(header http.Header, result *ratelimit.Result, policy ratelimit.Policy) {
+	header.Set("RateLimit-Limit", strconv.Itoa(result.Limit))
+	header.Set("RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	header.Set("RateLimit-Reset", strconv.FormatInt(ceilSeconds(result.ResetAfter), 10))
+	header.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d;burst=%d", policy.Rate, ceilSeconds(policy.Period), policy.Burst))
+}
+
+// ceilSeconds rounds up so clients never retry too early
+func ceilSeconds(d time.Duration) int64 {
+	return int64(math.Ceil(d.Seconds()))
+}
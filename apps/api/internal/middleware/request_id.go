@@ -0,0 +1,31 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// RequestIDHeader identifies a request across logs and audit events
+	RequestIDHeader = "X-Request-Id"
+
+	maxRequestIDLength = 128
+)
+
+// NewRequestIDMiddleware returns a HTTP middleware that keeps the caller's
+// X-Request-Id, or assigns a new one, and echoes it on the response
+func NewRequestIDMiddleware() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(RequestIDHeader)
+			if requestID == "" || len(requestID) > maxRequestIDLength {
+				requestID = uuid.NewString()
+				r.Header.Set(RequestIDHeader, requestID)
+			}
+			w.Header().Set(RequestIDHeader, requestID)
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package middleware
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// revocationCacheTTL bounds how long a revocation can go unnoticed
+	revocationCacheTTL = 5 * time.Second
+	// revocationCacheSize caps the number of tokens remembered per replica
+	revocationCacheSize = 10000
+)
+
+type revocationCacheEntry struct {
+	revoked   bool
+	expiresAt time.Time
+}
+
+// revocationCache remembers recent revocation checks by jti so repeated calls
+// with the same access token don't each need a Redis round-trip
+type revocationCache struct {
+	mu      sync.Mutex
+	entries map[string]revocationCacheEntry
+}
+
+func newRevocationCache() *revocationCache {
+	return &revocationCache{
+		entries: make(map[string]revocationCacheEntry),
+	}
+}
+
+// get returns the cached result for the token, if still fresh
+func (c *revocationCache) get(tokenID string, now time.Time) (revoked bool, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, exists := c.entries[tokenID]
+	if !exists || now.After(entry.expiresAt) {
+		return false, false
+	}
+	return entry.revoked, true
+}
+
+// set caches the result for the token; a revoked token stays revoked, so that
+// result is kept until the token itself expires
+func (c *revocationCache) set(tokenID string, revoked bool, tokenExpiresAt, now time.Time) {
+	expiresAt := now.Add(revocationCacheTTL)
+	if revoked || tokenExpiresAt.Before(expiresAt) {
+		expiresAt = tokenExpiresAt
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= revocationCacheSize {
+		c.evictExpired(now)
+		if len(c.entries) >= revocationCacheSize {
+			c.entries = make(map[string]revocationCacheEntry)
+		}
+	}
+	c.entries[tokenID] = revocationCacheEntry{revoked: revoked, expiresAt: expiresAt}
+}
+
+func (c *revocationCache) evictExpired(now time.Time) {
+	for tokenID, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, tokenID)
+		}
+	}
+}
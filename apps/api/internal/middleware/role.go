@@ -2,72 +2,51 @@ package middleware
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 )
 
-// RoleInterceptor is a Connect interceptor that checks user roles
-type RoleInterceptor struct {
-	allowedRoles []string
-}
+var (
+	ErrInsufficientRole = errors.New("insufficient role")
+	ErrRoleNotActive    = errors.New("switch to a role allowed here with SwitchRole")
+)
 
-// NewRoleInterceptor creates a new role checking interceptor
-// allowedRoles: list of roles that are allowed to access the endpoint (e.g., ["CHEF", "RESTAURANT"])
-func NewRoleInterceptor(allowedRoles ...string) *RoleInterceptor {
-	return &RoleInterceptor{
-		allowedRoles: allowedRoles,
+// checkRole checks that the caller's active role is one of allowedRoles
+//
+// Only the active role of the session counts. Users who hold an allowed role
+// without having it active get ErrRoleNotActive so clients can offer to switch.
+func checkRole(ctx context.Context, allowedRoles ...string) error {
+	role, ok := GetUserRole(ctx)
+	if !ok {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("missing role context"))
 	}
-}
-
-// WrapUnary wraps unary RPCs with role checking
-func (i *RoleInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
-	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		// Get user role from context
-		role, ok := GetUserRole(ctx)
-		if !ok {
-			return nil, connect.NewError(connect.CodeUnauthenticated, nil)
-		}
-
-		// Check if user has the required role
-		if !i.hasRole(role) {
-			return nil, connect.NewError(connect.CodePermissionDenied, nil)
-		}
-
-		return next(ctx, req)
+	if slices.Contains(allowedRoles, role) {
+		return nil
 	}
-}
 
-// WrapStreamingClient wraps streaming client RPCs with role checking
-func (i *RoleInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
-	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
-		return next(ctx, spec)
-	}
-}
-
-// WrapStreamingHandler wraps streaming handler RPCs with role checking
-func (i *RoleInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
-	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
-		// Get user role from context
-		role, ok := GetUserRole(ctx)
-		if !ok {
-			return connect.NewError(connect.CodeUnauthenticated, nil)
-		}
-
-		// Check if user has the required role
-		if !i.hasRole(role) {
-			return connect.NewError(connect.CodePermissionDenied, nil)
+	roles, _ := GetUserRoles(ctx)
+	for _, granted := range roles {
+		if slices.Contains(allowedRoles, granted) {
+			return roleNotActiveError(granted)
 		}
-
-		return next(ctx, conn)
 	}
+
+	return connect.NewError(connect.CodePermissionDenied, ErrInsufficientRole)
 }
 
-// hasRole checks if the user's role is in the allowed roles list
-func (i *RoleInterceptor) hasRole(userRole string) bool {
-	for _, allowedRole := range i.allowedRoles {
-		if userRole == allowedRole {
-			return true
-		}
+// roleNotActiveError names a granted role that would be allowed, so clients
+// can switch to it instead of showing a dead end
+func roleNotActiveError(role string) error {
+	connectErr := connect.NewError(connect.CodePermissionDenied, ErrRoleNotActive)
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.ErrorInfo{
+		Reason:   "ROLE_NOT_ACTIVE",
+		Domain:   "chefnext.identity",
+		Metadata: map[string]string{"role": role},
+	}); detailErr == nil {
+		connectErr.AddDetail(detail)
 	}
-	return false
+	return connectErr
 }
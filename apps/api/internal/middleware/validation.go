@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"github.com/bufbuild/protovalidate-go"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+)
+
+// ValidationInterceptor is a Connect interceptor that enforces the
+// buf.validate constraints declared on request messages
+//
+// Violations are returned as InvalidArgument with a BadRequest detail listing
+// each invalid field, so clients can show the message next to the input.
+type ValidationInterceptor struct {
+	validator *protovalidate.Validator
+}
+
+// NewValidationInterceptor creates a new request validation interceptor
+func NewValidationInterceptor() (*ValidationInterceptor, error) {
+	validator, err := protovalidate.New()
+	if err != nil {
+		return nil, err
+	}
+	return &ValidationInterceptor{validator: validator}, nil
+}
+
+// WrapUnary wraps unary RPCs with request validation
+func (i *ValidationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.validate(req.Any()); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+// WrapStreamingClient wraps streaming client RPCs with request validation
+func (i *ValidationInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		return next(ctx, spec)
+	}
+}
+
+// WrapStreamingHandler wraps streaming handler RPCs with request validation
+func (i *ValidationInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &validatingHandlerConn{StreamingHandlerConn: conn, interceptor: i})
+	}
+}
+
+func (i *ValidationInterceptor) validate(msg any) error {
+	message, ok := msg.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	err := i.validator.Validate(message)
+	if err == nil {
+		return nil
+	}
+
+	var validationErr *protovalidate.ValidationError
+	if !errors.As(err, &validationErr) {
+		return connect.NewError(connect.CodeInternal, err)
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(validationErr.Violations))
+	for _, violation := range validationErr.Violations {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       violation.GetFieldPath(),
+			Description: violation.GetMessage(),
+		})
+	}
+	return NewFieldViolationError(err, violations...)
+}
+
+// NewFieldViolationError builds an InvalidArgument error carrying a
+// BadRequest detail with the given field violations
+func NewFieldViolationError(err error, violations ...*errdetails.BadRequest_FieldViolation) *connect.Error {
+	connectErr := connect.NewError(connect.CodeInvalidArgument, err)
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.BadRequest{
+		FieldViolations: violations,
+	}); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// NewFieldError is NewFieldViolationError for a single invalid field
+func NewFieldError(field string, err error) *connect.Error {
+	return NewFieldViolationError(err, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: err.Error(),
+	})
+}
+
+type validatingHandlerConn struct {
+	connect.StreamingHandlerConn
+	interceptor *ValidationInterceptor
+}
+
+func (c *validatingHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	return c.interceptor.validate(msg)
+}
@@ -0,0 +1,111 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+)
+
+// Target types of audit events
+const (
+	TargetUser              = "user"
+	TargetChefProfile       = "chef_profile"
+	TargetRestaurantProfile = "restaurant_profile"
+	TargetJob               = "job"
+	TargetApplication       = "application"
+	TargetKYCSubmission     = "kyc_submission"
+)
+
+// Request describes the RPC a change is made in. It is stored in the context
+// by the audit interceptor so use cases can attribute their changes without
+// depending on the transport
+type Request struct {
+	ActorUserID uuid.UUID
+	ActorRole   string
+	APIKeyID    uuid.UUID
+	Procedure   string
+	IPAddress   string
+	RequestID   string
+}
+
+type requestKey struct{}
+
+// WithRequest stores the request metadata in context
+func WithRequest(ctx context.Context, request Request) context.Context {
+	return context.WithValue(ctx, requestKey{}, request)
+}
+
+// RequestFromContext retrieves the request metadata from context
+func RequestFromContext(ctx context.Context) (Request, bool) {
+	request, ok := ctx.Value(requestKey{}).(Request)
+	return request, ok
+}
+
+// Event describes a change to a resource
+type Event struct {
+	// Action names the change, e.g. "job_updated"
+	Action     string
+	TargetType string
+	TargetID   uuid.UUID
+	// RestaurantID is the restaurant owning the target, if any
+	RestaurantID uuid.UUID
+	// ActorUserID attributes the change to a user when the request is not
+	// authenticated as them, e.g. on registration or password reset
+	ActorUserID uuid.UUID
+	// Before and After are JSON-encodable snapshots of the target; nil when it
+	// did not exist before or does not exist after the change
+	Before any
+	After  any
+}
+
+// Recorder persists audit events
+//
+// Callers pass queries bound to the transaction of the change so that the
+// change and its event commit or roll back together.
+type Recorder interface {
+	Record(ctx context.Context, queries *db.Queries, event Event) error
+}
+
+// Changes is the before/after diff of an event
+type Changes struct {
+	Before map[string]json.RawMessage `json:"before,omitempty"`
+	After  map[string]json.RawMessage `json:"after,omitempty"`
+}
+
+// Diff reduces two snapshots to the top-level fields that differ
+func Diff(before, after any) (Changes, error) {
+	beforeFields, err := snapshotFields(before)
+	if err != nil {
+		return Changes{}, err
+	}
+	afterFields, err := snapshotFields(after)
+	if err != nil {
+		return Changes{}, err
+	}
+
+	for key, value := range beforeFields {
+		if other, ok := afterFields[key]; ok && bytes.Equal(value, other) {
+			delete(beforeFields, key)
+			delete(afterFields, key)
+		}
+	}
+	return Changes{Before: beforeFields, After: afterFields}, nil
+}
+
+func snapshotFields(snapshot any) (map[string]json.RawMessage, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
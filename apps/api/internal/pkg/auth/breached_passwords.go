@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"bufio"
+	"crypto/sha1"
+	_ "embed"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const breachedPrefixLength = 5
+
+//go:embed data/breached_passwords.txt
+var defaultBreachedPasswords string
+
+// BreachedPasswords is an offline set of compromised password hashes laid out
+// like the Have I Been Pwned range API: SHA-1 hashes are grouped by their
+// five character prefix, so a lookup only ever touches one small range and the
+// dataset never holds plaintext passwords
+type BreachedPasswords struct {
+	ranges map[string][]string
+	size   int
+}
+
+// DefaultBreachedPasswords loads the dataset shipped with the service
+func DefaultBreachedPasswords() (*BreachedPasswords, error) {
+	return LoadBreachedPasswords(strings.NewReader(defaultBreachedPasswords))
+}
+
+// LoadBreachedPasswords reads a hash-prefix file with one "PREFIX:SUFFIX" line
+// per uppercase hex SHA-1 hash; an optional ":count" column and lines starting
+// with # are ignored
+func LoadBreachedPasswords(r io.Reader) (*BreachedPasswords, error) {
+	set := &BreachedPasswords{ranges: make(map[string][]string)}
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Split(strings.ToUpper(text), ":")
+		if len(fields) < 2 || len(fields[0]) != breachedPrefixLength || len(fields[0])+len(fields[1]) != sha1.Size*2 {
+			return nil, fmt.Errorf("breached passwords line %d: expected PREFIX:SUFFIX", line)
+		}
+		if _, err := hex.DecodeString(fields[0] + fields[1]); err != nil {
+			return nil, fmt.Errorf("breached passwords line %d: %w", line, err)
+		}
+
+		set.ranges[fields[0]] = append(set.ranges[fields[0]], fields[1])
+		set.size++
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, suffixes := range set.ranges {
+		sort.Strings(suffixes)
+	}
+	return set, nil
+}
+
+// Len returns the number of hashes in the set
+func (b *BreachedPasswords) Len() int {
+	return b.size
+}
+
+// Range returns the sorted hash suffixes that share the given prefix
+func (b *BreachedPasswords) Range(prefix string) []string {
+	return b.ranges[strings.ToUpper(prefix)]
+}
+
+// Contains reports whether the password appears in the dataset
+func (b *BreachedPasswords) Contains(password string) bool {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	suffixes := b.Range(hash[:breachedPrefixLength])
+	suffix := hash[breachedPrefixLength:]
+	i := sort.SearchStrings(suffixes, suffix)
+	return i < len(suffixes) && suffixes[i] == suffix
+}
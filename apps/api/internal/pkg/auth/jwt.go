@@ -1,7 +1,11 @@
 package auth
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -25,55 +29,141 @@ const (
 type Claims struct {
 	UserID uuid.UUID `json:"user_id"`
 	Email  string    `json:"email"`
-	Role   string    `json:"role"`
-	Type   TokenType `json:"type"`
+	// Role is the active role the token acts as
+	Role string `json:"role"`
+	// Roles lists every role granted to the user; tokens issued before users
+	// could hold several roles only carry Role
+	Roles     []string  `json:"roles,omitempty"`
+	Type      TokenType `json:"type"`
+	SessionID string    `json:"sid,omitempty"`
+	// MFA is set when the session was established with a second factor
+	MFA bool `json:"mfa,omitempty"`
+	// IssuedAt replaces RegisteredClaims.IssuedAt so iat keeps microseconds
+	IssuedAt *MicroNumericDate `json:"iat,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// GetIssuedAt implements jwt.Claims with the microsecond issued-at time
+func (c Claims) GetIssuedAt() (*jwt.NumericDate, error) {
+	if c.IssuedAt == nil {
+		return nil, nil
+	}
+	return jwt.NewNumericDate(c.IssuedAt.Time), nil
+}
+
+// MicroNumericDate is a JWT NumericDate encoded with microsecond precision
+//
+// A per-user revocation cutoff has to tell apart tokens issued in the same
+// second as it, which whole seconds cannot. jwt.TimePrecision would do the
+// same but changes every other user of the package in the process.
+type MicroNumericDate struct {
+	time.Time
+}
+
+// NewMicroNumericDate truncates t to the microsecond
+func NewMicroNumericDate(t time.Time) *MicroNumericDate {
+	return &MicroNumericDate{Time: t.Truncate(time.Microsecond)}
+}
+
+// MarshalJSON encodes the date as seconds with six decimal places
+func (d MicroNumericDate) MarshalJSON() ([]byte, error) {
+	t := d.Truncate(time.Microsecond)
+	return fmt.Appendf(nil, "%d.%06d", t.Unix(), t.Nanosecond()/int(time.Microsecond)), nil
+}
+
+// UnmarshalJSON decodes seconds with an optional fraction without going
+// through float64, which cannot hold microseconds at today's timestamps
+func (d *MicroNumericDate) UnmarshalJSON(b []byte) error {
+	var number json.Number
+	if err := json.Unmarshal(b, &number); err != nil {
+		return ErrInvalidToken
+	}
+
+	whole, fraction, _ := strings.Cut(number.String(), ".")
+	seconds, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	var nanos int64
+	if fraction != "" {
+		fraction = (fraction + "000000000")[:9]
+		if nanos, err = strconv.ParseInt(fraction, 10, 64); err != nil {
+			return ErrInvalidToken
+		}
+	}
+
+	d.Time = time.Unix(seconds, nanos).Truncate(time.Microsecond)
+	return nil
+}
+
+// TokenSubject identifies who a token is issued to
+type TokenSubject struct {
+	UserID    uuid.UUID
+	Email     string
+	Role      string
+	Roles     []string
+	SessionID string
+	MFA       bool
+}
+
 // JWTManager handles JWT token operations
+//
+// Tokens are signed with EdDSA using the active key of the key manager and
+// carry its kid, so any service holding the JWKS can verify them.
 type JWTManager struct {
-	secretKey             string
-	accessTokenDuration   time.Duration
-	refreshTokenDuration  time.Duration
+	keys                 *KeyManager
+	accessTokenDuration  time.Duration
+	refreshTokenDuration time.Duration
 }
 
 // NewJWTManager creates a new JWT manager
-func NewJWTManager(secretKey string, accessTokenDuration, refreshTokenDuration time.Duration) *JWTManager {
+func NewJWTManager(keys *KeyManager, accessTokenDuration, refreshTokenDuration time.Duration) *JWTManager {
 	return &JWTManager{
-		secretKey:            secretKey,
+		keys:                 keys,
 		accessTokenDuration:  accessTokenDuration,
 		refreshTokenDuration: refreshTokenDuration,
 	}
 }
 
 // GenerateAccessToken generates a new access token
-func (m *JWTManager) GenerateAccessToken(userID uuid.UUID, email, role string) (string, error) {
-	return m.generateToken(userID, email, role, AccessToken, m.accessTokenDuration)
+func (m *JWTManager) GenerateAccessToken(subject TokenSubject) (string, error) {
+	return m.generateToken(subject, AccessToken, m.accessTokenDuration)
 }
 
 // GenerateRefreshToken generates a new refresh token
-func (m *JWTManager) GenerateRefreshToken(userID uuid.UUID, email, role string) (string, error) {
-	return m.generateToken(userID, email, role, RefreshToken, m.refreshTokenDuration)
+func (m *JWTManager) GenerateRefreshToken(subject TokenSubject) (string, error) {
+	return m.generateToken(subject, RefreshToken, m.refreshTokenDuration)
 }
 
 // generateToken generates a JWT token
-func (m *JWTManager) generateToken(userID uuid.UUID, email, role string, tokenType TokenType, duration time.Duration) (string, error) {
+func (m *JWTManager) generateToken(subject TokenSubject, tokenType TokenType, duration time.Duration) (string, error) {
 	now := time.Now()
 	claims := &Claims{
-		UserID: userID,
-		Email:  email,
-		Role:   role,
-		Type:   tokenType,
+		UserID:    subject.UserID,
+		Email:     subject.Email,
+		Role:      subject.Role,
+		Roles:     subject.Roles,
+		Type:      tokenType,
+		SessionID: subject.SessionID,
+		MFA:       subject.MFA,
+		IssuedAt:  NewMicroNumericDate(now),
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(duration)),
-			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    "chefnext",
+			ID:        uuid.NewString(),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(m.secretKey))
+	key, err := m.keys.SigningKey()
+	if err != nil {
+		return "", err
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.PrivateKey)
 }
 
 // VerifyToken verifies and parses a JWT token
@@ -83,10 +173,14 @@ func (m *JWTManager) VerifyToken(tokenString string) (*Claims, error) {
 		&Claims{},
 		func(token *jwt.Token) (interface{}, error) {
 			// Verify signing method
-			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			if _, ok := token.Method.(*jwt.SigningMethodEd25519); !ok {
 				return nil, ErrInvalidToken
 			}
-			return []byte(m.secretKey), nil
+			kid, ok := token.Header["kid"].(string)
+			if !ok {
+				return nil, ErrInvalidToken
+			}
+			return m.keys.VerificationKey(kid)
 		},
 	)
 
@@ -95,7 +189,7 @@ func (m *JWTManager) VerifyToken(tokenString string) (*Claims, error) {
 	}
 
 	claims, ok := token.Claims.(*Claims)
-	if !ok || !token.Valid {
+	if !ok || !token.Valid || claims.ID == "" {
 		return nil, ErrInvalidToken
 	}
 
@@ -134,3 +228,23 @@ func (m *JWTManager) VerifyRefreshToken(tokenString string) (*Claims, error) {
 
 	return claims, nil
 }
+
+// TokenSubject returns the subject the token was issued to
+func (c *Claims) TokenSubject() TokenSubject {
+	return TokenSubject{
+		UserID:    c.UserID,
+		Email:     c.Email,
+		Role:      c.Role,
+		Roles:     c.GrantedRoles(),
+		SessionID: c.SessionID,
+		MFA:       c.MFA,
+	}
+}
+
+// GrantedRoles returns every role granted to the user
+func (c *Claims) GrantedRoles() []string {
+	if len(c.Roles) == 0 {
+		return []string{c.Role}
+	}
+	return c.Roles
+}
@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	signingKeysKey  = "jwt_signing_keys"
+	rotationLockKey = "jwt_signing_keys:rotation_lock"
+)
+
+var (
+	ErrInvalidKeyMaterial = errors.New("invalid signing key material")
+)
+
+// storedSigningKey is the JSON form of a signing key kept in Redis
+type storedSigningKey struct {
+	ID            string    `json:"kid"`
+	EncryptedSeed []byte    `json:"encrypted_seed"`
+	CreatedAt     time.Time `json:"created_at"`
+	ActivatesAt   time.Time `json:"activates_at"`
+}
+
+// KeyStore persists signing keys in Redis so every API replica signs and
+// verifies with the same key set. Private keys are encrypted at rest with a
+// key derived from the encryption key.
+type KeyStore struct {
+	client *redis.Client
+	box    *SecretBox
+}
+
+// NewKeyStore creates a new key store
+func NewKeyStore(client *redis.Client, box *SecretBox) *KeyStore {
+	return &KeyStore{
+		client: client,
+		box:    box,
+	}
+}
+
+// Load returns every stored signing key
+func (s *KeyStore) Load(ctx context.Context) ([]*SigningKey, error) {
+	values, err := s.client.HGetAll(ctx, signingKeysKey).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*SigningKey, 0, len(values))
+	for _, value := range values {
+		var stored storedSigningKey
+		if err := json.Unmarshal([]byte(value), &stored); err != nil {
+			return nil, err
+		}
+
+		// The key ID is bound as associated data so seeds cannot be swapped
+		seed, err := s.box.Open(stored.EncryptedSeed, []byte(stored.ID))
+		if err != nil || len(seed) != ed25519.SeedSize {
+			return nil, ErrInvalidKeyMaterial
+		}
+
+		keys = append(keys, &SigningKey{
+			ID:          stored.ID,
+			PrivateKey:  ed25519.NewKeyFromSeed(seed),
+			CreatedAt:   stored.CreatedAt,
+			ActivatesAt: stored.ActivatesAt,
+		})
+	}
+
+	return keys, nil
+}
+
+// Save stores a signing key
+func (s *KeyStore) Save(ctx context.Context, key *SigningKey) error {
+	encrypted, err := s.box.Seal(key.PrivateKey.Seed(), []byte(key.ID))
+	if err != nil {
+		return err
+	}
+
+	value, err := json.Marshal(storedSigningKey{
+		ID:            key.ID,
+		EncryptedSeed: encrypted,
+		CreatedAt:     key.CreatedAt,
+		ActivatesAt:   key.ActivatesAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.client.HSet(ctx, signingKeysKey, key.ID, value).Err()
+}
+
+// Delete removes signing keys that are no longer needed for verification
+func (s *KeyStore) Delete(ctx context.Context, keyIDs ...string) error {
+	if len(keyIDs) == 0 {
+		return nil
+	}
+	return s.client.HDel(ctx, signingKeysKey, keyIDs...).Err()
+}
+
+// AcquireRotationLock ensures only one replica rotates keys at a time
+func (s *KeyStore) AcquireRotationLock(ctx context.Context, ttl time.Duration) (bool, error) {
+	return s.client.SetNX(ctx, rotationLockKey, "1", ttl).Result()
+}
+
+// ReleaseRotationLock releases the rotation lock
+func (s *KeyStore) ReleaseRotationLock(ctx context.Context) error {
+	return s.client.Del(ctx, rotationLockKey).Err()
+}
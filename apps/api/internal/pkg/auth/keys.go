@@ -0,0 +1,317 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"log/slog"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	rotationLockTTL   = 30 * time.Second
+	keyReloadInterval = time.Minute
+)
+
+var (
+	ErrNoSigningKey = errors.New("no active signing key")
+	ErrUnknownKeyID = errors.New("unknown signing key id")
+)
+
+// SigningKey is an Ed25519 key pair identified by its kid
+type SigningKey struct {
+	ID          string
+	PrivateKey  ed25519.PrivateKey
+	CreatedAt   time.Time
+	ActivatesAt time.Time
+}
+
+// PublicKey returns the verification half of the key
+func (k *SigningKey) PublicKey() ed25519.PublicKey {
+	return k.PrivateKey.Public().(ed25519.PublicKey)
+}
+
+// JWK is the public JSON Web Key form of a signing key
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	X         string `json:"x"`
+}
+
+// JWKS is a JSON Web Key Set
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// KeyManager keeps the set of JWT signing keys in memory and rotates them
+//
+// A new key is published prepublish before it starts signing so verifiers that
+// cache the JWKS already know it, and a retired key is kept for retention after
+// it stops signing so tokens it issued stay verifiable until they expire.
+type KeyManager struct {
+	store            *KeyStore
+	rotationInterval time.Duration
+	prepublish       time.Duration
+	retention        time.Duration
+	log              *slog.Logger
+
+	mu   sync.RWMutex
+	keys []*SigningKey
+}
+
+// NewKeyManager creates a new key manager
+func NewKeyManager(store *KeyStore, rotationInterval, prepublish, retention time.Duration, log *slog.Logger) *KeyManager {
+	return &KeyManager{
+		store:            store,
+		rotationInterval: rotationInterval,
+		prepublish:       prepublish,
+		retention:        retention,
+		log:              log,
+	}
+}
+
+// Init loads the key set, creating the first key when none exists yet
+func (m *KeyManager) Init(ctx context.Context) error {
+	if err := m.reload(ctx); err != nil {
+		return err
+	}
+	if m.hasActiveKey(time.Now()) {
+		return nil
+	}
+
+	locked, err := m.store.AcquireRotationLock(ctx, rotationLockTTL)
+	if err != nil {
+		return err
+	}
+	if !locked {
+		// Another replica is creating the first key; wait for it to appear
+		return m.waitForActiveKey(ctx)
+	}
+	defer m.store.ReleaseRotationLock(context.WithoutCancel(ctx))
+
+	if err := m.reload(ctx); err != nil {
+		return err
+	}
+	if m.hasActiveKey(time.Now()) {
+		return nil
+	}
+
+	key, err := generateSigningKey(time.Now())
+	if err != nil {
+		return err
+	}
+	if err := m.store.Save(ctx, key); err != nil {
+		return err
+	}
+	return m.reload(ctx)
+}
+
+// Run periodically reloads the key set and rotates keys until ctx is done
+func (m *KeyManager) Run(ctx context.Context) {
+	ticker := time.NewTicker(keyReloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.rotate(ctx); err != nil {
+				m.log.Error("signing key rotation failed", "error", err)
+			}
+		}
+	}
+}
+
+// SigningKey returns the newest key that has become active
+func (m *KeyManager) SigningKey() (*SigningKey, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	key := activeKey(m.keys, time.Now())
+	if key == nil {
+		return nil, ErrNoSigningKey
+	}
+	return key, nil
+}
+
+// VerificationKey returns the public key for kid
+func (m *KeyManager) VerificationKey(kid string) (ed25519.PublicKey, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, key := range m.keys {
+		if key.ID == kid {
+			return key.PublicKey(), nil
+		}
+	}
+	return nil, ErrUnknownKeyID
+}
+
+// JWKS returns every published key, including ones not yet signing
+func (m *KeyManager) JWKS() JWKS {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	set := JWKS{Keys: make([]JWK, 0, len(m.keys))}
+	for _, key := range m.keys {
+		set.Keys = append(set.Keys, JWK{
+			KeyType:   "OKP",
+			Curve:     "Ed25519",
+			KeyID:     key.ID,
+			Use:       "sig",
+			Algorithm: "EdDSA",
+			X:         base64.RawURLEncoding.EncodeToString(key.PublicKey()),
+		})
+	}
+	return set
+}
+
+// rotate publishes the next key when the active one is due to retire and
+// prunes keys whose tokens have all expired
+func (m *KeyManager) rotate(ctx context.Context) error {
+	if err := m.reload(ctx); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	due, expired := m.plan(now)
+	if !due && len(expired) == 0 {
+		return nil
+	}
+
+	locked, err := m.store.AcquireRotationLock(ctx, rotationLockTTL)
+	if err != nil || !locked {
+		return err
+	}
+	defer m.store.ReleaseRotationLock(context.WithoutCancel(ctx))
+
+	// Re-plan against the latest state now that we hold the lock
+	if err := m.reload(ctx); err != nil {
+		return err
+	}
+	due, expired = m.plan(now)
+
+	if due {
+		next, err := generateSigningKey(now.Add(m.prepublish))
+		if err != nil {
+			return err
+		}
+		if err := m.store.Save(ctx, next); err != nil {
+			return err
+		}
+		m.log.Info("published new signing key", "kid", next.ID, "activates_at", next.ActivatesAt)
+	}
+	if len(expired) > 0 {
+		if err := m.store.Delete(ctx, expired...); err != nil {
+			return err
+		}
+		m.log.Info("removed retired signing keys", "kids", expired)
+	}
+
+	return m.reload(ctx)
+}
+
+// plan reports whether a new key is due and which keys can be dropped at now
+func (m *KeyManager) plan(now time.Time) (bool, []string) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	current := activeKey(m.keys, now)
+	pending := false
+	for _, key := range m.keys {
+		if key.ActivatesAt.After(now) {
+			pending = true
+		}
+	}
+	due := current != nil && !pending && !now.Before(current.ActivatesAt.Add(m.rotationInterval-m.prepublish))
+
+	// A key is retired once a newer key took over; drop it after retention
+	var expired []string
+	for i, key := range m.keys {
+		if i+1 >= len(m.keys) {
+			break
+		}
+		successor := m.keys[i+1]
+		if key != current && !successor.ActivatesAt.After(now) && now.Sub(successor.ActivatesAt) > m.retention {
+			expired = append(expired, key.ID)
+		}
+	}
+
+	return due, expired
+}
+
+func (m *KeyManager) reload(ctx context.Context) error {
+	keys, err := m.store.Load(ctx)
+	if err != nil {
+		return err
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].ActivatesAt.Before(keys[j].ActivatesAt)
+	})
+
+	m.mu.Lock()
+	m.keys = keys
+	m.mu.Unlock()
+	return nil
+}
+
+func (m *KeyManager) hasActiveKey(now time.Time) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return activeKey(m.keys, now) != nil
+}
+
+func (m *KeyManager) waitForActiveKey(ctx context.Context) error {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	timeout := time.After(rotationLockTTL)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout:
+			return ErrNoSigningKey
+		case <-ticker.C:
+			if err := m.reload(ctx); err != nil {
+				return err
+			}
+			if m.hasActiveKey(time.Now()) {
+				return nil
+			}
+		}
+	}
+}
+
+// activeKey returns the newest key active at now from keys sorted by activation
+func activeKey(keys []*SigningKey, now time.Time) *SigningKey {
+	for i := len(keys) - 1; i >= 0; i-- {
+		if !keys[i].ActivatesAt.After(now) {
+			return keys[i]
+		}
+	}
+	return nil
+}
+
+func generateSigningKey(activatesAt time.Time) (*SigningKey, error) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return &SigningKey{
+		ID:          uuid.NewString(),
+		PrivateKey:  privateKey,
+		CreatedAt:   time.Now(),
+		ActivatesAt: activatesAt,
+	}, nil
+}
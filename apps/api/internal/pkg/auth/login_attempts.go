@@ -0,0 +1,157 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	ErrTooManyLoginAttempts = errors.New("too many failed login attempts")
+)
+
+// LoginLockedError reports a temporary lockout and when login may be retried
+type LoginLockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginLockedError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrTooManyLoginAttempts, e.RetryAfter.Round(time.Second))
+}
+
+func (e *LoginLockedError) Unwrap() error {
+	return ErrTooManyLoginAttempts
+}
+
+// LoginAttemptPolicy controls when repeated failures lock a login key
+//
+// Once Threshold failures accumulate within Window, every further failure
+// locks the key for BaseLockout doubled per extra failure, capped at MaxLockout.
+type LoginAttemptPolicy struct {
+	Threshold   int64
+	Window      time.Duration
+	BaseLockout time.Duration
+	MaxLockout  time.Duration
+}
+
+// lockoutFor returns how long the key is locked after failures attempts
+func (p LoginAttemptPolicy) lockoutFor(failures int64) time.Duration {
+	if failures < p.Threshold {
+		return 0
+	}
+
+	lockout := p.BaseLockout
+	for i := p.Threshold; i < failures && lockout < p.MaxLockout; i++ {
+		lockout *= 2
+	}
+	return min(lockout, p.MaxLockout)
+}
+
+// LoginAttemptLimiter counts failed logins per email and per client IP in Redis
+//
+// The email counter protects a single account from password guessing, while
+// the IP counter slows down credential stuffing across many accounts. The IP
+// must be the one resolved by the client IP middleware, which ignores
+// forwarding headers from untrusted peers, or it can be rotated at will.
+type LoginAttemptLimiter struct {
+	client      *redis.Client
+	emailPolicy LoginAttemptPolicy
+	ipPolicy    LoginAttemptPolicy
+}
+
+// NewLoginAttemptLimiter creates a new login attempt limiter
+func NewLoginAttemptLimiter(client *redis.Client, emailPolicy, ipPolicy LoginAttemptPolicy) *LoginAttemptLimiter {
+	return &LoginAttemptLimiter{
+		client:      client,
+		emailPolicy: emailPolicy,
+		ipPolicy:    ipPolicy,
+	}
+}
+
+// Check returns a LoginLockedError if the email or IP is currently locked
+func (l *LoginAttemptLimiter) Check(ctx context.Context, email, ipAddress string) error {
+	pipe := l.client.Pipeline()
+	emailTTL := pipe.PTTL(ctx, l.lockKey("email", normalizeEmail(email)))
+	var ipTTL *redis.DurationCmd
+	if ipAddress != "" {
+		ipTTL = pipe.PTTL(ctx, l.lockKey("ip", ipAddress))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return err
+	}
+
+	retryAfter := max(emailTTL.Val(), 0)
+	if ipTTL != nil {
+		retryAfter = max(retryAfter, ipTTL.Val())
+	}
+	if retryAfter > 0 {
+		return &LoginLockedError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// RecordFailure counts a failed attempt and returns a LoginLockedError when it
+// triggers a lockout
+func (l *LoginAttemptLimiter) RecordFailure(ctx context.Context, email, ipAddress string) error {
+	retryAfter, err := l.recordFailure(ctx, "email", normalizeEmail(email), l.emailPolicy)
+	if err != nil {
+		return err
+	}
+
+	if ipAddress != "" {
+		ipRetryAfter, err := l.recordFailure(ctx, "ip", ipAddress, l.ipPolicy)
+		if err != nil {
+			return err
+		}
+		retryAfter = max(retryAfter, ipRetryAfter)
+	}
+
+	if retryAfter > 0 {
+		return &LoginLockedError{RetryAfter: retryAfter}
+	}
+	return nil
+}
+
+// Reset clears the failure count for the email after a successful login; the IP
+// counter is left alone so one valid account cannot unlock a stuffing source
+func (l *LoginAttemptLimiter) Reset(ctx context.Context, email string) error {
+	email = normalizeEmail(email)
+	return l.client.Del(ctx, l.failuresKey("email", email), l.lockKey("email", email)).Err()
+}
+
+func (l *LoginAttemptLimiter) recordFailure(ctx context.Context, scope, value string, policy LoginAttemptPolicy) (time.Duration, error) {
+	failuresKey := l.failuresKey(scope, value)
+
+	pipe := l.client.TxPipeline()
+	incr := pipe.Incr(ctx, failuresKey)
+	pipe.Expire(ctx, failuresKey, policy.Window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	lockout := policy.lockoutFor(incr.Val())
+	if lockout == 0 {
+		return 0, nil
+	}
+
+	if err := l.client.Set(ctx, l.lockKey(scope, value), incr.Val(), lockout).Err(); err != nil {
+		return 0, err
+	}
+	return lockout, nil
+}
+
+func (l *LoginAttemptLimiter) failuresKey(scope, value string) string {
+	return fmt.Sprintf("login_failures:%s:%s", scope, value)
+}
+
+func (l *LoginAttemptLimiter) lockKey(scope, value string) string {
+	return fmt.Sprintf("login_lockout:%s:%s", scope, value)
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,26 @@
+package auth
+
+// MFAPolicy decides which roles must sign in with a second factor
+type MFAPolicy struct {
+	requiredRoles map[string]bool
+}
+
+// NewMFAPolicy creates a policy requiring MFA for the given roles
+func NewMFAPolicy(requiredRoles ...string) *MFAPolicy {
+	roles := make(map[string]bool, len(requiredRoles))
+	for _, role := range requiredRoles {
+		roles[role] = true
+	}
+	return &MFAPolicy{requiredRoles: roles}
+}
+
+// Requires reports whether users holding any of roles must use MFA; it looks
+// at every granted role since a session can switch to any of them
+func (p *MFAPolicy) Requires(roles ...string) bool {
+	for _, role := range roles {
+		if p.requiredRoles[role] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,195 @@
+package auth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	ErrInvalidOneTimeToken  = errors.New("invalid or expired one-time token")
+	ErrTokenBindingMismatch = errors.New("one-time token was issued to another device")
+)
+
+// OneTimeTokenPurpose scopes a one-time token to a single flow
+type OneTimeTokenPurpose string
+
+const (
+	EmailVerificationPurpose OneTimeTokenPurpose = "email_verification"
+	PasswordResetPurpose     OneTimeTokenPurpose = "password_reset"
+	MFAChallengePurpose      OneTimeTokenPurpose = "mfa_challenge"
+	MagicLinkPurpose         OneTimeTokenPurpose = "magic_link"
+	AccountDeletionPurpose   OneTimeTokenPurpose = "account_deletion"
+)
+
+// OneTimeTokenStore issues signed, single-use tokens backed by Redis
+type OneTimeTokenStore struct {
+	client    *redis.Client
+	secretKey []byte
+}
+
+// NewOneTimeTokenStore creates a new one-time token store
+func NewOneTimeTokenStore(client *redis.Client, secretKey []byte) *OneTimeTokenStore {
+	return &OneTimeTokenStore{
+		client:    client,
+		secretKey: secretKey,
+	}
+}
+
+// Issue creates a token for the user that can be consumed exactly once before ttl elapses
+func (s *OneTimeTokenStore) Issue(ctx context.Context, purpose OneTimeTokenPurpose, userID uuid.UUID, ttl time.Duration) (string, error) {
+	return s.issue(ctx, purpose, userID.String(), ttl)
+}
+
+// IssueBound creates a token that can only be consumed together with binding,
+// a secret kept by the device that asked for the token, so a forwarded or
+// intercepted link is useless anywhere else
+func (s *OneTimeTokenStore) IssueBound(ctx context.Context, purpose OneTimeTokenPurpose, userID uuid.UUID, binding string, ttl time.Duration) (string, error) {
+	return s.issue(ctx, purpose, userID.String()+"|"+hashBinding(binding), ttl)
+}
+
+// NewTokenBinding returns a random secret to bind a token to a device
+func NewTokenBinding() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func (s *OneTimeTokenStore) issue(ctx context.Context, purpose OneTimeTokenPurpose, value string, ttl time.Duration) (string, error) {
+	nonce := make([]byte, 32)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	id := base64.RawURLEncoding.EncodeToString(nonce)
+	if err := s.client.Set(ctx, s.key(purpose, id), value, ttl).Err(); err != nil {
+		return "", err
+	}
+
+	return id + "." + s.sign(purpose, id), nil
+}
+
+// Consume validates the token and deletes it, returning the user it was issued for
+func (s *OneTimeTokenStore) Consume(ctx context.Context, purpose OneTimeTokenPurpose, token string) (uuid.UUID, error) {
+	id, ok := s.verify(purpose, token)
+	if !ok {
+		return uuid.Nil, ErrInvalidOneTimeToken
+	}
+
+	value, err := s.client.GetDel(ctx, s.key(purpose, id)).Result()
+	if err == redis.Nil {
+		return uuid.Nil, ErrInvalidOneTimeToken
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	userID, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, ErrInvalidOneTimeToken
+	}
+
+	return userID, nil
+}
+
+// ConsumeBound validates and deletes a token issued with IssueBound; the token
+// is spent even when the binding does not match, so it cannot be retried
+func (s *OneTimeTokenStore) ConsumeBound(ctx context.Context, purpose OneTimeTokenPurpose, token, binding string) (uuid.UUID, error) {
+	id, ok := s.verify(purpose, token)
+	if !ok {
+		return uuid.Nil, ErrInvalidOneTimeToken
+	}
+
+	value, err := s.client.GetDel(ctx, s.key(purpose, id)).Result()
+	if err == redis.Nil {
+		return uuid.Nil, ErrInvalidOneTimeToken
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	rawUserID, bindingHash, found := strings.Cut(value, "|")
+	if !found {
+		return uuid.Nil, ErrInvalidOneTimeToken
+	}
+
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return uuid.Nil, ErrInvalidOneTimeToken
+	}
+
+	if !hmac.Equal([]byte(bindingHash), []byte(hashBinding(binding))) {
+		return userID, ErrTokenBindingMismatch
+	}
+
+	return userID, nil
+}
+
+// Peek validates the token without consuming it, for flows that may need
+// several attempts before the token is finally consumed
+func (s *OneTimeTokenStore) Peek(ctx context.Context, purpose OneTimeTokenPurpose, token string) (uuid.UUID, error) {
+	id, ok := s.verify(purpose, token)
+	if !ok {
+		return uuid.Nil, ErrInvalidOneTimeToken
+	}
+
+	value, err := s.client.Get(ctx, s.key(purpose, id)).Result()
+	if err == redis.Nil {
+		return uuid.Nil, ErrInvalidOneTimeToken
+	}
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	userID, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, ErrInvalidOneTimeToken
+	}
+
+	return userID, nil
+}
+
+// verify checks the signature so forged tokens never reach Redis
+func (s *OneTimeTokenStore) verify(purpose OneTimeTokenPurpose, token string) (string, bool) {
+	id, signature, found := strings.Cut(token, ".")
+	if !found || id == "" || signature == "" {
+		return "", false
+	}
+
+	expected := s.sign(purpose, id)
+	if !hmac.Equal([]byte(signature), []byte(expected)) {
+		return "", false
+	}
+
+	return id, true
+}
+
+// sign computes the HMAC of the token id bound to its purpose
+func (s *OneTimeTokenStore) sign(purpose OneTimeTokenPurpose, id string) string {
+	mac := hmac.New(sha256.New, s.secretKey)
+	mac.Write([]byte(purpose))
+	mac.Write([]byte{':'})
+	mac.Write([]byte(id))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (s *OneTimeTokenStore) key(purpose OneTimeTokenPurpose, id string) string {
+	return fmt.Sprintf("one_time_token:%s:%s", purpose, id)
+}
+
+func hashBinding(binding string) string {
+	sum := sha256.Sum256([]byte(binding))
+	return hex.EncodeToString(sum[:])
+}
@@ -77,28 +77,49 @@ func HashPassword(password string, params *Argon2Params) (encodedHash string, er
 
 // VerifyPassword checks if a password matches the given hash
 func VerifyPassword(password, encodedHash string) (match bool, err error) {
+	match, _, err = VerifyPasswordWithParams(password, encodedHash, nil)
+	return match, err
+}
+
+// VerifyPasswordWithParams checks if a password matches the given hash and
+// reports whether the hash was made with weaker parameters than params, in
+// which case the caller should re-hash the password while it has it in hand
+func VerifyPasswordWithParams(password, encodedHash string, params *Argon2Params) (match, needsRehash bool, err error) {
+	if params == nil {
+		params = DefaultArgon2Params()
+	}
+
 	// Extract parameters from encoded hash
-	params, salt, hash, err := decodeHash(encodedHash)
+	stored, salt, hash, err := decodeHash(encodedHash)
 	if err != nil {
-		return false, err
+		return false, false, err
 	}
 
 	// Generate hash for the input password using same parameters
 	otherHash := argon2.IDKey(
 		[]byte(password),
 		salt,
-		params.Iterations,
-		params.Memory,
-		params.Parallelism,
-		params.KeyLength,
+		stored.Iterations,
+		stored.Memory,
+		stored.Parallelism,
+		stored.KeyLength,
 	)
 
 	// Use constant-time comparison to prevent timing attacks
-	if subtle.ConstantTimeCompare(hash, otherHash) == 1 {
-		return true, nil
+	if subtle.ConstantTimeCompare(hash, otherHash) != 1 {
+		return false, false, nil
 	}
 
-	return false, nil
+	return true, stored.weakerThan(params), nil
+}
+
+// weakerThan reports whether any cost or size parameter is below target
+func (p *Argon2Params) weakerThan(target *Argon2Params) bool {
+	return p.Memory < target.Memory ||
+		p.Iterations < target.Iterations ||
+		p.Parallelism < target.Parallelism ||
+		p.SaltLength < target.SaltLength ||
+		p.KeyLength < target.KeyLength
 }
 
 // decodeHash extracts parameters, salt, and hash from encoded string
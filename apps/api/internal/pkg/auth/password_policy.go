@@ -0,0 +1,149 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxPasswordLength bounds the work done hashing attacker-supplied input
+const maxPasswordLength = 128
+
+// minEmailSimilarityLength avoids flagging passwords that merely share a short
+// fragment such as "jo" with the email address
+const minEmailSimilarityLength = 4
+
+var (
+	ErrWeakPassword = errors.New("password does not meet the password policy")
+)
+
+// Password policy violation reasons, returned to clients as machine-readable codes
+const (
+	PasswordTooShort         = "PASSWORD_TOO_SHORT"
+	PasswordTooLong          = "PASSWORD_TOO_LONG"
+	PasswordBannedPattern    = "PASSWORD_BANNED_PATTERN"
+	PasswordSimilarToEmail   = "PASSWORD_SIMILAR_TO_EMAIL"
+	PasswordKnownCompromised = "PASSWORD_KNOWN_COMPROMISED"
+)
+
+// PasswordViolation is one way a password fails the policy
+type PasswordViolation struct {
+	Reason      string
+	Description string
+}
+
+// PasswordPolicyError lists every policy violation so a form can show them all at once
+type PasswordPolicyError struct {
+	Violations []PasswordViolation
+}
+
+func (e *PasswordPolicyError) Error() string {
+	descriptions := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		descriptions = append(descriptions, violation.Description)
+	}
+	return fmt.Sprintf("%s: %s", ErrWeakPassword, strings.Join(descriptions, "; "))
+}
+
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrWeakPassword
+}
+
+// PasswordPolicy decides whether a new password is acceptable
+type PasswordPolicy struct {
+	minLength      int
+	bannedPatterns []string
+	breached       *BreachedPasswords
+}
+
+// NewPasswordPolicy creates a new password policy; banned patterns match
+// case-insensitively anywhere in the password and breached may be nil
+func NewPasswordPolicy(minLength int, bannedPatterns []string, breached *BreachedPasswords) *PasswordPolicy {
+	patterns := make([]string, 0, len(bannedPatterns))
+	for _, pattern := range bannedPatterns {
+		if pattern = strings.ToLower(strings.TrimSpace(pattern)); pattern != "" {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return &PasswordPolicy{
+		minLength:      minLength,
+		bannedPatterns: patterns,
+		breached:       breached,
+	}
+}
+
+// Check returns a *PasswordPolicyError describing every violation, or nil
+func (p *PasswordPolicy) Check(password, email string) error {
+	var violations []PasswordViolation
+
+	length := utf8.RuneCountInString(password)
+	if length < p.minLength {
+		violations = append(violations, PasswordViolation{
+			Reason:      PasswordTooShort,
+			Description: fmt.Sprintf("must be at least %d characters", p.minLength),
+		})
+	}
+	if length > maxPasswordLength {
+		violations = append(violations, PasswordViolation{
+			Reason:      PasswordTooLong,
+			Description: fmt.Sprintf("must be at most %d characters", maxPasswordLength),
+		})
+	}
+
+	lower := strings.ToLower(password)
+	for _, pattern := range p.bannedPatterns {
+		if strings.Contains(lower, pattern) {
+			violations = append(violations, PasswordViolation{
+				Reason:      PasswordBannedPattern,
+				Description: fmt.Sprintf("must not contain %q", pattern),
+			})
+			break
+		}
+	}
+
+	if similarToEmail(password, email) {
+		violations = append(violations, PasswordViolation{
+			Reason:      PasswordSimilarToEmail,
+			Description: "must not contain or be part of your email address",
+		})
+	}
+
+	if p.breached != nil && password != "" && p.breached.Contains(password) {
+		violations = append(violations, PasswordViolation{
+			Reason:      PasswordKnownCompromised,
+			Description: "appears in a list of compromised passwords",
+		})
+	}
+
+	if len(violations) > 0 {
+		return &PasswordPolicyError{Violations: violations}
+	}
+	return nil
+}
+
+// similarToEmail compares letters and digits only, so "John.Smith99" matches
+// john_smith99@example.com
+func similarToEmail(password, email string) bool {
+	normalizedPassword := alphanumeric(password)
+	if len(normalizedPassword) < minEmailSimilarityLength {
+		return false
+	}
+
+	local, _, _ := strings.Cut(email, "@")
+	normalizedLocal := alphanumeric(local)
+	if len(normalizedLocal) >= minEmailSimilarityLength && strings.Contains(normalizedPassword, normalizedLocal) {
+		return true
+	}
+	return strings.Contains(alphanumeric(email), normalizedPassword)
+}
+
+func alphanumeric(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, value)
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	ErrTooManyRequests = errors.New("too many requests")
+)
+
+// ThrottledError reports a throttled request and when it may be retried
+type ThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *ThrottledError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrTooManyRequests, e.RetryAfter.Round(time.Second))
+}
+
+func (e *ThrottledError) Unwrap() error {
+	return ErrTooManyRequests
+}
+
+// RequestThrottle allows at most Limit requests per key within a fixed window,
+// for flows such as sending mail where every request costs something even
+// when it succeeds
+type RequestThrottle struct {
+	client *redis.Client
+	name   string
+	limit  int64
+	window time.Duration
+}
+
+// NewRequestThrottle creates a throttle whose Redis keys are namespaced by name
+func NewRequestThrottle(client *redis.Client, name string, limit int64, window time.Duration) *RequestThrottle {
+	return &RequestThrottle{
+		client: client,
+		name:   name,
+		limit:  limit,
+		window: window,
+	}
+}
+
+// Allow counts a request for key and returns a ThrottledError once the limit
+// for the current window is exceeded
+func (t *RequestThrottle) Allow(ctx context.Context, key string) error {
+	redisKey := fmt.Sprintf("throttle:%s:%s", t.name, key)
+
+	pipe := t.client.TxPipeline()
+	incr := pipe.Incr(ctx, redisKey)
+	// Only the first request of a window sets the expiry, so the window is fixed
+	pipe.ExpireNX(ctx, redisKey, t.window)
+	ttl := pipe.PTTL(ctx, redisKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+
+	if incr.Val() > t.limit {
+		return &ThrottledError{RetryAfter: max(ttl.Val(), time.Second)}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// RevocationList tracks access tokens that must be rejected before they expire
+//
+// Access tokens can be revoked individually by jti, per session, or for a whole
+// user by recording a cutoff: any token issued before it is rejected. Entries
+// only need to outlive the longest access token, so they expire after ttl.
+type RevocationList struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// NewRevocationList creates a new revocation list
+func NewRevocationList(client *redis.Client, accessTokenDuration time.Duration) *RevocationList {
+	return &RevocationList{
+		client: client,
+		ttl:    accessTokenDuration,
+	}
+}
+
+// RevokeToken revokes a single access token by its jti
+func (l *RevocationList) RevokeToken(ctx context.Context, tokenID string) error {
+	if tokenID == "" {
+		return nil
+	}
+	return l.client.Set(ctx, l.tokenKey(tokenID), "1", l.ttl).Err()
+}
+
+// RevokeSession revokes every access token issued for the session
+func (l *RevocationList) RevokeSession(ctx context.Context, sessionID string) error {
+	if sessionID == "" {
+		return nil
+	}
+	return l.client.Set(ctx, l.sessionKey(sessionID), "1", l.ttl).Err()
+}
+
+// RevokeUserTokens revokes every access token issued to the user before now
+//
+// Tokens carry their issue time truncated to the microsecond, so the cutoff is
+// truncated the same way to keep tokens issued right after the call valid.
+func (l *RevocationList) RevokeUserTokens(ctx context.Context, userID uuid.UUID) error {
+	cutoff := time.Now().Truncate(time.Microsecond).UTC().Format(time.RFC3339Nano)
+	return l.client.Set(ctx, l.userKey(userID), cutoff, l.ttl).Err()
+}
+
+// IsRevoked reports whether the access token has been revoked
+func (l *RevocationList) IsRevoked(ctx context.Context, claims *Claims) (bool, error) {
+	values, err := l.client.MGet(ctx,
+		l.tokenKey(claims.ID),
+		l.sessionKey(claims.SessionID),
+		l.userKey(claims.UserID),
+	).Result()
+	if err != nil {
+		return false, err
+	}
+
+	if values[0] != nil || (claims.SessionID != "" && values[1] != nil) {
+		return true, nil
+	}
+
+	if cutoff, ok := values[2].(string); ok && claims.IssuedAt != nil {
+		if claims.IssuedAt.Truncate(time.Microsecond).Before(parseCutoff(cutoff).Truncate(time.Microsecond)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// parseCutoff reads a per-user cutoff; entries written before sub-second
+// cutoffs hold Unix seconds
+func parseCutoff(value string) time.Time {
+	if cutoff, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return cutoff
+	}
+	return parseUnix(value)
+}
+
+func (l *RevocationList) tokenKey(tokenID string) string {
+	return fmt.Sprintf("revoked_token:%s", tokenID)
+}
+
+func (l *RevocationList) sessionKey(sessionID string) string {
+	return fmt.Sprintf("revoked_session:%s", sessionID)
+}
+
+func (l *RevocationList) userKey(userID uuid.UUID) string {
+	return fmt.Sprintf("tokens_revoked_before:%s", userID.String())
+}
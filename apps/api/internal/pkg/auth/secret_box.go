@@ -0,0 +1,103 @@
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+)
+
+var (
+	ErrDecryptionFailed = errors.New("failed to decrypt secret")
+)
+
+// KeyPurpose separates the keys derived from the deployment's encryption key,
+// so a key leaked or misused for one purpose is useless for the others
+type KeyPurpose string
+
+const (
+	SigningKeysPurpose   KeyPurpose = "signing-keys"
+	TOTPSecretsPurpose   KeyPurpose = "totp-secrets"
+	KYCDocumentsPurpose  KeyPurpose = "kyc-documents"
+	OneTimeTokensPurpose KeyPurpose = "one-time-tokens"
+)
+
+// DeriveKey derives a 256-bit key for purpose from the encryption key with
+// HKDF-SHA256
+func DeriveKey(encryptionKey string, purpose KeyPurpose) ([]byte, error) {
+	return hkdf.Key(sha256.New, []byte(encryptionKey), nil, "chefnext/"+string(purpose), 32)
+}
+
+// SecretBox encrypts small secrets at rest with AES-GCM using a key derived
+// from the deployment's encryption key for one purpose
+//
+// Secrets sealed before keys were derived per purpose used sha256 of
+// JWT_SECRET; they can still be opened when legacySecret is set, and are
+// sealed with the derived key the next time they are written.
+type SecretBox struct {
+	aead   cipher.AEAD
+	legacy cipher.AEAD
+}
+
+// NewSecretBox creates a new secret box
+func NewSecretBox(encryptionKey string, purpose KeyPurpose, legacySecret string) (*SecretBox, error) {
+	key, err := DeriveKey(encryptionKey, purpose)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	box := &SecretBox{aead: aead}
+	if legacySecret != "" {
+		legacyKey := sha256.Sum256([]byte(legacySecret))
+		if box.legacy, err = newAEAD(legacyKey[:]); err != nil {
+			return nil, err
+		}
+	}
+	return box, nil
+}
+
+// Seal encrypts plaintext, binding it to associatedData so a ciphertext cannot
+// be moved to another record
+func (b *SecretBox) Seal(plaintext, associatedData []byte) ([]byte, error) {
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return b.aead.Seal(nonce, nonce, plaintext, associatedData), nil
+}
+
+// Open decrypts a ciphertext produced by Seal with the same associatedData
+func (b *SecretBox) Open(ciphertext, associatedData []byte) ([]byte, error) {
+	plaintext, err := open(b.aead, ciphertext, associatedData)
+	if err != nil && b.legacy != nil {
+		plaintext, err = open(b.legacy, ciphertext, associatedData)
+	}
+	return plaintext, err
+}
+
+func open(aead cipher.AEAD, ciphertext, associatedData []byte) ([]byte, error) {
+	nonceSize := aead.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return nil, ErrDecryptionFailed
+	}
+
+	plaintext, err := aead.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], associatedData)
+	if err != nil {
+		return nil, ErrDecryptionFailed
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -2,14 +2,75 @@ package auth
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 )
 
-// TokenStore manages refresh tokens in Redis
+var (
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrRefreshTokenReused = errors.New("refresh token has already been used")
+)
+
+// rotateRefreshTokenScript swaps the session's refresh token only if the
+// presented one is current. It returns 0 when the session does not exist,
+// -1 when a previously rotated token is replayed and 1 on success.
+var rotateRefreshTokenScript = redis.NewScript(`
+local current = redis.call('HMGET', KEYS[1], 'user_id', 'token_hash')
+if not current[1] or current[1] ~= ARGV[1] then
+  return 0
+end
+if current[2] ~= ARGV[2] then
+  return -1
+end
+redis.call('HSET', KEYS[1], 'token_hash', ARGV[3], 'ip_address', ARGV[4], 'user_agent', ARGV[5], 'last_used_at', ARGV[6])
+redis.call('HINCRBY', KEYS[1], 'generation', 1)
+redis.call('EXPIRE', KEYS[1], ARGV[7])
+redis.call('EXPIRE', KEYS[2], ARGV[7])
+return 1
+`)
+
+// SessionMetadata describes the client a session was created from
+type SessionMetadata struct {
+	DeviceLabel string
+	IPAddress   string
+	UserAgent   string
+}
+
+// Session represents one signed-in device holding its own refresh token
+type Session struct {
+	ID         string
+	UserID     uuid.UUID
+	Metadata   SessionMetadata
+	CreatedAt  time.Time
+	LastUsedAt time.Time
+}
+
+// NewSession creates a session with a fresh ID for the user
+func NewSession(userID uuid.UUID, metadata SessionMetadata) *Session {
+	now := time.Now()
+	return &Session{
+		ID:         uuid.NewString(),
+		UserID:     userID,
+		Metadata:   metadata,
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+}
+
+// TokenStore manages per-session refresh tokens in Redis
+//
+// Each session is stored as a hash at refresh_session:<sessionID> and indexed
+// in the set user_sessions:<userID> so a user can hold several at once. A
+// session is also the refresh token family: every rotation replaces its token,
+// and replaying an older token of the family is reported as reuse.
 type TokenStore struct {
 	client *redis.Client
 	ttl    time.Duration
@@ -23,42 +84,174 @@ func NewTokenStore(client *redis.Client, ttl time.Duration) *TokenStore {
 	}
 }
 
-// StoreRefreshToken stores a refresh token in Redis
-func (s *TokenStore) StoreRefreshToken(ctx context.Context, userID uuid.UUID, token string) error {
-	key := fmt.Sprintf("refresh_token:%s", userID.String())
-	return s.client.Set(ctx, key, token, s.ttl).Err()
+// StoreRefreshToken creates the session and stores its refresh token in Redis
+func (s *TokenStore) StoreRefreshToken(ctx context.Context, session *Session, token string) error {
+	sessionKey := s.sessionKey(session.ID)
+	userKey := s.userSessionsKey(session.UserID)
+
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, sessionKey, map[string]any{
+			"user_id":      session.UserID.String(),
+			"token_hash":   hashToken(token),
+			"device_label": session.Metadata.DeviceLabel,
+			"ip_address":   session.Metadata.IPAddress,
+			"user_agent":   session.Metadata.UserAgent,
+			"created_at":   strconv.FormatInt(session.CreatedAt.Unix(), 10),
+			"last_used_at": strconv.FormatInt(session.LastUsedAt.Unix(), 10),
+			"generation":   0,
+		})
+		pipe.Expire(ctx, sessionKey, s.ttl)
+		pipe.SAdd(ctx, userKey, session.ID)
+		pipe.Expire(ctx, userKey, s.ttl)
+		return nil
+	})
+	return err
 }
 
-// ValidateRefreshToken checks if a refresh token is valid
-func (s *TokenStore) ValidateRefreshToken(ctx context.Context, userID uuid.UUID, token string) (bool, error) {
-	key := fmt.Sprintf("refresh_token:%s", userID.String())
-	storedToken, err := s.client.Get(ctx, key).Result()
-	if err == redis.Nil {
-		return false, nil
+// RotateRefreshToken atomically replaces the session's refresh token with
+// newToken, provided presentedToken is the current one. Replaying a token that
+// was already rotated returns ErrRefreshTokenReused.
+func (s *TokenStore) RotateRefreshToken(ctx context.Context, userID uuid.UUID, sessionID, presentedToken, newToken string, metadata SessionMetadata) error {
+	result, err := rotateRefreshTokenScript.Run(ctx, s.client,
+		[]string{s.sessionKey(sessionID), s.userSessionsKey(userID)},
+		userID.String(),
+		hashToken(presentedToken),
+		hashToken(newToken),
+		metadata.IPAddress,
+		metadata.UserAgent,
+		strconv.FormatInt(time.Now().Unix(), 10),
+		int64(s.ttl.Seconds()),
+	).Int()
+	if err != nil {
+		return err
+	}
+
+	switch result {
+	case 1:
+		return nil
+	case -1:
+		return ErrRefreshTokenReused
+	default:
+		return ErrSessionNotFound
 	}
+}
+
+// ListSessions returns the user's active sessions, most recently used first
+func (s *TokenStore) ListSessions(ctx context.Context, userID uuid.UUID) ([]*Session, error) {
+	userKey := s.userSessionsKey(userID)
+	sessionIDs, err := s.client.SMembers(ctx, userKey).Result()
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	return storedToken == token, nil
+	sessions := make([]*Session, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		session, err := s.getSession(ctx, userID, sessionID)
+		if errors.Is(err, ErrSessionNotFound) {
+			// The session hash expired; drop the stale index entry
+			if err := s.client.SRem(ctx, userKey, sessionID).Err(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt)
+	})
+
+	return sessions, nil
 }
 
-// RevokeRefreshToken removes a refresh token from Redis
-func (s *TokenStore) RevokeRefreshToken(ctx context.Context, userID uuid.UUID) error {
-	key := fmt.Sprintf("refresh_token:%s", userID.String())
-	return s.client.Del(ctx, key).Err()
+// RevokeRefreshToken removes a single session from Redis
+func (s *TokenStore) RevokeRefreshToken(ctx context.Context, userID uuid.UUID, sessionID string) error {
+	if _, err := s.getSession(ctx, userID, sessionID); err != nil {
+		return err
+	}
+
+	_, err := s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, s.sessionKey(sessionID))
+		pipe.SRem(ctx, s.userSessionsKey(userID), sessionID)
+		return nil
+	})
+	return err
+}
+
+// RevokeOtherSessions revokes every session of the user except keepSessionID
+func (s *TokenStore) RevokeOtherSessions(ctx context.Context, userID uuid.UUID, keepSessionID string) error {
+	return s.revokeSessions(ctx, userID, keepSessionID)
 }
 
-// RevokeAllUserTokens revokes all tokens for a user
+// RevokeAllUserTokens revokes all sessions for a user
 func (s *TokenStore) RevokeAllUserTokens(ctx context.Context, userID uuid.UUID) error {
-	pattern := fmt.Sprintf("refresh_token:%s*", userID.String())
+	return s.revokeSessions(ctx, userID, "")
+}
 
-	iter := s.client.Scan(ctx, 0, pattern, 0).Iterator()
-	for iter.Next(ctx) {
-		if err := s.client.Del(ctx, iter.Val()).Err(); err != nil {
-			return err
+func (s *TokenStore) revokeSessions(ctx context.Context, userID uuid.UUID, keepSessionID string) error {
+	userKey := s.userSessionsKey(userID)
+	sessionIDs, err := s.client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, sessionID := range sessionIDs {
+			if sessionID == keepSessionID {
+				continue
+			}
+			pipe.Del(ctx, s.sessionKey(sessionID))
+			pipe.SRem(ctx, userKey, sessionID)
 		}
+		return nil
+	})
+	return err
+}
+
+// getSession loads a session and checks that it belongs to the user
+func (s *TokenStore) getSession(ctx context.Context, userID uuid.UUID, sessionID string) (*Session, error) {
+	values, err := s.client.HGetAll(ctx, s.sessionKey(sessionID)).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) == 0 || values["user_id"] != userID.String() {
+		return nil, ErrSessionNotFound
 	}
 
-	return iter.Err()
+	return &Session{
+		ID:     sessionID,
+		UserID: userID,
+		Metadata: SessionMetadata{
+			DeviceLabel: values["device_label"],
+			IPAddress:   values["ip_address"],
+			UserAgent:   values["user_agent"],
+		},
+		CreatedAt:  parseUnix(values["created_at"]),
+		LastUsedAt: parseUnix(values["last_used_at"]),
+	}, nil
+}
+
+func (s *TokenStore) sessionKey(sessionID string) string {
+	return fmt.Sprintf("refresh_session:%s", sessionID)
+}
+
+func (s *TokenStore) userSessionsKey(userID uuid.UUID) string {
+	return fmt.Sprintf("user_sessions:%s", userID.String())
+}
+
+// hashToken avoids keeping usable refresh tokens at rest in Redis
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func parseUnix(value string) time.Time {
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Unix(seconds, 0)
 }
@@ -0,0 +1,111 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	ErrKeyReused  = errors.New("idempotency key was already used for a different request")
+	ErrInProgress = errors.New("a request with this idempotency key is still in progress")
+)
+
+// claimTTL bounds how long a request can hold a key without completing, so a
+// crashed replica cannot block retries forever
+const claimTTL = time.Minute
+
+// releaseScript deletes a key only while it still holds the caller's claim
+var releaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+  return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// record is what is stored under a key: the fingerprint of the request that
+// claimed it and, once that request succeeded, its serialized response
+type record struct {
+	Fingerprint string `json:"fingerprint"`
+	Done        bool   `json:"done"`
+	Response    []byte `json:"response,omitempty"`
+}
+
+// Store remembers the outcome of requests sent with an idempotency key so
+// retries get the original response instead of running again
+type Store struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// NewStore creates a new idempotency store
+// ttl: how long completed responses can be replayed
+func NewStore(client *redis.Client, ttl time.Duration) *Store {
+	return &Store{client: client, ttl: ttl}
+}
+
+// Begin claims key for a request with the given fingerprint. When the key
+// already completed for the same request its stored response is returned with
+// found set; otherwise the caller owns the key and must Complete or Release it.
+func (s *Store) Begin(ctx context.Context, key, fingerprint string) (response []byte, found bool, err error) {
+	claim, err := json.Marshal(record{Fingerprint: fingerprint})
+	if err != nil {
+		return nil, false, err
+	}
+
+	claimed, err := s.client.SetNX(ctx, redisKey(key), claim, claimTTL).Result()
+	if err != nil {
+		return nil, false, err
+	}
+	if claimed {
+		return nil, false, nil
+	}
+
+	raw, err := s.client.Get(ctx, redisKey(key)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		// The other claim expired in between; let the client retry
+		return nil, false, ErrInProgress
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	var existing record
+	if err := json.Unmarshal(raw, &existing); err != nil {
+		return nil, false, err
+	}
+	if existing.Fingerprint != fingerprint {
+		return nil, false, ErrKeyReused
+	}
+	if !existing.Done {
+		return nil, false, ErrInProgress
+	}
+	return existing.Response, true, nil
+}
+
+// Complete stores the response of the request that claimed key
+func (s *Store) Complete(ctx context.Context, key, fingerprint string, response []byte) error {
+	done, err := json.Marshal(record{Fingerprint: fingerprint, Done: true, Response: response})
+	if err != nil {
+		return err
+	}
+	return s.client.Set(ctx, redisKey(key), done, s.ttl).Err()
+}
+
+// Release gives up a claim after the request failed, so it can be retried
+// with the same key
+func (s *Store) Release(ctx context.Context, key, fingerprint string) error {
+	claim, err := json.Marshal(record{Fingerprint: fingerprint})
+	if err != nil {
+		return err
+	}
+	return releaseScript.Run(ctx, s.client, []string{redisKey(key)}, claim).Err()
+}
+
+func redisKey(key string) string {
+	return fmt.Sprintf("idempotency:%s", key)
+}
@@ -6,7 +6,8 @@ import (
 	"strings"
 )
 
-// New creates a JSON slog.Logger configured for the given log level.
+// New creates a JSON slog.Logger configured for the given log level. Records
+// logged with a traced context carry its trace_id and span_id.
 func New(level string) *slog.Logger {
 	var slogLevel slog.Level
 
@@ -24,5 +25,5 @@ func New(level string) *slog.Logger {
 	}
 
 	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: slogLevel})
-	return slog.New(handler)
+	return slog.New(traceHandler{handler})
 }
@@ -0,0 +1,22 @@
+package mail
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrNoRecipients = errors.New("mail message has no recipients")
+)
+
+// Message is a plain-text email ready to be delivered
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Sender delivers email messages
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
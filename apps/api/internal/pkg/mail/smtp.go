@@ -0,0 +1,60 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// SMTPSender delivers messages through an SMTP relay such as MailPit
+type SMTPSender struct {
+	addr string
+	from string
+}
+
+// NewSMTPSender creates a new SMTP sender
+// addr: host:port of the SMTP server
+// from: RFC 5322 address used in the From header and envelope
+func NewSMTPSender(addr, from string) *SMTPSender {
+	return &SMTPSender{
+		addr: addr,
+		from: from,
+	}
+}
+
+// Send delivers the message via SMTP
+func (s *SMTPSender) Send(ctx context.Context, msg Message) error {
+	if len(msg.To) == 0 {
+		return ErrNoRecipients
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	envelopeFrom := s.from
+	if parsed, err := mail.ParseAddress(s.from); err == nil {
+		envelopeFrom = parsed.Address
+	}
+
+	return smtp.SendMail(s.addr, nil, envelopeFrom, msg.To, s.buildMessage(msg))
+}
+
+// buildMessage renders headers and body as a UTF-8 plain-text email
+func (s *SMTPSender) buildMessage(msg Message) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", s.from)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
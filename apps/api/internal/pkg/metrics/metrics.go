@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace prefixes every metric the API exports.
+const namespace = "chefnext"
+
+// Metrics holds the API's own Prometheus collectors.
+type Metrics struct {
+	rpcRequests              *prometheus.CounterVec
+	rpcDuration              *prometheus.HistogramVec
+	rateLimitRejections      *prometheus.CounterVec
+	jobsPublished            prometheus.Counter
+	applicationsCreated      prometheus.Counter
+	applicationStatusChanges *prometheus.CounterVec
+}
+
+// New creates the API's collectors and registers them with reg.
+func New(reg prometheus.Registerer) *Metrics {
+	factory := promauto.With(reg)
+	return &Metrics{
+		rpcRequests: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "rpc_requests_total",
+			Help:      "Connect RPCs handled, by procedure and result code.",
+		}, []string{"procedure", "code"}),
+		rpcDuration: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "rpc_duration_seconds",
+			Help:      "Time taken to handle Connect RPCs, by procedure.",
+			Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		}, []string{"procedure"}),
+		rateLimitRejections: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "rate_limit_rejections_total",
+			Help:      "Requests rejected by the rate limiter, by bucket.",
+		}, []string{"bucket"}),
+		jobsPublished: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "jobs_published_total",
+			Help:      "Jobs that were published, either on creation or by an update.",
+		}),
+		applicationsCreated: factory.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "applications_created_total",
+			Help:      "Applications submitted by chefs.",
+		}),
+		applicationStatusChanges: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "application_status_changes_total",
+			Help:      "Application status changes made by restaurants, by new status.",
+		}, []string{"status"}),
+	}
+}
+
+// NewRegistry creates a registry with the Go runtime and process collectors.
+func NewRegistry() *prometheus.Registry {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return reg
+}
+
+// Handler serves the registry in the Prometheus exposition format.
+func Handler(reg *prometheus.Registry) http.Handler {
+	return promhttp.HandlerFor(reg, promhttp.HandlerOpts{Registry: reg})
+}
+
+// ObserveRPC records a handled RPC and how long it took.
+func (m *Metrics) ObserveRPC(procedure, code string, duration time.Duration) {
+	m.rpcRequests.WithLabelValues(procedure, code).Inc()
+	m.rpcDuration.WithLabelValues(procedure).Observe(duration.Seconds())
+}
+
+// RateLimited records a request rejected by the rate limiter.
+func (m *Metrics) RateLimited(bucket string) {
+	m.rateLimitRejections.WithLabelValues(bucket).Inc()
+}
+
+// JobPublished records a job becoming visible to chefs.
+func (m *Metrics) JobPublished() {
+	m.jobsPublished.Inc()
+}
+
+// ApplicationCreated records a chef applying to a job.
+func (m *Metrics) ApplicationCreated() {
+	m.applicationsCreated.Inc()
+}
+
+// ApplicationStatusChanged records a restaurant moving an application to status.
+func (m *Metrics) ApplicationStatusChanged(status string) {
+	m.applicationStatusChanges.WithLabelValues(status).Inc()
+}
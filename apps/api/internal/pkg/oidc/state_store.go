@@ -0,0 +1,96 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	ErrInvalidState = errors.New("invalid or expired login state")
+)
+
+// LoginState is what we remember between redirecting to the provider and
+// handling its callback
+type LoginState struct {
+	Provider     string `json:"provider"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"code_verifier"`
+	// Role is used when the callback creates a new account
+	Role string `json:"role,omitempty"`
+	// LinkUserID is set when an authenticated user is linking a new identity
+	LinkUserID uuid.UUID `json:"link_user_id,omitempty"`
+}
+
+// StateStore keeps login states in Redis until the callback consumes them
+type StateStore struct {
+	client *redis.Client
+	ttl    time.Duration
+}
+
+// NewStateStore creates a new state store
+func NewStateStore(client *redis.Client, ttl time.Duration) *StateStore {
+	return &StateStore{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+// Save stores the login state and returns the opaque state parameter
+func (s *StateStore) Save(ctx context.Context, state *LoginState) (string, error) {
+	id, err := RandomString()
+	if err != nil {
+		return "", err
+	}
+
+	value, err := json.Marshal(state)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.client.Set(ctx, s.key(id), value, s.ttl).Err(); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// Consume returns the login state for the state parameter and deletes it
+func (s *StateStore) Consume(ctx context.Context, id string) (*LoginState, error) {
+	if id == "" {
+		return nil, ErrInvalidState
+	}
+
+	value, err := s.client.GetDel(ctx, s.key(id)).Bytes()
+	if err == redis.Nil {
+		return nil, ErrInvalidState
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var state LoginState
+	if err := json.Unmarshal(value, &state); err != nil {
+		return nil, ErrInvalidState
+	}
+	return &state, nil
+}
+
+func (s *StateStore) key(id string) string {
+	return fmt.Sprintf("oidc_state:%s", id)
+}
+
+// RandomString returns a URL-safe random value for states, nonces and PKCE verifiers
+func RandomString() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
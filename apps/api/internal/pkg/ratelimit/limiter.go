@@ -0,0 +1,94 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// gcraScript implements the generic cell rate algorithm. Each key stores its
+// theoretical arrival time (TAT) in milliseconds, read against the Redis clock
+// so every replica agrees on time. It returns whether the request is allowed,
+// the remaining burst, and the milliseconds until a retry may succeed and
+// until the bucket is full again.
+var gcraScript = redis.NewScript(`
+local now = redis.call('TIME')
+local now_ms = tonumber(now[1]) * 1000 + math.floor(tonumber(now[2]) / 1000)
+local interval = tonumber(ARGV[1])
+local burst_offset = interval * tonumber(ARGV[2])
+
+local tat = tonumber(redis.call('GET', KEYS[1]))
+if not tat or tat < now_ms then
+  tat = now_ms
+end
+
+local new_tat = tat + interval
+local allow_at = new_tat - burst_offset
+if allow_at > now_ms then
+  return {0, 0, allow_at - now_ms, tat - now_ms}
+end
+
+redis.call('SET', KEYS[1], new_tat, 'PX', new_tat - now_ms)
+local remaining = math.floor((now_ms + burst_offset - new_tat) / interval)
+return {1, remaining, 0, new_tat - now_ms}
+`)
+
+// Policy allows Rate requests per Period on average, with bursts of up to
+// Burst requests at once
+type Policy struct {
+	Rate   int
+	Period time.Duration
+	Burst  int
+}
+
+// interval is the time one request costs
+func (p Policy) interval() time.Duration {
+	return p.Period / time.Duration(p.Rate)
+}
+
+// Result describes the outcome of a rate limit check
+type Result struct {
+	Allowed bool
+	// Limit is the largest burst the policy allows
+	Limit int
+	// Remaining is how many more requests can be made right now
+	Remaining int
+	// RetryAfter is how long to wait before the next request can succeed;
+	// zero when the request was allowed
+	RetryAfter time.Duration
+	// ResetAfter is how long until the full burst is available again
+	ResetAfter time.Duration
+}
+
+// Limiter enforces rate limit policies with GCRA state shared in Redis, so
+// limits hold across every replica and idle keys expire on their own
+type Limiter struct {
+	client *redis.Client
+}
+
+// NewLimiter creates a new Redis-backed limiter
+func NewLimiter(client *redis.Client) *Limiter {
+	return &Limiter{client: client}
+}
+
+// Allow counts one request against key under policy
+func (l *Limiter) Allow(ctx context.Context, key string, policy Policy) (*Result, error) {
+	values, err := gcraScript.Run(ctx, l.client,
+		[]string{fmt.Sprintf("rate_limit:%s", key)},
+		max(policy.interval().Milliseconds(), 1),
+		policy.Burst,
+	).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		Allowed:    values[0] == 1,
+		Limit:      policy.Burst,
+		Remaining:  int(values[1]),
+		RetryAfter: time.Duration(values[2]) * time.Millisecond,
+		ResetAfter: time.Duration(values[3]) * time.Millisecond,
+	}, nil
+}
@@ -0,0 +1,78 @@
+package security
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventType classifies security-relevant events
+type EventType string
+
+const (
+	RefreshTokenReused  EventType = "refresh_token_reused"
+	LoginFailed         EventType = "login_failed"
+	LoginLocked         EventType = "login_locked"
+	MFAFailed           EventType = "mfa_failed"
+	MFARecoveryCodeUsed EventType = "mfa_recovery_code_used"
+	// MagicLinkDeviceMismatch is a login link opened away from the device that
+	// requested it, which may be a forwarded or intercepted email
+	MagicLinkDeviceMismatch EventType = "magic_link_device_mismatch"
+)
+
+// Event describes something security teams may need to investigate
+type Event struct {
+	Type       EventType
+	UserID     uuid.UUID
+	SessionID  string
+	IPAddress  string
+	UserAgent  string
+	Details    map[string]string
+	OccurredAt time.Time
+}
+
+// Recorder persists or forwards security events
+type Recorder interface {
+	Record(ctx context.Context, event Event)
+}
+
+// LogRecorder writes security events to a structured logger
+type LogRecorder struct {
+	log *slog.Logger
+}
+
+// NewLogRecorder creates a new log-backed recorder
+func NewLogRecorder(log *slog.Logger) *LogRecorder {
+	return &LogRecorder{log: log}
+}
+
+// Record logs the event at warning level so it stands out from request logs
+func (r *LogRecorder) Record(ctx context.Context, event Event) {
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+
+	attrs := []slog.Attr{
+		slog.String("event_type", string(event.Type)),
+		slog.Time("occurred_at", event.OccurredAt),
+	}
+	if event.UserID != uuid.Nil {
+		attrs = append(attrs, slog.String("user_id", event.UserID.String()))
+	}
+	if event.SessionID != "" {
+		attrs = append(attrs, slog.String("session_id", event.SessionID))
+	}
+	if event.IPAddress != "" {
+		attrs = append(attrs, slog.String("ip_address", event.IPAddress))
+	}
+	if event.UserAgent != "" {
+		attrs = append(attrs, slog.String("user_agent", event.UserAgent))
+	}
+	for key, value := range event.Details {
+		attrs = append(attrs, slog.String(key, value))
+	}
+
+	r.log.LogAttrs(ctx, slog.LevelWarn, "security event", attrs...)
+}
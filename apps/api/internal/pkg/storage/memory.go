@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryStore keeps objects in memory instead of an object store.
+// It is intended for tests and local tooling.
+type MemoryStore struct {
+	mu      sync.Mutex
+	objects map[string]Object
+}
+
+// NewMemoryStore creates a new in-memory blob store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{objects: make(map[string]Object)}
+}
+
+// Put stores a copy of the object, replacing any object with the same key
+func (s *MemoryStore) Put(ctx context.Context, object Object) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	object.Data = append([]byte(nil), object.Data...)
+	s.objects[object.Key] = object
+	return nil
+}
+
+// Get returns a copy of the object stored under key
+func (s *MemoryStore) Get(ctx context.Context, key string) (*Object, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	object, ok := s.objects[key]
+	if !ok {
+		return nil, ErrObjectNotFound
+	}
+	object.Data = append([]byte(nil), object.Data...)
+	return &object, nil
+}
+
+// Delete removes the object; deleting a missing key is not an error
+func (s *MemoryStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.objects, key)
+	return nil
+}
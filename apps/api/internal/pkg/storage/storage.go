@@ -0,0 +1,24 @@
+package storage
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrObjectNotFound = errors.New("object not found")
+)
+
+// Object is a stored blob with its metadata
+type Object struct {
+	Key         string
+	ContentType string
+	Data        []byte
+}
+
+// BlobStore keeps uploaded files such as KYC documents outside the database
+type BlobStore interface {
+	Put(ctx context.Context, object Object) error
+	Get(ctx context.Context, key string) (*Object, error)
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,63 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PgxTracer is a pgx.QueryTracer that records a client span for every query.
+// Spans are named after the sqlc query, so a slow RPC shows which generated
+// query it spent its time in.
+type PgxTracer struct {
+	tracer trace.Tracer
+}
+
+// NewPgxTracer creates a tracer to set as pgx.ConnConfig.Tracer.
+func NewPgxTracer() *PgxTracer {
+	return &PgxTracer{tracer: otel.Tracer(instrumentationName)}
+}
+
+// TraceQueryStart starts the query span.
+func (t *PgxTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	name := queryName(data.SQL)
+	ctx, _ = t.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNamePostgreSQL,
+			semconv.DBQuerySummary(name),
+			semconv.DBQueryText(data.SQL),
+		),
+	)
+	return ctx
+}
+
+// TraceQueryEnd ends the query span, marking it failed on errors other than
+// an empty result.
+func (t *PgxTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	defer span.End()
+
+	if data.Err != nil && !errors.Is(data.Err, pgx.ErrNoRows) {
+		span.RecordError(data.Err)
+		span.SetStatus(codes.Error, data.Err.Error())
+		return
+	}
+	span.SetAttributes(semconv.DBResponseReturnedRows(int(data.CommandTag.RowsAffected())))
+}
+
+// queryName returns the name sqlc puts in the "-- name: GetUserByID :one"
+// comment heading each generated query.
+func queryName(sql string) string {
+	line, _, _ := strings.Cut(sql, "\n")
+	if fields := strings.Fields(line); len(fields) >= 3 && fields[0] == "--" && fields[1] == "name:" {
+		return fields[2]
+	}
+	return "postgresql.query"
+}
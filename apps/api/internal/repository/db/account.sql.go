@@ -0,0 +1,539 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: account.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const anonymizeChefProfile = `-- name: AnonymizeChefProfile :exec
+UPDATE chef_profiles
+SET full_name = 'Deleted user',
+    headline = NULL,
+    summary = NULL,
+    bio = NULL,
+    location = NULL,
+    years_experience = NULL,
+    availability = NULL,
+    languages = NULL,
+    learning_focus = NULL,
+    specialties = NULL,
+    work_areas = NULL,
+    skill_tree_json = NULL,
+    portfolio_items = '[]'::jsonb,
+    updated_at = NOW()
+WHERE user_id = $1
+`
+
+func (q *Queries) AnonymizeChefProfile(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, anonymizeChefProfile, userID)
+	return err
+}
+
+const anonymizeRestaurantProfile = `-- name: AnonymizeRestaurantProfile :exec
+UPDATE restaurant_profiles
+SET name = 'Deleted restaurant',
+    display_name = 'Deleted restaurant',
+    address = NULL,
+    description = NULL,
+    cuisine_types = NULL,
+    tagline = NULL,
+    location = NULL,
+    seats = NULL,
+    mentorship_style = NULL,
+    culture_keywords = NULL,
+    benefits = NULL,
+    support_programs = NULL,
+    learning_highlights = '[]'::jsonb,
+    updated_at = NOW()
+WHERE user_id = $1
+`
+
+func (q *Queries) AnonymizeRestaurantProfile(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, anonymizeRestaurantProfile, userID)
+	return err
+}
+
+const anonymizeUser = `-- name: AnonymizeUser :exec
+UPDATE users
+SET email = 'deleted-' || id::text || '@deleted.invalid',
+    password_hash = '',
+    kyc_flags = '{}',
+    email_verified_at = NULL,
+    suspension_reason = NULL,
+    deletion_scheduled_for = NULL,
+    deleted_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) AnonymizeUser(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, anonymizeUser, id)
+	return err
+}
+
+const cancelUserDeletion = `-- name: CancelUserDeletion :execrows
+UPDATE users
+SET deletion_requested_at = NULL,
+    deletion_scheduled_for = NULL,
+    updated_at = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL
+  AND deletion_scheduled_for IS NOT NULL
+`
+
+func (q *Queries) CancelUserDeletion(ctx context.Context, id pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, cancelUserDeletion, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const closeJobsByRestaurantUser = `-- name: CloseJobsByRestaurantUser :exec
+UPDATE jobs
+SET status = 'CLOSED',
+    updated_at = NOW()
+WHERE restaurant_id IN (
+    SELECT id FROM restaurant_profiles WHERE user_id = $1
+)
+  AND status <> 'CLOSED'
+`
+
+func (q *Queries) CloseJobsByRestaurantUser(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, closeJobsByRestaurantUser, userID)
+	return err
+}
+
+const countApplicationsByChefUser = `-- name: CountApplicationsByChefUser :one
+SELECT COUNT(*)
+FROM applications a
+JOIN chef_profiles cp ON cp.id = a.chef_profile_id
+WHERE cp.user_id = $1
+`
+
+func (q *Queries) CountApplicationsByChefUser(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countApplicationsByChefUser, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countApplicationsByRestaurantUser = `-- name: CountApplicationsByRestaurantUser :one
+SELECT COUNT(*)
+FROM applications a
+JOIN jobs j ON j.id = a.job_id
+JOIN restaurant_profiles rp ON rp.id = j.restaurant_id
+WHERE rp.user_id = $1
+`
+
+func (q *Queries) CountApplicationsByRestaurantUser(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countApplicationsByRestaurantUser, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const deleteKYCSubmissionsByUser = `-- name: DeleteKYCSubmissionsByUser :exec
+DELETE FROM kyc_submissions
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteKYCSubmissionsByUser(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteKYCSubmissionsByUser, userID)
+	return err
+}
+
+const deleteRecoveryCodesByUser = `-- name: DeleteRecoveryCodesByUser :exec
+DELETE FROM mfa_recovery_codes
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteRecoveryCodesByUser(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteRecoveryCodesByUser, userID)
+	return err
+}
+
+const deleteTOTPCredentialByUser = `-- name: DeleteTOTPCredentialByUser :exec
+DELETE FROM user_totp_credentials
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteTOTPCredentialByUser(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTOTPCredentialByUser, userID)
+	return err
+}
+
+const deleteUserIdentitiesByUser = `-- name: DeleteUserIdentitiesByUser :exec
+DELETE FROM user_identities
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserIdentitiesByUser(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteUserIdentitiesByUser, userID)
+	return err
+}
+
+const listApplicationsByChefUser = `-- name: ListApplicationsByChefUser :many
+SELECT
+    a.id,
+    a.job_id,
+    a.status,
+    a.cover_letter,
+    a.created_at,
+    a.updated_at,
+    j.title AS job_title,
+    rp.display_name AS restaurant_display_name
+FROM applications a
+JOIN chef_profiles cp ON cp.id = a.chef_profile_id
+JOIN jobs j ON j.id = a.job_id
+JOIN restaurant_profiles rp ON rp.id = j.restaurant_id
+WHERE cp.user_id = $1
+ORDER BY a.created_at ASC
+`
+
+type ListApplicationsByChefUserRow struct {
+	ID                    pgtype.UUID
+	JobID                 pgtype.UUID
+	Status                ApplicationStatus
+	CoverLetter           pgtype.Text
+	CreatedAt             pgtype.Timestamp
+	UpdatedAt             pgtype.Timestamp
+	JobTitle              string
+	RestaurantDisplayName pgtype.Text
+}
+
+func (q *Queries) ListApplicationsByChefUser(ctx context.Context, userID pgtype.UUID) ([]ListApplicationsByChefUserRow, error) {
+	rows, err := q.db.Query(ctx, listApplicationsByChefUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListApplicationsByChefUserRow
+	for rows.Next() {
+		var i ListApplicationsByChefUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.JobID,
+			&i.Status,
+			&i.CoverLetter,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.JobTitle,
+			&i.RestaurantDisplayName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listApplicationsReceivedByRestaurantUser = `-- name: ListApplicationsReceivedByRestaurantUser :many
+SELECT
+    a.id,
+    a.job_id,
+    a.status,
+    a.cover_letter,
+    a.created_at,
+    a.updated_at,
+    j.title AS job_title,
+    cp.full_name AS chef_full_name
+FROM applications a
+JOIN jobs j ON j.id = a.job_id
+JOIN restaurant_profiles rp ON rp.id = j.restaurant_id
+JOIN chef_profiles cp ON cp.id = a.chef_profile_id
+WHERE rp.user_id = $1
+ORDER BY a.created_at ASC
+`
+
+type ListApplicationsReceivedByRestaurantUserRow struct {
+	ID           pgtype.UUID
+	JobID        pgtype.UUID
+	Status       ApplicationStatus
+	CoverLetter  pgtype.Text
+	CreatedAt    pgtype.Timestamp
+	UpdatedAt    pgtype.Timestamp
+	JobTitle     string
+	ChefFullName pgtype.Text
+}
+
+func (q *Queries) ListApplicationsReceivedByRestaurantUser(ctx context.Context, userID pgtype.UUID) ([]ListApplicationsReceivedByRestaurantUserRow, error) {
+	rows, err := q.db.Query(ctx, listApplicationsReceivedByRestaurantUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListApplicationsReceivedByRestaurantUserRow
+	for rows.Next() {
+		var i ListApplicationsReceivedByRestaurantUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.JobID,
+			&i.Status,
+			&i.CoverLetter,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.JobTitle,
+			&i.ChefFullName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listJobsByRestaurantUser = `-- name: ListJobsByRestaurantUser :many
+SELECT
+    j.id,
+    j.title,
+    j.description,
+    j.required_skills,
+    j.location,
+    j.salary_range,
+    j.employment_type,
+    j.status,
+    j.metadata,
+    j.created_at,
+    j.updated_at
+FROM jobs j
+JOIN restaurant_profiles rp ON rp.id = j.restaurant_id
+WHERE rp.user_id = $1
+ORDER BY j.created_at ASC
+`
+
+type ListJobsByRestaurantUserRow struct {
+	ID             pgtype.UUID
+	Title          string
+	Description    string
+	RequiredSkills []string
+	Location       pgtype.Text
+	SalaryRange    pgtype.Text
+	EmploymentType pgtype.Text
+	Status         JobStatus
+	Metadata       []byte
+	CreatedAt      pgtype.Timestamp
+	UpdatedAt      pgtype.Timestamp
+}
+
+func (q *Queries) ListJobsByRestaurantUser(ctx context.Context, userID pgtype.UUID) ([]ListJobsByRestaurantUserRow, error) {
+	rows, err := q.db.Query(ctx, listJobsByRestaurantUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListJobsByRestaurantUserRow
+	for rows.Next() {
+		var i ListJobsByRestaurantUserRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Description,
+			&i.RequiredSkills,
+			&i.Location,
+			&i.SalaryRange,
+			&i.EmploymentType,
+			&i.Status,
+			&i.Metadata,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listKYCDocumentKeysByUser = `-- name: ListKYCDocumentKeysByUser :many
+SELECT d.storage_key
+FROM kyc_documents d
+JOIN kyc_submissions s ON s.id = d.submission_id
+WHERE s.user_id = $1
+`
+
+func (q *Queries) ListKYCDocumentKeysByUser(ctx context.Context, userID pgtype.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, listKYCDocumentKeysByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var storage_key string
+		if err := rows.Scan(&storage_key); err != nil {
+			return nil, err
+		}
+		items = append(items, storage_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listKYCSubmissionsByUser = `-- name: ListKYCSubmissionsByUser :many
+SELECT id, user_id, status, business_name, registration_number, business_address, representative_name, rejection_reasons, reviewer_note, reviewed_by, reviewed_at, created_at, updated_at
+FROM kyc_submissions
+WHERE user_id = $1
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListKYCSubmissionsByUser(ctx context.Context, userID pgtype.UUID) ([]KycSubmission, error) {
+	rows, err := q.db.Query(ctx, listKYCSubmissionsByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []KycSubmission
+	for rows.Next() {
+		var i KycSubmission
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.Status,
+			&i.BusinessName,
+			&i.RegistrationNumber,
+			&i.BusinessAddress,
+			&i.RepresentativeName,
+			&i.RejectionReasons,
+			&i.ReviewerNote,
+			&i.ReviewedBy,
+			&i.ReviewedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersDueForDeletion = `-- name: ListUsersDueForDeletion :many
+SELECT id
+FROM users
+WHERE deletion_scheduled_for <= $1
+  AND deleted_at IS NULL
+ORDER BY deletion_scheduled_for ASC
+LIMIT $2
+`
+
+type ListUsersDueForDeletionParams struct {
+	DeletionScheduledFor pgtype.Timestamptz
+	Limit                int32
+}
+
+func (q *Queries) ListUsersDueForDeletion(ctx context.Context, arg ListUsersDueForDeletionParams) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, listUsersDueForDeletion, arg.DeletionScheduledFor, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockUserDueForDeletion = `-- name: LockUserDueForDeletion :one
+SELECT id
+FROM users
+WHERE id = $1
+  AND deletion_scheduled_for <= $2
+  AND deleted_at IS NULL
+FOR UPDATE
+`
+
+type LockUserDueForDeletionParams struct {
+	ID                   pgtype.UUID
+	DeletionScheduledFor pgtype.Timestamptz
+}
+
+// Held until the purge commits, so a cancellation either lands first and the
+// purge skips the account, or waits and finds nothing left to cancel
+func (q *Queries) LockUserDueForDeletion(ctx context.Context, arg LockUserDueForDeletionParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, lockUserDueForDeletion, arg.ID, arg.DeletionScheduledFor)
+	var id pgtype.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const redactApplicationsByChefUser = `-- name: RedactApplicationsByChefUser :exec
+UPDATE applications
+SET cover_letter = NULL,
+    updated_at = NOW()
+WHERE chef_profile_id IN (
+    SELECT id FROM chef_profiles WHERE user_id = $1
+)
+`
+
+func (q *Queries) RedactApplicationsByChefUser(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, redactApplicationsByChefUser, userID)
+	return err
+}
+
+const scheduleUserDeletion = `-- name: ScheduleUserDeletion :one
+UPDATE users
+SET deletion_requested_at = NOW(),
+    deletion_scheduled_for = $2,
+    updated_at = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL
+  AND deletion_scheduled_for IS NULL
+RETURNING id, email, password_hash, role, kyc_status, created_at, updated_at, kyc_flags, email_verified_at, suspended_at, suspension_reason, deletion_requested_at, deletion_scheduled_for, deleted_at, roles
+`
+
+type ScheduleUserDeletionParams struct {
+	ID                   pgtype.UUID
+	DeletionScheduledFor pgtype.Timestamptz
+}
+
+func (q *Queries) ScheduleUserDeletion(ctx context.Context, arg ScheduleUserDeletionParams) (User, error) {
+	row := q.db.QueryRow(ctx, scheduleUserDeletion, arg.ID, arg.DeletionScheduledFor)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Role,
+		&i.KycStatus,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.KycFlags,
+		&i.EmailVerifiedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
+		&i.DeletionRequestedAt,
+		&i.DeletionScheduledFor,
+		&i.DeletedAt,
+		&i.Roles,
+	)
+	return i, err
+}
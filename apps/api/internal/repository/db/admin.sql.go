@@ -0,0 +1,204 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: admin.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const reactivateUser = `-- name: ReactivateUser :one
+UPDATE users
+SET suspended_at = NULL,
+    suspension_reason = NULL,
+    updated_at = NOW()
+WHERE id = $1
+  AND suspended_at IS NOT NULL
+RETURNING id, email, password_hash, role, kyc_status, created_at, updated_at, kyc_flags, email_verified_at, suspended_at, suspension_reason, deletion_requested_at, deletion_scheduled_for, deleted_at, roles
+`
+
+func (q *Queries) ReactivateUser(ctx context.Context, id pgtype.UUID) (User, error) {
+	row := q.db.QueryRow(ctx, reactivateUser, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Role,
+		&i.KycStatus,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.KycFlags,
+		&i.EmailVerifiedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
+		&i.DeletionRequestedAt,
+		&i.DeletionScheduledFor,
+		&i.DeletedAt,
+		&i.Roles,
+	)
+	return i, err
+}
+
+const searchUsers = `-- name: SearchUsers :many
+SELECT
+    id, email, password_hash, role, kyc_status, created_at, updated_at, kyc_flags, email_verified_at, suspended_at, suspension_reason, deletion_requested_at, deletion_scheduled_for, deleted_at, roles,
+    COUNT(*) OVER() AS total_count
+FROM users
+WHERE
+    ($3::text IS NULL OR email ILIKE '%' || $3 || '%')
+    AND ($4::text IS NULL OR $4 = ANY(roles))
+    AND ($5::boolean IS NULL OR (suspended_at IS NOT NULL) = $5)
+ORDER BY created_at DESC
+LIMIT $1 OFFSET $2
+`
+
+type SearchUsersParams struct {
+	Limit     int32
+	Offset    int32
+	Email     pgtype.Text
+	Role      pgtype.Text
+	Suspended pgtype.Bool
+}
+
+type SearchUsersRow struct {
+	ID                   pgtype.UUID
+	Email                string
+	PasswordHash         string
+	Role                 string
+	KycStatus            string
+	CreatedAt            pgtype.Timestamptz
+	UpdatedAt            pgtype.Timestamptz
+	KycFlags             []byte
+	EmailVerifiedAt      pgtype.Timestamptz
+	SuspendedAt          pgtype.Timestamptz
+	SuspensionReason     pgtype.Text
+	DeletionRequestedAt  pgtype.Timestamptz
+	DeletionScheduledFor pgtype.Timestamptz
+	DeletedAt            pgtype.Timestamptz
+	Roles                []string
+	TotalCount           int64
+}
+
+func (q *Queries) SearchUsers(ctx context.Context, arg SearchUsersParams) ([]SearchUsersRow, error) {
+	rows, err := q.db.Query(ctx, searchUsers,
+		arg.Limit,
+		arg.Offset,
+		arg.Email,
+		arg.Role,
+		arg.Suspended,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchUsersRow
+	for rows.Next() {
+		var i SearchUsersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.PasswordHash,
+			&i.Role,
+			&i.KycStatus,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.KycFlags,
+			&i.EmailVerifiedAt,
+			&i.SuspendedAt,
+			&i.SuspensionReason,
+			&i.DeletionRequestedAt,
+			&i.DeletionScheduledFor,
+			&i.DeletedAt,
+			&i.Roles,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const suspendUser = `-- name: SuspendUser :one
+UPDATE users
+SET suspended_at = NOW(),
+    suspension_reason = $2,
+    updated_at = NOW()
+WHERE id = $1
+  AND suspended_at IS NULL
+RETURNING id, email, password_hash, role, kyc_status, created_at, updated_at, kyc_flags, email_verified_at, suspended_at, suspension_reason, deletion_requested_at, deletion_scheduled_for, deleted_at, roles
+`
+
+type SuspendUserParams struct {
+	ID               pgtype.UUID
+	SuspensionReason pgtype.Text
+}
+
+func (q *Queries) SuspendUser(ctx context.Context, arg SuspendUserParams) (User, error) {
+	row := q.db.QueryRow(ctx, suspendUser, arg.ID, arg.SuspensionReason)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Role,
+		&i.KycStatus,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.KycFlags,
+		&i.EmailVerifiedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
+		&i.DeletionRequestedAt,
+		&i.DeletionScheduledFor,
+		&i.DeletedAt,
+		&i.Roles,
+	)
+	return i, err
+}
+
+const updateUserRole = `-- name: UpdateUserRole :one
+UPDATE users
+SET role = $2,
+    roles = ARRAY[$2]::text[],
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, email, password_hash, role, kyc_status, created_at, updated_at, kyc_flags, email_verified_at, suspended_at, suspension_reason, deletion_requested_at, deletion_scheduled_for, deleted_at, roles
+`
+
+type UpdateUserRoleParams struct {
+	ID   pgtype.UUID
+	Role string
+}
+
+// Replaces every granted role with the single given one
+func (q *Queries) UpdateUserRole(ctx context.Context, arg UpdateUserRoleParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserRole, arg.ID, arg.Role)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Role,
+		&i.KycStatus,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.KycFlags,
+		&i.EmailVerifiedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
+		&i.DeletionRequestedAt,
+		&i.DeletionScheduledFor,
+		&i.DeletedAt,
+		&i.Roles,
+	)
+	return i, err
+}
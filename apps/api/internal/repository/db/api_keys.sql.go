@@ -0,0 +1,190 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: api_keys.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAPIKey = `-- name: CreateAPIKey :one
+INSERT INTO api_keys (
+    restaurant_id,
+    created_by,
+    name,
+    prefix,
+    key_hash,
+    scopes,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING id, restaurant_id, created_by, name, prefix, key_hash, scopes, expires_at, last_used_at, revoked_at, created_at
+`
+
+type CreateAPIKeyParams struct {
+	RestaurantID pgtype.UUID
+	CreatedBy    pgtype.UUID
+	Name         string
+	Prefix       string
+	KeyHash      string
+	Scopes       []string
+	ExpiresAt    pgtype.Timestamptz
+}
+
+func (q *Queries) CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, createAPIKey,
+		arg.RestaurantID,
+		arg.CreatedBy,
+		arg.Name,
+		arg.Prefix,
+		arg.KeyHash,
+		arg.Scopes,
+		arg.ExpiresAt,
+	)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.RestaurantID,
+		&i.CreatedBy,
+		&i.Name,
+		&i.Prefix,
+		&i.KeyHash,
+		&i.Scopes,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAPIKeyForAuthentication = `-- name: GetAPIKeyForAuthentication :one
+SELECT
+    k.id,
+    k.restaurant_id,
+    k.created_by,
+    k.scopes,
+    k.expires_at,
+    k.revoked_at,
+    u.email AS user_email,
+    u.roles AS user_roles,
+    u.suspended_at AS user_suspended_at,
+    u.deleted_at AS user_deleted_at,
+    m.role AS member_role
+FROM api_keys k
+JOIN users u ON u.id = k.created_by
+LEFT JOIN restaurant_members m ON m.restaurant_id = k.restaurant_id AND m.user_id = k.created_by
+WHERE k.key_hash = $1
+`
+
+type GetAPIKeyForAuthenticationRow struct {
+	ID              pgtype.UUID
+	RestaurantID    pgtype.UUID
+	CreatedBy       pgtype.UUID
+	Scopes          []string
+	ExpiresAt       pgtype.Timestamptz
+	RevokedAt       pgtype.Timestamptz
+	UserEmail       string
+	UserRoles       []string
+	UserSuspendedAt pgtype.Timestamptz
+	UserDeletedAt   pgtype.Timestamptz
+	MemberRole      pgtype.Text
+}
+
+// member_role is NULL once the creator has left the restaurant team
+func (q *Queries) GetAPIKeyForAuthentication(ctx context.Context, keyHash string) (GetAPIKeyForAuthenticationRow, error) {
+	row := q.db.QueryRow(ctx, getAPIKeyForAuthentication, keyHash)
+	var i GetAPIKeyForAuthenticationRow
+	err := row.Scan(
+		&i.ID,
+		&i.RestaurantID,
+		&i.CreatedBy,
+		&i.Scopes,
+		&i.ExpiresAt,
+		&i.RevokedAt,
+		&i.UserEmail,
+		&i.UserRoles,
+		&i.UserSuspendedAt,
+		&i.UserDeletedAt,
+		&i.MemberRole,
+	)
+	return i, err
+}
+
+const listAPIKeysByRestaurant = `-- name: ListAPIKeysByRestaurant :many
+SELECT id, restaurant_id, created_by, name, prefix, key_hash, scopes, expires_at, last_used_at, revoked_at, created_at
+FROM api_keys
+WHERE restaurant_id = $1
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListAPIKeysByRestaurant(ctx context.Context, restaurantID pgtype.UUID) ([]ApiKey, error) {
+	rows, err := q.db.Query(ctx, listAPIKeysByRestaurant, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ApiKey
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.RestaurantID,
+			&i.CreatedBy,
+			&i.Name,
+			&i.Prefix,
+			&i.KeyHash,
+			&i.Scopes,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeAPIKey = `-- name: RevokeAPIKey :execrows
+UPDATE api_keys
+SET revoked_at = NOW()
+WHERE id = $1
+  AND restaurant_id = $2
+  AND revoked_at IS NULL
+`
+
+type RevokeAPIKeyParams struct {
+	ID           pgtype.UUID
+	RestaurantID pgtype.UUID
+}
+
+func (q *Queries) RevokeAPIKey(ctx context.Context, arg RevokeAPIKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeAPIKey, arg.ID, arg.RestaurantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const touchAPIKey = `-- name: TouchAPIKey :exec
+UPDATE api_keys
+SET last_used_at = NOW()
+WHERE id = $1
+  AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute')
+`
+
+// Recorded at most once a minute to keep authentication cheap
+func (q *Queries) TouchAPIKey(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, touchAPIKey, id)
+	return err
+}
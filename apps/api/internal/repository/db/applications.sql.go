@@ -81,6 +81,7 @@ SELECT
     a.id,
     a.job_id,
     a.chef_profile_id,
+    a.status,
     j.restaurant_id,
     rp.user_id AS restaurant_user_id,
     cp.user_id AS chef_user_id
@@ -95,6 +96,7 @@ type GetApplicationOwnershipRow struct {
 	ID               pgtype.UUID
 	JobID            pgtype.UUID
 	ChefProfileID    pgtype.UUID
+	Status           ApplicationStatus
 	RestaurantID     pgtype.UUID
 	RestaurantUserID pgtype.UUID
 	ChefUserID       pgtype.UUID
@@ -107,6 +109,7 @@ func (q *Queries) GetApplicationOwnership(ctx context.Context, id pgtype.UUID) (
 		&i.ID,
 		&i.JobID,
 		&i.ChefProfileID,
+		&i.Status,
 		&i.RestaurantID,
 		&i.RestaurantUserID,
 		&i.ChefUserID,
@@ -0,0 +1,162 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: audit_events.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createAuditEvent = `-- name: CreateAuditEvent :exec
+INSERT INTO audit_events (
+    actor_user_id,
+    actor_role,
+    api_key_id,
+    procedure,
+    action,
+    target_type,
+    target_id,
+    restaurant_id,
+    changes,
+    ip_address,
+    request_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+)
+`
+
+type CreateAuditEventParams struct {
+	ActorUserID  pgtype.UUID
+	ActorRole    pgtype.Text
+	ApiKeyID     pgtype.UUID
+	Procedure    pgtype.Text
+	Action       string
+	TargetType   string
+	TargetID     pgtype.UUID
+	RestaurantID pgtype.UUID
+	Changes      []byte
+	IpAddress    pgtype.Text
+	RequestID    pgtype.Text
+}
+
+func (q *Queries) CreateAuditEvent(ctx context.Context, arg CreateAuditEventParams) error {
+	_, err := q.db.Exec(ctx, createAuditEvent,
+		arg.ActorUserID,
+		arg.ActorRole,
+		arg.ApiKeyID,
+		arg.Procedure,
+		arg.Action,
+		arg.TargetType,
+		arg.TargetID,
+		arg.RestaurantID,
+		arg.Changes,
+		arg.IpAddress,
+		arg.RequestID,
+	)
+	return err
+}
+
+const listAuditEvents = `-- name: ListAuditEvents :many
+SELECT
+    id, actor_user_id, actor_role, api_key_id, procedure, action, target_type, target_id, restaurant_id, changes, ip_address, request_id, created_at,
+    COUNT(*) OVER() AS total_count
+FROM audit_events
+WHERE
+    ($3::uuid IS NULL OR restaurant_id = $3)
+    AND ($4::uuid IS NULL OR actor_user_id = $4)
+    AND ($5::text IS NULL OR target_type = $5)
+    AND ($6::uuid IS NULL OR target_id = $6)
+ORDER BY created_at DESC
+LIMIT $1 OFFSET $2
+`
+
+type ListAuditEventsParams struct {
+	Limit        int32
+	Offset       int32
+	RestaurantID pgtype.UUID
+	ActorUserID  pgtype.UUID
+	TargetType   pgtype.Text
+	TargetID     pgtype.UUID
+}
+
+type ListAuditEventsRow struct {
+	ID           pgtype.UUID
+	ActorUserID  pgtype.UUID
+	ActorRole    pgtype.Text
+	ApiKeyID     pgtype.UUID
+	Procedure    pgtype.Text
+	Action       string
+	TargetType   string
+	TargetID     pgtype.UUID
+	RestaurantID pgtype.UUID
+	Changes      []byte
+	IpAddress    pgtype.Text
+	RequestID    pgtype.Text
+	CreatedAt    pgtype.Timestamptz
+	TotalCount   int64
+}
+
+func (q *Queries) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]ListAuditEventsRow, error) {
+	rows, err := q.db.Query(ctx, listAuditEvents,
+		arg.Limit,
+		arg.Offset,
+		arg.RestaurantID,
+		arg.ActorUserID,
+		arg.TargetType,
+		arg.TargetID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAuditEventsRow
+	for rows.Next() {
+		var i ListAuditEventsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ActorUserID,
+			&i.ActorRole,
+			&i.ApiKeyID,
+			&i.Procedure,
+			&i.Action,
+			&i.TargetType,
+			&i.TargetID,
+			&i.RestaurantID,
+			&i.Changes,
+			&i.IpAddress,
+			&i.RequestID,
+			&i.CreatedAt,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const redactAuditEventsByUser = `-- name: RedactAuditEventsByUser :exec
+UPDATE audit_events
+SET changes = '{}'
+WHERE (target_type = 'user' AND target_id = $1)
+   OR (target_type = 'chef_profile' AND target_id IN (
+        SELECT id FROM chef_profiles WHERE user_id = $1
+   ))
+   OR (target_type = 'kyc_submission' AND target_id IN (
+        SELECT id FROM kyc_submissions WHERE user_id = $1
+   ))
+`
+
+// Drops the recorded field values of changes to the user's account, chef
+// profile and KYC submissions once the account is purged
+func (q *Queries) RedactAuditEventsByUser(ctx context.Context, targetID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, redactAuditEventsByUser, targetID)
+	return err
+}
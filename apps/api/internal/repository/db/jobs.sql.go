@@ -156,6 +156,7 @@ const getJobOwnership = `-- name: GetJobOwnership :one
 SELECT
     j.id,
     j.restaurant_id,
+    j.status,
     rp.user_id AS restaurant_user_id
 FROM jobs j
 JOIN restaurant_profiles rp ON rp.id = j.restaurant_id
@@ -165,13 +166,19 @@ WHERE j.id = $1
 type GetJobOwnershipRow struct {
 	ID               pgtype.UUID
 	RestaurantID     pgtype.UUID
+	Status           JobStatus
 	RestaurantUserID pgtype.UUID
 }
 
 func (q *Queries) GetJobOwnership(ctx context.Context, id pgtype.UUID) (GetJobOwnershipRow, error) {
 	row := q.db.QueryRow(ctx, getJobOwnership, id)
 	var i GetJobOwnershipRow
-	err := row.Scan(&i.ID, &i.RestaurantID, &i.RestaurantUserID)
+	err := row.Scan(
+		&i.ID,
+		&i.RestaurantID,
+		&i.Status,
+		&i.RestaurantUserID,
+	)
 	return i, err
 }
 
@@ -0,0 +1,382 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: kyc.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const createKYCDocument = `-- name: CreateKYCDocument :one
+INSERT INTO kyc_documents (
+    id,
+    submission_id,
+    document_type,
+    file_name,
+    content_type,
+    size_bytes,
+    sha256,
+    storage_key
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8
+)
+RETURNING id, submission_id, document_type, file_name, content_type, size_bytes, sha256, storage_key, created_at
+`
+
+type CreateKYCDocumentParams struct {
+	ID           pgtype.UUID
+	SubmissionID pgtype.UUID
+	DocumentType string
+	FileName     string
+	ContentType  string
+	SizeBytes    int64
+	Sha256       string
+	StorageKey   string
+}
+
+func (q *Queries) CreateKYCDocument(ctx context.Context, arg CreateKYCDocumentParams) (KycDocument, error) {
+	row := q.db.QueryRow(ctx, createKYCDocument,
+		arg.ID,
+		arg.SubmissionID,
+		arg.DocumentType,
+		arg.FileName,
+		arg.ContentType,
+		arg.SizeBytes,
+		arg.Sha256,
+		arg.StorageKey,
+	)
+	var i KycDocument
+	err := row.Scan(
+		&i.ID,
+		&i.SubmissionID,
+		&i.DocumentType,
+		&i.FileName,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.Sha256,
+		&i.StorageKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createKYCSubmission = `-- name: CreateKYCSubmission :one
+INSERT INTO kyc_submissions (
+    id,
+    user_id,
+    business_name,
+    registration_number,
+    business_address,
+    representative_name
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+)
+RETURNING id, user_id, status, business_name, registration_number, business_address, representative_name, rejection_reasons, reviewer_note, reviewed_by, reviewed_at, created_at, updated_at
+`
+
+type CreateKYCSubmissionParams struct {
+	ID                 pgtype.UUID
+	UserID             pgtype.UUID
+	BusinessName       string
+	RegistrationNumber string
+	BusinessAddress    string
+	RepresentativeName string
+}
+
+func (q *Queries) CreateKYCSubmission(ctx context.Context, arg CreateKYCSubmissionParams) (KycSubmission, error) {
+	row := q.db.QueryRow(ctx, createKYCSubmission,
+		arg.ID,
+		arg.UserID,
+		arg.BusinessName,
+		arg.RegistrationNumber,
+		arg.BusinessAddress,
+		arg.RepresentativeName,
+	)
+	var i KycSubmission
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.BusinessName,
+		&i.RegistrationNumber,
+		&i.BusinessAddress,
+		&i.RepresentativeName,
+		&i.RejectionReasons,
+		&i.ReviewerNote,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteKYCSubmission = `-- name: DeleteKYCSubmission :exec
+DELETE FROM kyc_submissions
+WHERE id = $1
+`
+
+func (q *Queries) DeleteKYCSubmission(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteKYCSubmission, id)
+	return err
+}
+
+const getKYCDocumentByID = `-- name: GetKYCDocumentByID :one
+SELECT id, submission_id, document_type, file_name, content_type, size_bytes, sha256, storage_key, created_at
+FROM kyc_documents
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetKYCDocumentByID(ctx context.Context, id pgtype.UUID) (KycDocument, error) {
+	row := q.db.QueryRow(ctx, getKYCDocumentByID, id)
+	var i KycDocument
+	err := row.Scan(
+		&i.ID,
+		&i.SubmissionID,
+		&i.DocumentType,
+		&i.FileName,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.Sha256,
+		&i.StorageKey,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getKYCSubmissionByID = `-- name: GetKYCSubmissionByID :one
+SELECT
+    s.id, s.user_id, s.status, s.business_name, s.registration_number, s.business_address, s.representative_name, s.rejection_reasons, s.reviewer_note, s.reviewed_by, s.reviewed_at, s.created_at, s.updated_at,
+    u.email AS user_email
+FROM kyc_submissions s
+JOIN users u ON u.id = s.user_id
+WHERE s.id = $1
+LIMIT 1
+`
+
+type GetKYCSubmissionByIDRow struct {
+	KycSubmission KycSubmission
+	UserEmail     string
+}
+
+func (q *Queries) GetKYCSubmissionByID(ctx context.Context, id pgtype.UUID) (GetKYCSubmissionByIDRow, error) {
+	row := q.db.QueryRow(ctx, getKYCSubmissionByID, id)
+	var i GetKYCSubmissionByIDRow
+	err := row.Scan(
+		&i.KycSubmission.ID,
+		&i.KycSubmission.UserID,
+		&i.KycSubmission.Status,
+		&i.KycSubmission.BusinessName,
+		&i.KycSubmission.RegistrationNumber,
+		&i.KycSubmission.BusinessAddress,
+		&i.KycSubmission.RepresentativeName,
+		&i.KycSubmission.RejectionReasons,
+		&i.KycSubmission.ReviewerNote,
+		&i.KycSubmission.ReviewedBy,
+		&i.KycSubmission.ReviewedAt,
+		&i.KycSubmission.CreatedAt,
+		&i.KycSubmission.UpdatedAt,
+		&i.UserEmail,
+	)
+	return i, err
+}
+
+const getLatestKYCSubmissionByUser = `-- name: GetLatestKYCSubmissionByUser :one
+SELECT
+    s.id, s.user_id, s.status, s.business_name, s.registration_number, s.business_address, s.representative_name, s.rejection_reasons, s.reviewer_note, s.reviewed_by, s.reviewed_at, s.created_at, s.updated_at,
+    u.email AS user_email
+FROM kyc_submissions s
+JOIN users u ON u.id = s.user_id
+WHERE s.user_id = $1
+ORDER BY s.created_at DESC
+LIMIT 1
+`
+
+type GetLatestKYCSubmissionByUserRow struct {
+	KycSubmission KycSubmission
+	UserEmail     string
+}
+
+func (q *Queries) GetLatestKYCSubmissionByUser(ctx context.Context, userID pgtype.UUID) (GetLatestKYCSubmissionByUserRow, error) {
+	row := q.db.QueryRow(ctx, getLatestKYCSubmissionByUser, userID)
+	var i GetLatestKYCSubmissionByUserRow
+	err := row.Scan(
+		&i.KycSubmission.ID,
+		&i.KycSubmission.UserID,
+		&i.KycSubmission.Status,
+		&i.KycSubmission.BusinessName,
+		&i.KycSubmission.RegistrationNumber,
+		&i.KycSubmission.BusinessAddress,
+		&i.KycSubmission.RepresentativeName,
+		&i.KycSubmission.RejectionReasons,
+		&i.KycSubmission.ReviewerNote,
+		&i.KycSubmission.ReviewedBy,
+		&i.KycSubmission.ReviewedAt,
+		&i.KycSubmission.CreatedAt,
+		&i.KycSubmission.UpdatedAt,
+		&i.UserEmail,
+	)
+	return i, err
+}
+
+const listKYCDocumentsBySubmission = `-- name: ListKYCDocumentsBySubmission :many
+SELECT id, submission_id, document_type, file_name, content_type, size_bytes, sha256, storage_key, created_at
+FROM kyc_documents
+WHERE submission_id = $1
+ORDER BY created_at ASC
+`
+
+func (q *Queries) ListKYCDocumentsBySubmission(ctx context.Context, submissionID pgtype.UUID) ([]KycDocument, error) {
+	rows, err := q.db.Query(ctx, listKYCDocumentsBySubmission, submissionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []KycDocument
+	for rows.Next() {
+		var i KycDocument
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubmissionID,
+			&i.DocumentType,
+			&i.FileName,
+			&i.ContentType,
+			&i.SizeBytes,
+			&i.Sha256,
+			&i.StorageKey,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listKYCSubmissions = `-- name: ListKYCSubmissions :many
+SELECT
+    s.id, s.user_id, s.status, s.business_name, s.registration_number, s.business_address, s.representative_name, s.rejection_reasons, s.reviewer_note, s.reviewed_by, s.reviewed_at, s.created_at, s.updated_at,
+    u.email AS user_email,
+    COUNT(*) OVER() AS total_count
+FROM kyc_submissions s
+JOIN users u ON u.id = s.user_id
+WHERE $3::text IS NULL OR s.status = $3
+ORDER BY s.created_at ASC
+LIMIT $1 OFFSET $2
+`
+
+type ListKYCSubmissionsParams struct {
+	Limit  int32
+	Offset int32
+	Status pgtype.Text
+}
+
+type ListKYCSubmissionsRow struct {
+	KycSubmission KycSubmission
+	UserEmail     string
+	TotalCount    int64
+}
+
+func (q *Queries) ListKYCSubmissions(ctx context.Context, arg ListKYCSubmissionsParams) ([]ListKYCSubmissionsRow, error) {
+	rows, err := q.db.Query(ctx, listKYCSubmissions, arg.Limit, arg.Offset, arg.Status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListKYCSubmissionsRow
+	for rows.Next() {
+		var i ListKYCSubmissionsRow
+		if err := rows.Scan(
+			&i.KycSubmission.ID,
+			&i.KycSubmission.UserID,
+			&i.KycSubmission.Status,
+			&i.KycSubmission.BusinessName,
+			&i.KycSubmission.RegistrationNumber,
+			&i.KycSubmission.BusinessAddress,
+			&i.KycSubmission.RepresentativeName,
+			&i.KycSubmission.RejectionReasons,
+			&i.KycSubmission.ReviewerNote,
+			&i.KycSubmission.ReviewedBy,
+			&i.KycSubmission.ReviewedAt,
+			&i.KycSubmission.CreatedAt,
+			&i.KycSubmission.UpdatedAt,
+			&i.UserEmail,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const reviewKYCSubmission = `-- name: ReviewKYCSubmission :one
+UPDATE kyc_submissions
+SET status = $2,
+    rejection_reasons = $3,
+    reviewer_note = $4,
+    reviewed_by = $5,
+    reviewed_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'submitted'
+RETURNING id, user_id, status, business_name, registration_number, business_address, representative_name, rejection_reasons, reviewer_note, reviewed_by, reviewed_at, created_at, updated_at
+`
+
+type ReviewKYCSubmissionParams struct {
+	ID               pgtype.UUID
+	Status           string
+	RejectionReasons []string
+	ReviewerNote     pgtype.Text
+	ReviewedBy       pgtype.UUID
+}
+
+func (q *Queries) ReviewKYCSubmission(ctx context.Context, arg ReviewKYCSubmissionParams) (KycSubmission, error) {
+	row := q.db.QueryRow(ctx, reviewKYCSubmission,
+		arg.ID,
+		arg.Status,
+		arg.RejectionReasons,
+		arg.ReviewerNote,
+		arg.ReviewedBy,
+	)
+	var i KycSubmission
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Status,
+		&i.BusinessName,
+		&i.RegistrationNumber,
+		&i.BusinessAddress,
+		&i.RepresentativeName,
+		&i.RejectionReasons,
+		&i.ReviewerNote,
+		&i.ReviewedBy,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,159 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: mfa.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const confirmTOTPCredential = `-- name: ConfirmTOTPCredential :execrows
+UPDATE user_totp_credentials
+SET confirmed_at = NOW(),
+    last_used_step = $2,
+    updated_at = NOW()
+WHERE user_id = $1
+  AND confirmed_at IS NULL
+`
+
+type ConfirmTOTPCredentialParams struct {
+	UserID       pgtype.UUID
+	LastUsedStep int64
+}
+
+func (q *Queries) ConfirmTOTPCredential(ctx context.Context, arg ConfirmTOTPCredentialParams) (int64, error) {
+	result, err := q.db.Exec(ctx, confirmTOTPCredential, arg.UserID, arg.LastUsedStep)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countUnusedRecoveryCodes = `-- name: CountUnusedRecoveryCodes :one
+SELECT COUNT(*)
+FROM mfa_recovery_codes
+WHERE user_id = $1
+  AND used_at IS NULL
+`
+
+func (q *Queries) CountUnusedRecoveryCodes(ctx context.Context, userID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countUnusedRecoveryCodes, userID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const getTOTPCredential = `-- name: GetTOTPCredential :one
+SELECT user_id, secret_encrypted, confirmed_at, last_used_step, created_at, updated_at
+FROM user_totp_credentials
+WHERE user_id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetTOTPCredential(ctx context.Context, userID pgtype.UUID) (UserTotpCredential, error) {
+	row := q.db.QueryRow(ctx, getTOTPCredential, userID)
+	var i UserTotpCredential
+	err := row.Scan(
+		&i.UserID,
+		&i.SecretEncrypted,
+		&i.ConfirmedAt,
+		&i.LastUsedStep,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const replaceRecoveryCodes = `-- name: ReplaceRecoveryCodes :exec
+WITH deleted AS (
+    DELETE FROM mfa_recovery_codes
+    WHERE user_id = $1
+)
+INSERT INTO mfa_recovery_codes (user_id, code_hash)
+SELECT $1, unnest($2::varchar[])
+`
+
+type ReplaceRecoveryCodesParams struct {
+	UserID     pgtype.UUID
+	CodeHashes []string
+}
+
+func (q *Queries) ReplaceRecoveryCodes(ctx context.Context, arg ReplaceRecoveryCodesParams) error {
+	_, err := q.db.Exec(ctx, replaceRecoveryCodes, arg.UserID, arg.CodeHashes)
+	return err
+}
+
+const upsertPendingTOTPCredential = `-- name: UpsertPendingTOTPCredential :execrows
+INSERT INTO user_totp_credentials (
+    user_id,
+    secret_encrypted
+) VALUES (
+    $1,
+    $2
+)
+ON CONFLICT (user_id) DO UPDATE
+SET secret_encrypted = EXCLUDED.secret_encrypted,
+    last_used_step = 0,
+    updated_at = NOW()
+WHERE user_totp_credentials.confirmed_at IS NULL
+`
+
+type UpsertPendingTOTPCredentialParams struct {
+	UserID          pgtype.UUID
+	SecretEncrypted []byte
+}
+
+func (q *Queries) UpsertPendingTOTPCredential(ctx context.Context, arg UpsertPendingTOTPCredentialParams) (int64, error) {
+	result, err := q.db.Exec(ctx, upsertPendingTOTPCredential, arg.UserID, arg.SecretEncrypted)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const useRecoveryCode = `-- name: UseRecoveryCode :execrows
+UPDATE mfa_recovery_codes
+SET used_at = NOW()
+WHERE user_id = $1
+  AND code_hash = $2
+  AND used_at IS NULL
+`
+
+type UseRecoveryCodeParams struct {
+	UserID   pgtype.UUID
+	CodeHash string
+}
+
+func (q *Queries) UseRecoveryCode(ctx context.Context, arg UseRecoveryCodeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useRecoveryCode, arg.UserID, arg.CodeHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const useTOTPStep = `-- name: UseTOTPStep :execrows
+UPDATE user_totp_credentials
+SET last_used_step = $2,
+    updated_at = NOW()
+WHERE user_id = $1
+  AND confirmed_at IS NOT NULL
+  AND last_used_step < $2
+`
+
+type UseTOTPStepParams struct {
+	UserID       pgtype.UUID
+	LastUsedStep int64
+}
+
+func (q *Queries) UseTOTPStep(ctx context.Context, arg UseTOTPStepParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useTOTPStep, arg.UserID, arg.LastUsedStep)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
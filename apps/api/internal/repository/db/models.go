@@ -97,6 +97,20 @@ func (ns NullJobStatus) Value() (driver.Value, error) {
 	return string(ns.JobStatus), nil
 }
 
+type ApiKey struct {
+	ID           pgtype.UUID
+	RestaurantID pgtype.UUID
+	CreatedBy    pgtype.UUID
+	Name         string
+	Prefix       string
+	KeyHash      string
+	Scopes       []string
+	ExpiresAt    pgtype.Timestamptz
+	LastUsedAt   pgtype.Timestamptz
+	RevokedAt    pgtype.Timestamptz
+	CreatedAt    pgtype.Timestamptz
+}
+
 type Application struct {
 	ID            pgtype.UUID
 	JobID         pgtype.UUID
@@ -107,6 +121,22 @@ type Application struct {
 	UpdatedAt     pgtype.Timestamp
 }
 
+type AuditEvent struct {
+	ID           pgtype.UUID
+	ActorUserID  pgtype.UUID
+	ActorRole    pgtype.Text
+	ApiKeyID     pgtype.UUID
+	Procedure    pgtype.Text
+	Action       string
+	TargetType   string
+	TargetID     pgtype.UUID
+	RestaurantID pgtype.UUID
+	Changes      []byte
+	IpAddress    pgtype.Text
+	RequestID    pgtype.Text
+	CreatedAt    pgtype.Timestamptz
+}
+
 type ChefProfile struct {
 	ID              pgtype.UUID
 	UserID          pgtype.UUID
@@ -143,6 +173,65 @@ type Job struct {
 	UpdatedAt      pgtype.Timestamp
 }
 
+type KycDocument struct {
+	ID           pgtype.UUID
+	SubmissionID pgtype.UUID
+	DocumentType string
+	FileName     string
+	ContentType  string
+	SizeBytes    int64
+	Sha256       string
+	StorageKey   string
+	CreatedAt    pgtype.Timestamptz
+}
+
+type KycSubmission struct {
+	ID                 pgtype.UUID
+	UserID             pgtype.UUID
+	Status             string
+	BusinessName       string
+	RegistrationNumber string
+	BusinessAddress    string
+	RepresentativeName string
+	RejectionReasons   []string
+	ReviewerNote       pgtype.Text
+	ReviewedBy         pgtype.UUID
+	ReviewedAt         pgtype.Timestamptz
+	CreatedAt          pgtype.Timestamptz
+	UpdatedAt          pgtype.Timestamptz
+}
+
+type MfaRecoveryCode struct {
+	ID        pgtype.UUID
+	UserID    pgtype.UUID
+	CodeHash  string
+	UsedAt    pgtype.Timestamptz
+	CreatedAt pgtype.Timestamptz
+}
+
+type RestaurantInvitation struct {
+	ID           pgtype.UUID
+	RestaurantID pgtype.UUID
+	Email        string
+	Role         string
+	TokenHash    string
+	InvitedBy    pgtype.UUID
+	ExpiresAt    pgtype.Timestamptz
+	AcceptedBy   pgtype.UUID
+	AcceptedAt   pgtype.Timestamptz
+	RevokedAt    pgtype.Timestamptz
+	CreatedAt    pgtype.Timestamptz
+}
+
+type RestaurantMember struct {
+	ID           pgtype.UUID
+	RestaurantID pgtype.UUID
+	UserID       pgtype.UUID
+	Role         string
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+}
+
 type RestaurantProfile struct {
 	ID                 pgtype.UUID
 	UserID             pgtype.UUID
@@ -164,12 +253,38 @@ type RestaurantProfile struct {
 }
 
 type User struct {
-	ID           pgtype.UUID
-	Email        string
-	PasswordHash string
-	Role         string
-	KycStatus    string
-	CreatedAt    pgtype.Timestamptz
-	UpdatedAt    pgtype.Timestamptz
-	KycFlags     []byte
+	ID                   pgtype.UUID
+	Email                string
+	PasswordHash         string
+	Role                 string
+	KycStatus            string
+	CreatedAt            pgtype.Timestamptz
+	UpdatedAt            pgtype.Timestamptz
+	KycFlags             []byte
+	EmailVerifiedAt      pgtype.Timestamptz
+	SuspendedAt          pgtype.Timestamptz
+	SuspensionReason     pgtype.Text
+	DeletionRequestedAt  pgtype.Timestamptz
+	DeletionScheduledFor pgtype.Timestamptz
+	DeletedAt            pgtype.Timestamptz
+	Roles                []string
+}
+
+type UserIdentity struct {
+	ID          pgtype.UUID
+	UserID      pgtype.UUID
+	Provider    string
+	Subject     string
+	Email       pgtype.Text
+	CreatedAt   pgtype.Timestamptz
+	LastLoginAt pgtype.Timestamptz
+}
+
+type UserTotpCredential struct {
+	UserID          pgtype.UUID
+	SecretEncrypted []byte
+	ConfirmedAt     pgtype.Timestamptz
+	LastUsedStep    int64
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
 }
@@ -0,0 +1,503 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: restaurant_members.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const acceptRestaurantInvitation = `-- name: AcceptRestaurantInvitation :execrows
+UPDATE restaurant_invitations
+SET accepted_by = $2,
+    accepted_at = NOW()
+WHERE id = $1
+  AND accepted_at IS NULL
+  AND revoked_at IS NULL
+  AND expires_at > NOW()
+`
+
+type AcceptRestaurantInvitationParams struct {
+	ID         pgtype.UUID
+	AcceptedBy pgtype.UUID
+}
+
+func (q *Queries) AcceptRestaurantInvitation(ctx context.Context, arg AcceptRestaurantInvitationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, acceptRestaurantInvitation, arg.ID, arg.AcceptedBy)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const countRestaurantMembers = `-- name: CountRestaurantMembers :one
+SELECT COUNT(*)
+FROM restaurant_members
+WHERE restaurant_id = $1
+`
+
+func (q *Queries) CountRestaurantMembers(ctx context.Context, restaurantID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countRestaurantMembers, restaurantID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countRestaurantOwners = `-- name: CountRestaurantOwners :one
+SELECT COUNT(*)
+FROM restaurant_members
+WHERE restaurant_id = $1 AND role = 'OWNER'
+`
+
+func (q *Queries) CountRestaurantOwners(ctx context.Context, restaurantID pgtype.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countRestaurantOwners, restaurantID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createRestaurantInvitation = `-- name: CreateRestaurantInvitation :one
+INSERT INTO restaurant_invitations (
+    restaurant_id,
+    email,
+    role,
+    token_hash,
+    invited_by,
+    expires_at
+) VALUES (
+    $1, $2, $3, $4, $5, $6
+)
+RETURNING id, restaurant_id, email, role, token_hash, invited_by, expires_at, accepted_by, accepted_at, revoked_at, created_at
+`
+
+type CreateRestaurantInvitationParams struct {
+	RestaurantID pgtype.UUID
+	Email        string
+	Role         string
+	TokenHash    string
+	InvitedBy    pgtype.UUID
+	ExpiresAt    pgtype.Timestamptz
+}
+
+func (q *Queries) CreateRestaurantInvitation(ctx context.Context, arg CreateRestaurantInvitationParams) (RestaurantInvitation, error) {
+	row := q.db.QueryRow(ctx, createRestaurantInvitation,
+		arg.RestaurantID,
+		arg.Email,
+		arg.Role,
+		arg.TokenHash,
+		arg.InvitedBy,
+		arg.ExpiresAt,
+	)
+	var i RestaurantInvitation
+	err := row.Scan(
+		&i.ID,
+		&i.RestaurantID,
+		&i.Email,
+		&i.Role,
+		&i.TokenHash,
+		&i.InvitedBy,
+		&i.ExpiresAt,
+		&i.AcceptedBy,
+		&i.AcceptedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const createRestaurantMember = `-- name: CreateRestaurantMember :one
+INSERT INTO restaurant_members (
+    restaurant_id,
+    user_id,
+    role
+) VALUES (
+    $1, $2, $3
+)
+RETURNING id, restaurant_id, user_id, role, created_at, updated_at
+`
+
+type CreateRestaurantMemberParams struct {
+	RestaurantID pgtype.UUID
+	UserID       pgtype.UUID
+	Role         string
+}
+
+func (q *Queries) CreateRestaurantMember(ctx context.Context, arg CreateRestaurantMemberParams) (RestaurantMember, error) {
+	row := q.db.QueryRow(ctx, createRestaurantMember, arg.RestaurantID, arg.UserID, arg.Role)
+	var i RestaurantMember
+	err := row.Scan(
+		&i.ID,
+		&i.RestaurantID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const deleteRestaurantMember = `-- name: DeleteRestaurantMember :exec
+DELETE FROM restaurant_members
+WHERE id = $1
+`
+
+func (q *Queries) DeleteRestaurantMember(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteRestaurantMember, id)
+	return err
+}
+
+const deleteRestaurantMembershipsByUser = `-- name: DeleteRestaurantMembershipsByUser :exec
+DELETE FROM restaurant_members
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteRestaurantMembershipsByUser(ctx context.Context, userID pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, deleteRestaurantMembershipsByUser, userID)
+	return err
+}
+
+const getRestaurantInvitationByTokenHash = `-- name: GetRestaurantInvitationByTokenHash :one
+SELECT i.id, i.restaurant_id, i.email, i.role, i.token_hash, i.invited_by, i.expires_at, i.accepted_by, i.accepted_at, i.revoked_at, i.created_at, rp.display_name AS restaurant_display_name
+FROM restaurant_invitations i
+JOIN restaurant_profiles rp ON rp.id = i.restaurant_id
+WHERE i.token_hash = $1
+`
+
+type GetRestaurantInvitationByTokenHashRow struct {
+	RestaurantInvitation  RestaurantInvitation
+	RestaurantDisplayName pgtype.Text
+}
+
+func (q *Queries) GetRestaurantInvitationByTokenHash(ctx context.Context, tokenHash string) (GetRestaurantInvitationByTokenHashRow, error) {
+	row := q.db.QueryRow(ctx, getRestaurantInvitationByTokenHash, tokenHash)
+	var i GetRestaurantInvitationByTokenHashRow
+	err := row.Scan(
+		&i.RestaurantInvitation.ID,
+		&i.RestaurantInvitation.RestaurantID,
+		&i.RestaurantInvitation.Email,
+		&i.RestaurantInvitation.Role,
+		&i.RestaurantInvitation.TokenHash,
+		&i.RestaurantInvitation.InvitedBy,
+		&i.RestaurantInvitation.ExpiresAt,
+		&i.RestaurantInvitation.AcceptedBy,
+		&i.RestaurantInvitation.AcceptedAt,
+		&i.RestaurantInvitation.RevokedAt,
+		&i.RestaurantInvitation.CreatedAt,
+		&i.RestaurantDisplayName,
+	)
+	return i, err
+}
+
+const getRestaurantKYCStatus = `-- name: GetRestaurantKYCStatus :one
+SELECT u.kyc_status
+FROM restaurant_profiles rp
+JOIN users u ON u.id = rp.user_id
+WHERE rp.id = $1
+`
+
+// Verification belongs to the account that created the restaurant profile
+func (q *Queries) GetRestaurantKYCStatus(ctx context.Context, id pgtype.UUID) (string, error) {
+	row := q.db.QueryRow(ctx, getRestaurantKYCStatus, id)
+	var kyc_status string
+	err := row.Scan(&kyc_status)
+	return kyc_status, err
+}
+
+const getRestaurantMember = `-- name: GetRestaurantMember :one
+SELECT id, restaurant_id, user_id, role, created_at, updated_at
+FROM restaurant_members
+WHERE restaurant_id = $1 AND user_id = $2
+`
+
+type GetRestaurantMemberParams struct {
+	RestaurantID pgtype.UUID
+	UserID       pgtype.UUID
+}
+
+func (q *Queries) GetRestaurantMember(ctx context.Context, arg GetRestaurantMemberParams) (RestaurantMember, error) {
+	row := q.db.QueryRow(ctx, getRestaurantMember, arg.RestaurantID, arg.UserID)
+	var i RestaurantMember
+	err := row.Scan(
+		&i.ID,
+		&i.RestaurantID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getRestaurantMemberByID = `-- name: GetRestaurantMemberByID :one
+SELECT m.id, m.restaurant_id, m.user_id, m.role, m.created_at, m.updated_at, u.email AS user_email
+FROM restaurant_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.id = $1
+`
+
+type GetRestaurantMemberByIDRow struct {
+	RestaurantMember RestaurantMember
+	UserEmail        string
+}
+
+func (q *Queries) GetRestaurantMemberByID(ctx context.Context, id pgtype.UUID) (GetRestaurantMemberByIDRow, error) {
+	row := q.db.QueryRow(ctx, getRestaurantMemberByID, id)
+	var i GetRestaurantMemberByIDRow
+	err := row.Scan(
+		&i.RestaurantMember.ID,
+		&i.RestaurantMember.RestaurantID,
+		&i.RestaurantMember.UserID,
+		&i.RestaurantMember.Role,
+		&i.RestaurantMember.CreatedAt,
+		&i.RestaurantMember.UpdatedAt,
+		&i.UserEmail,
+	)
+	return i, err
+}
+
+const getRestaurantMembershipByUser = `-- name: GetRestaurantMembershipByUser :one
+SELECT
+    m.id,
+    m.restaurant_id,
+    m.role,
+    rp.display_name,
+    rp.tagline,
+    rp.location
+FROM restaurant_members m
+JOIN restaurant_profiles rp ON rp.id = m.restaurant_id
+WHERE m.user_id = $1
+`
+
+type GetRestaurantMembershipByUserRow struct {
+	ID           pgtype.UUID
+	RestaurantID pgtype.UUID
+	Role         string
+	DisplayName  pgtype.Text
+	Tagline      pgtype.Text
+	Location     pgtype.Text
+}
+
+func (q *Queries) GetRestaurantMembershipByUser(ctx context.Context, userID pgtype.UUID) (GetRestaurantMembershipByUserRow, error) {
+	row := q.db.QueryRow(ctx, getRestaurantMembershipByUser, userID)
+	var i GetRestaurantMembershipByUserRow
+	err := row.Scan(
+		&i.ID,
+		&i.RestaurantID,
+		&i.Role,
+		&i.DisplayName,
+		&i.Tagline,
+		&i.Location,
+	)
+	return i, err
+}
+
+const listOpenRestaurantInvitations = `-- name: ListOpenRestaurantInvitations :many
+SELECT id, restaurant_id, email, role, token_hash, invited_by, expires_at, accepted_by, accepted_at, revoked_at, created_at
+FROM restaurant_invitations
+WHERE restaurant_id = $1
+  AND accepted_at IS NULL
+  AND revoked_at IS NULL
+ORDER BY created_at DESC
+`
+
+func (q *Queries) ListOpenRestaurantInvitations(ctx context.Context, restaurantID pgtype.UUID) ([]RestaurantInvitation, error) {
+	rows, err := q.db.Query(ctx, listOpenRestaurantInvitations, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RestaurantInvitation
+	for rows.Next() {
+		var i RestaurantInvitation
+		if err := rows.Scan(
+			&i.ID,
+			&i.RestaurantID,
+			&i.Email,
+			&i.Role,
+			&i.TokenHash,
+			&i.InvitedBy,
+			&i.ExpiresAt,
+			&i.AcceptedBy,
+			&i.AcceptedAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRestaurantMembers = `-- name: ListRestaurantMembers :many
+SELECT m.id, m.restaurant_id, m.user_id, m.role, m.created_at, m.updated_at, u.email AS user_email
+FROM restaurant_members m
+JOIN users u ON u.id = m.user_id
+WHERE m.restaurant_id = $1
+ORDER BY m.created_at ASC
+`
+
+type ListRestaurantMembersRow struct {
+	RestaurantMember RestaurantMember
+	UserEmail        string
+}
+
+func (q *Queries) ListRestaurantMembers(ctx context.Context, restaurantID pgtype.UUID) ([]ListRestaurantMembersRow, error) {
+	rows, err := q.db.Query(ctx, listRestaurantMembers, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRestaurantMembersRow
+	for rows.Next() {
+		var i ListRestaurantMembersRow
+		if err := rows.Scan(
+			&i.RestaurantMember.ID,
+			&i.RestaurantMember.RestaurantID,
+			&i.RestaurantMember.UserID,
+			&i.RestaurantMember.Role,
+			&i.RestaurantMember.CreatedAt,
+			&i.RestaurantMember.UpdatedAt,
+			&i.UserEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockRestaurantOwners = `-- name: LockRestaurantOwners :many
+SELECT id
+FROM restaurant_members
+WHERE restaurant_id = $1 AND role = 'OWNER'
+FOR UPDATE
+`
+
+// Held until the transaction ends so concurrent demotions and removals are
+// checked one at a time and cannot leave the restaurant without an owner
+func (q *Queries) LockRestaurantOwners(ctx context.Context, restaurantID pgtype.UUID) ([]pgtype.UUID, error) {
+	rows, err := q.db.Query(ctx, lockRestaurantOwners, restaurantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []pgtype.UUID
+	for rows.Next() {
+		var id pgtype.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const promoteLongestStandingRestaurantMember = `-- name: PromoteLongestStandingRestaurantMember :one
+UPDATE restaurant_members
+SET role = 'OWNER',
+    updated_at = NOW()
+WHERE id = (
+    SELECT m.id
+    FROM restaurant_members m
+    WHERE m.restaurant_id = $1
+    ORDER BY m.created_at ASC, m.id ASC
+    LIMIT 1
+)
+RETURNING id, restaurant_id, user_id, role, created_at, updated_at
+`
+
+func (q *Queries) PromoteLongestStandingRestaurantMember(ctx context.Context, restaurantID pgtype.UUID) (RestaurantMember, error) {
+	row := q.db.QueryRow(ctx, promoteLongestStandingRestaurantMember, restaurantID)
+	var i RestaurantMember
+	err := row.Scan(
+		&i.ID,
+		&i.RestaurantID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const revokeOpenRestaurantInvitation = `-- name: RevokeOpenRestaurantInvitation :exec
+UPDATE restaurant_invitations
+SET revoked_at = NOW()
+WHERE restaurant_id = $1
+  AND email = $2
+  AND accepted_at IS NULL
+  AND revoked_at IS NULL
+`
+
+type RevokeOpenRestaurantInvitationParams struct {
+	RestaurantID pgtype.UUID
+	Email        string
+}
+
+func (q *Queries) RevokeOpenRestaurantInvitation(ctx context.Context, arg RevokeOpenRestaurantInvitationParams) error {
+	_, err := q.db.Exec(ctx, revokeOpenRestaurantInvitation, arg.RestaurantID, arg.Email)
+	return err
+}
+
+const revokeRestaurantInvitation = `-- name: RevokeRestaurantInvitation :execrows
+UPDATE restaurant_invitations
+SET revoked_at = NOW()
+WHERE id = $1
+  AND restaurant_id = $2
+  AND accepted_at IS NULL
+  AND revoked_at IS NULL
+`
+
+type RevokeRestaurantInvitationParams struct {
+	ID           pgtype.UUID
+	RestaurantID pgtype.UUID
+}
+
+func (q *Queries) RevokeRestaurantInvitation(ctx context.Context, arg RevokeRestaurantInvitationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, revokeRestaurantInvitation, arg.ID, arg.RestaurantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateRestaurantMemberRole = `-- name: UpdateRestaurantMemberRole :one
+UPDATE restaurant_members
+SET role = $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, restaurant_id, user_id, role, created_at, updated_at
+`
+
+type UpdateRestaurantMemberRoleParams struct {
+	ID   pgtype.UUID
+	Role string
+}
+
+func (q *Queries) UpdateRestaurantMemberRole(ctx context.Context, arg UpdateRestaurantMemberRoleParams) (RestaurantMember, error) {
+	row := q.db.QueryRow(ctx, updateRestaurantMemberRole, arg.ID, arg.Role)
+	var i RestaurantMember
+	err := row.Scan(
+		&i.ID,
+		&i.RestaurantID,
+		&i.UserID,
+		&i.Role,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
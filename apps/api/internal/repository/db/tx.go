@@ -0,0 +1,13 @@
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Beginner starts the transactions that Queries.WithTx binds to. It is
+// satisfied by *pgxpool.Pool.
+type Beginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
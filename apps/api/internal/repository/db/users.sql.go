@@ -11,19 +11,58 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const addUserRole = `-- name: AddUserRole :one
+UPDATE users
+SET roles = array_append(roles, $2::text),
+    updated_at = NOW()
+WHERE id = $1
+  AND NOT ($2::text = ANY(roles))
+RETURNING id, email, password_hash, role, kyc_status, created_at, updated_at, kyc_flags, email_verified_at, suspended_at, suspension_reason, deletion_requested_at, deletion_scheduled_for, deleted_at, roles
+`
+
+type AddUserRoleParams struct {
+	ID   pgtype.UUID
+	Role string
+}
+
+func (q *Queries) AddUserRole(ctx context.Context, arg AddUserRoleParams) (User, error) {
+	row := q.db.QueryRow(ctx, addUserRole, arg.ID, arg.Role)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.PasswordHash,
+		&i.Role,
+		&i.KycStatus,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.KycFlags,
+		&i.EmailVerifiedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
+		&i.DeletionRequestedAt,
+		&i.DeletionScheduledFor,
+		&i.DeletedAt,
+		&i.Roles,
+	)
+	return i, err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (
     email,
     password_hash,
     role,
+    roles,
     kyc_status
 ) VALUES (
     $1,
     $2,
     $3,
+    ARRAY[$3]::text[],
     $4
 )
-RETURNING id, email, password_hash, role, kyc_status, created_at, updated_at, kyc_flags
+RETURNING id, email, password_hash, role, kyc_status, created_at, updated_at, kyc_flags, email_verified_at, suspended_at, suspension_reason, deletion_requested_at, deletion_scheduled_for, deleted_at, roles
 `
 
 type CreateUserParams struct {
@@ -50,12 +89,19 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.KycFlags,
+		&i.EmailVerifiedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
+		&i.DeletionRequestedAt,
+		&i.DeletionScheduledFor,
+		&i.DeletedAt,
+		&i.Roles,
 	)
 	return i, err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, password_hash, role, kyc_status, created_at, updated_at, kyc_flags
+SELECT id, email, password_hash, role, kyc_status, created_at, updated_at, kyc_flags, email_verified_at, suspended_at, suspension_reason, deletion_requested_at, deletion_scheduled_for, deleted_at, roles
 FROM users
 WHERE email = $1
 LIMIT 1
@@ -73,12 +119,19 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.KycFlags,
+		&i.EmailVerifiedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
+		&i.DeletionRequestedAt,
+		&i.DeletionScheduledFor,
+		&i.DeletedAt,
+		&i.Roles,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, email, password_hash, role, kyc_status, created_at, updated_at, kyc_flags
+SELECT id, email, password_hash, role, kyc_status, created_at, updated_at, kyc_flags, email_verified_at, suspended_at, suspension_reason, deletion_requested_at, deletion_scheduled_for, deleted_at, roles
 FROM users
 WHERE id = $1
 LIMIT 1
@@ -96,10 +149,50 @@ func (q *Queries) GetUserByID(ctx context.Context, id pgtype.UUID) (User, error)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.KycFlags,
+		&i.EmailVerifiedAt,
+		&i.SuspendedAt,
+		&i.SuspensionReason,
+		&i.DeletionRequestedAt,
+		&i.DeletionScheduledFor,
+		&i.DeletedAt,
+		&i.Roles,
 	)
 	return i, err
 }
 
+const markUserEmailVerified = `-- name: MarkUserEmailVerified :exec
+UPDATE users
+SET email_verified_at = COALESCE(email_verified_at, NOW()),
+    updated_at = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) MarkUserEmailVerified(ctx context.Context, id pgtype.UUID) error {
+	_, err := q.db.Exec(ctx, markUserEmailVerified, id)
+	return err
+}
+
+const setUserActiveRole = `-- name: SetUserActiveRole :execrows
+UPDATE users
+SET role = $2::text,
+    updated_at = NOW()
+WHERE id = $1
+  AND $2::text = ANY(roles)
+`
+
+type SetUserActiveRoleParams struct {
+	ID   pgtype.UUID
+	Role string
+}
+
+func (q *Queries) SetUserActiveRole(ctx context.Context, arg SetUserActiveRoleParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserActiveRole, arg.ID, arg.Role)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const updateUserKYCStatus = `-- name: UpdateUserKYCStatus :exec
 UPDATE users
 SET kyc_status = $2,
@@ -116,3 +209,20 @@ func (q *Queries) UpdateUserKYCStatus(ctx context.Context, arg UpdateUserKYCStat
 	_, err := q.db.Exec(ctx, updateUserKYCStatus, arg.ID, arg.KycStatus)
 	return err
 }
+
+const updateUserPasswordHash = `-- name: UpdateUserPasswordHash :exec
+UPDATE users
+SET password_hash = $2,
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateUserPasswordHashParams struct {
+	ID           pgtype.UUID
+	PasswordHash string
+}
+
+func (q *Queries) UpdateUserPasswordHash(ctx context.Context, arg UpdateUserPasswordHashParams) error {
+	_, err := q.db.Exec(ctx, updateUserPasswordHash, arg.ID, arg.PasswordHash)
+	return err
+}
@@ -0,0 +1,448 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/chefnext/chefnext/apps/api/internal/usecase/kyc"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrUserNotFound     = errors.New("user not found")
+	ErrInvalidRole      = errors.New("role must be CHEF or RESTAURANT")
+	ErrProtectedAccount = errors.New("admin accounts can only be managed with the admin CLI")
+	ErrAlreadySuspended = errors.New("user is already suspended")
+	ErrNotSuspended     = errors.New("user is not suspended")
+)
+
+// Audit actions recorded for admin actions; KYC reviews are recorded by the
+// kyc service.
+const (
+	ActionRoleChanged     = "role_changed"
+	ActionUserSuspended   = "user_suspended"
+	ActionUserReactivated = "user_reactivated"
+	ActionForcedLogout    = "forced_logout"
+)
+
+// Roles that can be assigned.
+const (
+	RoleChef       = "CHEF"
+	RoleRestaurant = "RESTAURANT"
+	RoleAdmin      = "ADMIN"
+)
+
+// Service implements account management for platform operators. Every
+// mutation is recorded as an audit event in the same transaction.
+type Service struct {
+	pool        db.Beginner
+	queries     *db.Queries
+	tokenStore  *auth.TokenStore
+	revocations *auth.RevocationList
+	kyc         *kyc.Service
+	audit       audit.Recorder
+}
+
+// NewService wires the admin service. kycService may be nil for tools that
+// never review KYC submissions.
+func NewService(pool db.Beginner, queries *db.Queries, tokenStore *auth.TokenStore, revocations *auth.RevocationList, kycService *kyc.Service, auditRecorder audit.Recorder) *Service {
+	return &Service{
+		pool:        pool,
+		queries:     queries,
+		tokenStore:  tokenStore,
+		revocations: revocations,
+		kyc:         kycService,
+		audit:       auditRecorder,
+	}
+}
+
+// Actor identifies the operator reviewing a KYC submission.
+type Actor struct {
+	UserID uuid.UUID
+}
+
+// User is an account as seen by operators.
+type User struct {
+	ID               uuid.UUID
+	Email            string
+	Role             string
+	Roles            []string
+	KYCStatus        string
+	EmailVerified    bool
+	SuspendedAt      *time.Time
+	SuspensionReason string
+	CreatedAt        time.Time
+}
+
+// SearchUsersInput filters users; zero values match everything.
+type SearchUsersInput struct {
+	Email         string
+	Role          string
+	SuspendedOnly bool
+	Limit         int32
+	Offset        int32
+}
+
+// UserSearchOutput wraps paginated user search results.
+type UserSearchOutput struct {
+	Users []*User
+	Total int64
+}
+
+// ChangeUserRoleInput holds the new role for a user.
+type ChangeUserRoleInput struct {
+	UserID uuid.UUID
+	Role   string
+	Reason string
+}
+
+// UserActionInput identifies the user an action applies to.
+type UserActionInput struct {
+	UserID uuid.UUID
+	Reason string
+}
+
+// userSnapshot is the audited state of a user for admin actions; empty
+// fields are omitted. Reason is the operator's justification for the action.
+type userSnapshot struct {
+	Roles            []string `json:"roles,omitempty"`
+	Suspended        *bool    `json:"suspended,omitempty"`
+	SuspensionReason string   `json:"suspension_reason,omitempty"`
+	Reason           string   `json:"reason,omitempty"`
+}
+
+// SearchUsers lists users matching the filters, newest first.
+func (s *Service) SearchUsers(ctx context.Context, input SearchUsersInput) (*UserSearchOutput, error) {
+	params := db.SearchUsersParams{
+		Limit:  clampLimit(input.Limit),
+		Offset: input.Offset,
+	}
+	if email := strings.TrimSpace(input.Email); email != "" {
+		params.Email = pgtype.Text{String: email, Valid: true}
+	}
+	if input.Role != "" {
+		params.Role = pgtype.Text{String: input.Role, Valid: true}
+	}
+	if input.SuspendedOnly {
+		params.Suspended = pgtype.Bool{Bool: true, Valid: true}
+	}
+
+	rows, err := s.queries.SearchUsers(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*User, 0, len(rows))
+	var total int64
+	for _, row := range rows {
+		user, err := mapUser(db.User{
+			ID:               row.ID,
+			Email:            row.Email,
+			Role:             row.Role,
+			Roles:            row.Roles,
+			KycStatus:        row.KycStatus,
+			CreatedAt:        row.CreatedAt,
+			EmailVerifiedAt:  row.EmailVerifiedAt,
+			SuspendedAt:      row.SuspendedAt,
+			SuspensionReason: row.SuspensionReason,
+		})
+		if err != nil {
+			return nil, err
+		}
+		total = row.TotalCount
+		users = append(users, user)
+	}
+
+	return &UserSearchOutput{Users: users, Total: total}, nil
+}
+
+// GetUser returns a single user.
+func (s *Service) GetUser(ctx context.Context, userID uuid.UUID) (*User, error) {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return mapUser(user)
+}
+
+// ChangeUserRole replaces every role of a user with CHEF or RESTAURANT and
+// signs them out so no token keeps the old roles.
+func (s *Service) ChangeUserRole(ctx context.Context, input ChangeUserRoleInput) (*User, error) {
+	if input.Role != RoleChef && input.Role != RoleRestaurant {
+		return nil, ErrInvalidRole
+	}
+
+	target, err := s.getManageableUser(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.updateRole(ctx, target, input.Role, input.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.revokeAllTokens(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	return mapUser(updated)
+}
+
+// AssignRole replaces the roles of the user with the given email with any single
+// role, including ADMIN.
+// It is meant for the admin CLI and seeds; RPCs must use ChangeUserRole.
+func (s *Service) AssignRole(ctx context.Context, email, role string) (*User, error) {
+	if role != RoleChef && role != RoleRestaurant && role != RoleAdmin {
+		return nil, errors.New("role must be CHEF, RESTAURANT or ADMIN")
+	}
+
+	target, err := s.queries.GetUserByEmail(ctx, email)
+	if err == pgx.ErrNoRows {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.updateRole(ctx, target, role, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.revokeAllTokens(ctx, uuid.UUID(target.ID.Bytes)); err != nil {
+		return nil, err
+	}
+
+	return mapUser(updated)
+}
+
+// SuspendUser blocks a user from signing in and signs out all their sessions.
+func (s *Service) SuspendUser(ctx context.Context, input UserActionInput) (*User, error) {
+	target, err := s.getManageableUser(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	reason := strings.TrimSpace(input.Reason)
+	var updated db.User
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		var err error
+		updated, err = queries.SuspendUser(ctx, db.SuspendUserParams{
+			ID:               target.ID,
+			SuspensionReason: pgtype.Text{String: reason, Valid: reason != ""},
+		})
+		if err == pgx.ErrNoRows {
+			return ErrAlreadySuspended
+		}
+		if err != nil {
+			return err
+		}
+
+		return s.audit.Record(ctx, queries, audit.Event{
+			Action:     ActionUserSuspended,
+			TargetType: audit.TargetUser,
+			TargetID:   input.UserID,
+			Before:     userSnapshot{Suspended: ptr(false)},
+			After:      userSnapshot{Suspended: ptr(true), SuspensionReason: reason},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.revokeAllTokens(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	return mapUser(updated)
+}
+
+// ReactivateUser lifts a suspension.
+func (s *Service) ReactivateUser(ctx context.Context, input UserActionInput) (*User, error) {
+	target, err := s.getManageableUser(ctx, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated db.User
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		var err error
+		updated, err = queries.ReactivateUser(ctx, target.ID)
+		if err == pgx.ErrNoRows {
+			return ErrNotSuspended
+		}
+		if err != nil {
+			return err
+		}
+
+		return s.audit.Record(ctx, queries, audit.Event{
+			Action:     ActionUserReactivated,
+			TargetType: audit.TargetUser,
+			TargetID:   input.UserID,
+			Before:     userSnapshot{Suspended: ptr(true), SuspensionReason: target.SuspensionReason.String},
+			After:      userSnapshot{Suspended: ptr(false), Reason: strings.TrimSpace(input.Reason)},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapUser(updated)
+}
+
+// ForceLogout signs out all of a user's sessions.
+func (s *Service) ForceLogout(ctx context.Context, input UserActionInput) error {
+	if _, err := s.getManageableUser(ctx, input.UserID); err != nil {
+		return err
+	}
+
+	if err := s.revokeAllTokens(ctx, input.UserID); err != nil {
+		return err
+	}
+
+	// Nothing in the database changes, so the event is recorded on its own
+	return s.audit.Record(ctx, s.queries, audit.Event{
+		Action:     ActionForcedLogout,
+		TargetType: audit.TargetUser,
+		TargetID:   input.UserID,
+		After:      userSnapshot{Reason: strings.TrimSpace(input.Reason)},
+	})
+}
+
+// KYCReviewInput records a decision on a KYC submission.
+type KYCReviewInput struct {
+	SubmissionID uuid.UUID
+	Reasons      []string
+	Note         string
+}
+
+// ApproveKYC verifies the user behind a KYC submission. The kyc service
+// records the decision.
+func (s *Service) ApproveKYC(ctx context.Context, actor Actor, input KYCReviewInput) (*kyc.Submission, error) {
+	return s.kyc.Approve(ctx, kyc.ReviewInput{
+		SubmissionID: input.SubmissionID,
+		ReviewerID:   actor.UserID,
+		Note:         input.Note,
+	})
+}
+
+// RejectKYC rejects a KYC submission with reasons shown to the user.
+func (s *Service) RejectKYC(ctx context.Context, actor Actor, input KYCReviewInput) (*kyc.Submission, error) {
+	return s.kyc.Reject(ctx, kyc.ReviewInput{
+		SubmissionID: input.SubmissionID,
+		ReviewerID:   actor.UserID,
+		Reasons:      input.Reasons,
+		Note:         input.Note,
+	})
+}
+
+func (s *Service) getUser(ctx context.Context, userID uuid.UUID) (db.User, error) {
+	user, err := s.queries.GetUserByID(ctx, toPgUUID(userID))
+	if err == pgx.ErrNoRows {
+		return db.User{}, ErrUserNotFound
+	}
+	return user, err
+}
+
+// getManageableUser loads a user that RPCs may act on; admins, including the
+// caller, are out of reach so one compromised admin cannot lock out the others
+func (s *Service) getManageableUser(ctx context.Context, userID uuid.UUID) (db.User, error) {
+	user, err := s.getUser(ctx, userID)
+	if err != nil {
+		return db.User{}, err
+	}
+	if slices.Contains(user.Roles, RoleAdmin) {
+		return db.User{}, ErrProtectedAccount
+	}
+	return user, nil
+}
+
+// revokeAllTokens ends every refresh token family and rejects access tokens
+// issued so far
+func (s *Service) revokeAllTokens(ctx context.Context, userID uuid.UUID) error {
+	if err := s.tokenStore.RevokeAllUserTokens(ctx, userID); err != nil {
+		return err
+	}
+	return s.revocations.RevokeUserTokens(ctx, userID)
+}
+
+// updateRole replaces the user's roles and records the change in one
+// transaction
+func (s *Service) updateRole(ctx context.Context, target db.User, role, reason string) (db.User, error) {
+	var updated db.User
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		var err error
+		updated, err = queries.UpdateUserRole(ctx, db.UpdateUserRoleParams{
+			ID:   target.ID,
+			Role: role,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.audit.Record(ctx, queries, audit.Event{
+			Action:     ActionRoleChanged,
+			TargetType: audit.TargetUser,
+			TargetID:   uuid.UUID(target.ID.Bytes),
+			Before:     userSnapshot{Roles: target.Roles},
+			After:      userSnapshot{Roles: updated.Roles, Reason: strings.TrimSpace(reason)},
+		})
+	})
+	return updated, err
+}
+
+func mapUser(user db.User) (*User, error) {
+	id, err := uuid.FromBytes(user.ID.Bytes[:])
+	if err != nil {
+		return nil, err
+	}
+
+	mapped := &User{
+		ID:               id,
+		Email:            user.Email,
+		Role:             user.Role,
+		Roles:            user.Roles,
+		KYCStatus:        user.KycStatus,
+		EmailVerified:    user.EmailVerifiedAt.Valid,
+		SuspensionReason: user.SuspensionReason.String,
+		CreatedAt:        user.CreatedAt.Time,
+	}
+	if user.SuspendedAt.Valid {
+		suspendedAt := user.SuspendedAt.Time
+		mapped.SuspendedAt = &suspendedAt
+	}
+	return mapped, nil
+}
+
+func clampLimit(limit int32) int32 {
+	if limit <= 0 {
+		return 20
+	}
+	if limit > 100 {
+		return 100
+	}
+	return limit
+}
+
+func toPgUUID(id uuid.UUID) pgtype.UUID {
+	return pgtype.UUID{Bytes: id, Valid: true}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,237 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/chefnext/chefnext/apps/api/internal/usecase/restaurantteam"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const maxNameLength = 100
+
+var (
+	ErrInvalidName   = errors.New("api key name is required and must be at most 100 characters")
+	ErrInvalidScopes = errors.New("api key needs at least one known scope")
+	ErrInvalidExpiry = errors.New("api key expiry must be in the future")
+	ErrKeyNotFound   = errors.New("api key not found")
+)
+
+// Service manages restaurant API keys for machine integrations.
+type Service struct {
+	queries *db.Queries
+}
+
+// NewService wires the API key service.
+func NewService(queries *db.Queries) *Service {
+	return &Service{queries: queries}
+}
+
+// Key describes an API key without its secret.
+type Key struct {
+	ID         uuid.UUID
+	CreatedBy  uuid.UUID
+	Name       string
+	Prefix     string
+	Scopes     []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+}
+
+// CreatedKey carries the secret, which is only available at creation.
+type CreatedKey struct {
+	Key    *Key
+	Secret string
+}
+
+// CreateInput describes a new key.
+type CreateInput struct {
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time
+}
+
+// Create issues a key for the caller's restaurant that acts on the caller's
+// behalf.
+func (s *Service) Create(ctx context.Context, userID uuid.UUID, input CreateInput) (*CreatedKey, error) {
+	membership, err := s.authorize(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" || utf8.RuneCountInString(name) > maxNameLength {
+		return nil, ErrInvalidName
+	}
+
+	scopes, err := normalizeScopes(input.Scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := pgtype.Timestamptz{}
+	if input.ExpiresAt != nil {
+		if !input.ExpiresAt.After(time.Now()) {
+			return nil, ErrInvalidExpiry
+		}
+		expiresAt = pgtype.Timestamptz{Time: *input.ExpiresAt, Valid: true}
+	}
+
+	secret, prefix, hash, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := s.queries.CreateAPIKey(ctx, db.CreateAPIKeyParams{
+		RestaurantID: membership.RestaurantID,
+		CreatedBy:    pgtype.UUID{Bytes: userID, Valid: true},
+		Name:         name,
+		Prefix:       prefix,
+		KeyHash:      hash,
+		Scopes:       scopes,
+		ExpiresAt:    expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreatedKey{Key: mapKey(row), Secret: secret}, nil
+}
+
+// List returns every key of the caller's restaurant, including revoked ones.
+func (s *Service) List(ctx context.Context, userID uuid.UUID) ([]*Key, error) {
+	membership, err := s.authorize(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListAPIKeysByRestaurant(ctx, membership.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*Key, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, mapKey(row))
+	}
+	return keys, nil
+}
+
+// Revoke disables a key immediately.
+func (s *Service) Revoke(ctx context.Context, userID, keyID uuid.UUID) error {
+	membership, err := s.authorize(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	rows, err := s.queries.RevokeAPIKey(ctx, db.RevokeAPIKeyParams{
+		ID:           pgtype.UUID{Bytes: keyID, Valid: true},
+		RestaurantID: membership.RestaurantID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrKeyNotFound
+	}
+	return nil
+}
+
+// Authenticate resolves a presented key to the member it acts for. Keys stop
+// working when revoked or expired, and when their creator is suspended,
+// deleted or no longer on the restaurant team.
+func (s *Service) Authenticate(ctx context.Context, key string) (*auth.APIKeyPrincipal, error) {
+	row, err := s.queries.GetAPIKeyForAuthentication(ctx, auth.HashAPIKey(key))
+	if err == pgx.ErrNoRows {
+		return nil, auth.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case row.RevokedAt.Valid,
+		row.ExpiresAt.Valid && !row.ExpiresAt.Time.After(time.Now()),
+		row.UserSuspendedAt.Valid,
+		row.UserDeletedAt.Valid,
+		!row.MemberRole.Valid:
+		return nil, auth.ErrInvalidAPIKey
+	}
+
+	// Usage tracking must not turn a valid key into a failed request
+	_ = s.queries.TouchAPIKey(ctx, row.ID)
+
+	return &auth.APIKeyPrincipal{
+		KeyID:  uuid.UUID(row.ID.Bytes),
+		UserID: uuid.UUID(row.CreatedBy.Bytes),
+		Email:  row.UserEmail,
+		Roles:  row.UserRoles,
+		Scopes: row.Scopes,
+	}, nil
+}
+
+func (s *Service) authorize(ctx context.Context, userID uuid.UUID) (*db.GetRestaurantMembershipByUserRow, error) {
+	membership, err := s.queries.GetRestaurantMembershipByUser(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+	if err == pgx.ErrNoRows {
+		return nil, restaurantteam.ErrNotMember
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !restaurantteam.Allows(membership.Role, restaurantteam.PermissionManageAPIKeys) {
+		return nil, restaurantteam.ErrForbidden
+	}
+	return &membership, nil
+}
+
+// normalizeScopes drops duplicates and rejects unknown scopes.
+func normalizeScopes(scopes []string) ([]string, error) {
+	normalized := make([]string, 0, len(scopes))
+	seen := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		if !auth.ValidAPIKeyScope(scope) {
+			return nil, ErrInvalidScopes
+		}
+		if seen[scope] {
+			continue
+		}
+		seen[scope] = true
+		normalized = append(normalized, scope)
+	}
+	if len(normalized) == 0 {
+		return nil, ErrInvalidScopes
+	}
+	return normalized, nil
+}
+
+func mapKey(row db.ApiKey) *Key {
+	return &Key{
+		ID:         uuid.UUID(row.ID.Bytes),
+		CreatedBy:  uuid.UUID(row.CreatedBy.Bytes),
+		Name:       row.Name,
+		Prefix:     row.Prefix,
+		Scopes:     row.Scopes,
+		ExpiresAt:  timePointer(row.ExpiresAt),
+		LastUsedAt: timePointer(row.LastUsedAt),
+		RevokedAt:  timePointer(row.RevokedAt),
+		CreatedAt:  row.CreatedAt.Time,
+	}
+}
+
+func timePointer(value pgtype.Timestamptz) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	t := value.Time
+	return &t
+}
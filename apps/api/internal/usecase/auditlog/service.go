@@ -0,0 +1,197 @@
+package auditlog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/chefnext/chefnext/apps/api/internal/usecase/restaurantteam"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrNotMember = errors.New("not a member of a restaurant team")
+	ErrForbidden = errors.New("only restaurant owners can view the audit log")
+)
+
+// Service records audit events and lists them for restaurant owners and
+// admins.
+type Service struct {
+	queries *db.Queries
+	log     *slog.Logger
+}
+
+// NewService wires the audit log service.
+func NewService(queries *db.Queries, log *slog.Logger) *Service {
+	return &Service{queries: queries, log: log}
+}
+
+// Event is one recorded change.
+type Event struct {
+	ID           uuid.UUID
+	ActorUserID  uuid.UUID
+	ActorRole    string
+	APIKeyID     uuid.UUID
+	Procedure    string
+	Action       string
+	TargetType   string
+	TargetID     uuid.UUID
+	RestaurantID uuid.UUID
+	Changes      json.RawMessage
+	IPAddress    string
+	RequestID    string
+	CreatedAt    time.Time
+}
+
+// ListInput filters audit events; zero values match everything.
+type ListInput struct {
+	RestaurantID uuid.UUID
+	ActorUserID  uuid.UUID
+	TargetType   string
+	TargetID     uuid.UUID
+	Limit        int32
+	Offset       int32
+}
+
+// ListOutput wraps paginated audit events.
+type ListOutput struct {
+	Events []*Event
+	Total  int64
+}
+
+// Record stores an event through queries, attributing it to the request in
+// ctx. Callers bind queries to the transaction of the change, so a failure
+// here rolls the change back.
+func (s *Service) Record(ctx context.Context, queries *db.Queries, event audit.Event) error {
+	changes, err := audit.Diff(event.Before, event.After)
+	if err != nil {
+		s.log.ErrorContext(ctx, "encode audit event failed", slog.String("action", event.Action), slog.Any("error", err))
+		return err
+	}
+	payload, err := json.Marshal(changes)
+	if err != nil {
+		s.log.ErrorContext(ctx, "encode audit event failed", slog.String("action", event.Action), slog.Any("error", err))
+		return err
+	}
+
+	request, _ := audit.RequestFromContext(ctx)
+	actorUserID := request.ActorUserID
+	if event.ActorUserID != uuid.Nil {
+		actorUserID = event.ActorUserID
+	}
+
+	params := db.CreateAuditEventParams{
+		ActorUserID:  pgUUID(actorUserID),
+		ActorRole:    pgText(request.ActorRole),
+		ApiKeyID:     pgUUID(request.APIKeyID),
+		Procedure:    pgText(request.Procedure),
+		Action:       event.Action,
+		TargetType:   event.TargetType,
+		TargetID:     pgtype.UUID{Bytes: event.TargetID, Valid: true},
+		RestaurantID: pgUUID(event.RestaurantID),
+		Changes:      payload,
+		IpAddress:    pgText(request.IPAddress),
+		RequestID:    pgText(request.RequestID),
+	}
+
+	if err := queries.CreateAuditEvent(ctx, params); err != nil {
+		s.log.ErrorContext(ctx, "record audit event failed",
+			slog.String("action", event.Action),
+			slog.String("target_type", event.TargetType),
+			slog.String("target_id", event.TargetID.String()),
+			slog.Any("error", err),
+		)
+		return err
+	}
+	return nil
+}
+
+// ListForRestaurant lists events on the resources of the restaurant the user
+// owns, newest first.
+func (s *Service) ListForRestaurant(ctx context.Context, userID uuid.UUID, input ListInput) (*ListOutput, error) {
+	membership, err := s.queries.GetRestaurantMembershipByUser(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+	if err == pgx.ErrNoRows {
+		return nil, ErrNotMember
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !restaurantteam.Allows(membership.Role, restaurantteam.PermissionViewAuditLog) {
+		return nil, ErrForbidden
+	}
+
+	input.RestaurantID = uuid.UUID(membership.RestaurantID.Bytes)
+	return s.List(ctx, input)
+}
+
+// List lists events across the platform, newest first.
+func (s *Service) List(ctx context.Context, input ListInput) (*ListOutput, error) {
+	rows, err := s.queries.ListAuditEvents(ctx, db.ListAuditEventsParams{
+		Limit:        clampLimit(input.Limit),
+		Offset:       max(input.Offset, 0),
+		RestaurantID: pgUUID(input.RestaurantID),
+		ActorUserID:  pgUUID(input.ActorUserID),
+		TargetType:   pgText(input.TargetType),
+		TargetID:     pgUUID(input.TargetID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*Event, 0, len(rows))
+	var total int64
+	for _, row := range rows {
+		events = append(events, &Event{
+			ID:           uuid.UUID(row.ID.Bytes),
+			ActorUserID:  uuidOrNil(row.ActorUserID),
+			ActorRole:    row.ActorRole.String,
+			APIKeyID:     uuidOrNil(row.ApiKeyID),
+			Procedure:    row.Procedure.String,
+			Action:       row.Action,
+			TargetType:   row.TargetType,
+			TargetID:     uuid.UUID(row.TargetID.Bytes),
+			RestaurantID: uuidOrNil(row.RestaurantID),
+			Changes:      json.RawMessage(row.Changes),
+			IPAddress:    row.IpAddress.String,
+			RequestID:    row.RequestID.String,
+			CreatedAt:    row.CreatedAt.Time,
+		})
+		total = row.TotalCount
+	}
+
+	return &ListOutput{Events: events, Total: total}, nil
+}
+
+func clampLimit(limit int32) int32 {
+	if limit <= 0 {
+		return 20
+	}
+	if limit > 100 {
+		return 100
+	}
+	return limit
+}
+
+func pgUUID(id uuid.UUID) pgtype.UUID {
+	if id == uuid.Nil {
+		return pgtype.UUID{}
+	}
+	return pgtype.UUID{Bytes: id, Valid: true}
+}
+
+func pgText(value string) pgtype.Text {
+	return pgtype.Text{String: value, Valid: value != ""}
+}
+
+func uuidOrNil(id pgtype.UUID) uuid.UUID {
+	if !id.Valid {
+		return uuid.Nil
+	}
+	return uuid.UUID(id.Bytes)
+}
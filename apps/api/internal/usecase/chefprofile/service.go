@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
 	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -20,14 +21,22 @@ var (
 	ErrUnauthorizedProfileAccess = errors.New("cannot modify another user's profile")
 )
 
+// Audit actions recorded for chef profiles.
+const (
+	ActionProfileCreated = "chef_profile_created"
+	ActionProfileUpdated = "chef_profile_updated"
+)
+
 // Service coordinates chef profile operations against the database.
 type Service struct {
+	pool    db.Beginner
 	queries *db.Queries
+	audit   audit.Recorder
 }
 
 // NewService constructs a new Service instance.
-func NewService(queries *db.Queries) *Service {
-	return &Service{queries: queries}
+func NewService(pool db.Beginner, queries *db.Queries, auditRecorder audit.Recorder) *Service {
+	return &Service{pool: pool, queries: queries, audit: auditRecorder}
 }
 
 // Profile represents a chef profile in domain form.
@@ -108,10 +117,7 @@ func (s *Service) CreateProfile(ctx context.Context, input CreateInput) (*Profil
 		return nil, err
 	}
 
-	var userID pgtype.UUID
-	if err := userID.Scan(input.UserID[:]); err != nil {
-		return nil, err
-	}
+	userID := pgtype.UUID{Bytes: input.UserID, Valid: true}
 
 	if _, err := s.queries.GetChefProfileByUserID(ctx, userID); err == nil {
 		return nil, ErrProfileAlreadyExists
@@ -121,35 +127,51 @@ func (s *Service) CreateProfile(ctx context.Context, input CreateInput) (*Profil
 
 	skillTreeBytes := normalizeSkillTreeBytes(input.SkillTreeJSON)
 
-	profile, err := s.queries.CreateChefProfile(ctx, db.CreateChefProfileParams{
-		UserID:          userID,
-		FullName:        pgtype.Text{String: input.FullName, Valid: input.FullName != ""},
-		Headline:        pgtype.Text{String: input.Headline, Valid: input.Headline != ""},
-		Summary:         pgtype.Text{String: input.Summary, Valid: input.Summary != ""},
-		Location:        pgtype.Text{String: input.Location, Valid: input.Location != ""},
-		YearsExperience: pgtype.Int4{Int32: input.YearsExperience, Valid: true},
-		Availability:    pgtype.Text{String: input.Availability, Valid: input.Availability != ""},
-		Specialties:     input.Specialties,
-		WorkAreas:       input.WorkAreas,
-		Languages:       input.Languages,
-		Bio:             pgtype.Text{String: input.Bio, Valid: input.Bio != ""},
-		LearningFocus:   input.LearningFocus,
-		SkillTreeJson:   skillTreeBytes,
-		PortfolioItems:  input.PortfolioItems,
+	var created *Profile
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		profile, err := queries.CreateChefProfile(ctx, db.CreateChefProfileParams{
+			UserID:          userID,
+			FullName:        pgtype.Text{String: input.FullName, Valid: input.FullName != ""},
+			Headline:        pgtype.Text{String: input.Headline, Valid: input.Headline != ""},
+			Summary:         pgtype.Text{String: input.Summary, Valid: input.Summary != ""},
+			Location:        pgtype.Text{String: input.Location, Valid: input.Location != ""},
+			YearsExperience: pgtype.Int4{Int32: input.YearsExperience, Valid: true},
+			Availability:    pgtype.Text{String: input.Availability, Valid: input.Availability != ""},
+			Specialties:     input.Specialties,
+			WorkAreas:       input.WorkAreas,
+			Languages:       input.Languages,
+			Bio:             pgtype.Text{String: input.Bio, Valid: input.Bio != ""},
+			LearningFocus:   input.LearningFocus,
+			SkillTreeJson:   skillTreeBytes,
+			PortfolioItems:  input.PortfolioItems,
+		})
+		if err != nil {
+			return err
+		}
+		created, err = mapChefProfile(profile)
+		if err != nil {
+			return err
+		}
+
+		return s.audit.Record(ctx, queries, audit.Event{
+			Action:     ActionProfileCreated,
+			TargetType: audit.TargetChefProfile,
+			TargetID:   created.ID,
+			After:      auditProfile(created),
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return mapChefProfile(profile)
+	return created, nil
 }
 
 // GetProfile fetches a profile by its identifier.
 func (s *Service) GetProfile(ctx context.Context, profileID uuid.UUID) (*Profile, error) {
-	var pgID pgtype.UUID
-	if err := pgID.Scan(profileID[:]); err != nil {
-		return nil, err
-	}
+	pgID := pgtype.UUID{Bytes: profileID, Valid: true}
 
 	profile, err := s.queries.GetChefProfileByID(ctx, pgID)
 	if err == pgx.ErrNoRows {
@@ -164,10 +186,7 @@ func (s *Service) GetProfile(ctx context.Context, profileID uuid.UUID) (*Profile
 
 // GetProfileByUser fetches the current user's chef profile.
 func (s *Service) GetProfileByUser(ctx context.Context, userID uuid.UUID) (*Profile, error) {
-	var pgID pgtype.UUID
-	if err := pgID.Scan(userID[:]); err != nil {
-		return nil, err
-	}
+	pgID := pgtype.UUID{Bytes: userID, Valid: true}
 
 	profile, err := s.queries.GetChefProfileByUserID(ctx, pgID)
 	if err == pgx.ErrNoRows {
@@ -182,10 +201,7 @@ func (s *Service) GetProfileByUser(ctx context.Context, userID uuid.UUID) (*Prof
 
 // UpdateProfile applies partial changes to an existing chef profile.
 func (s *Service) UpdateProfile(ctx context.Context, input UpdateInput) (*Profile, error) {
-	var pgProfileID pgtype.UUID
-	if err := pgProfileID.Scan(input.ProfileID[:]); err != nil {
-		return nil, err
-	}
+	pgProfileID := pgtype.UUID{Bytes: input.ProfileID, Valid: true}
 
 	existing, err := s.queries.GetChefProfileByID(ctx, pgProfileID)
 	if err == pgx.ErrNoRows {
@@ -276,12 +292,37 @@ func (s *Service) UpdateProfile(ctx context.Context, input UpdateInput) (*Profil
 		params.PortfolioItems = *input.PortfolioItems
 	}
 
-	updated, err := s.queries.UpdateChefProfile(ctx, params)
+	before, err := mapChefProfile(existing)
 	if err != nil {
 		return nil, err
 	}
 
-	return mapChefProfile(updated)
+	var after *Profile
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		updated, err := queries.UpdateChefProfile(ctx, params)
+		if err != nil {
+			return err
+		}
+		after, err = mapChefProfile(updated)
+		if err != nil {
+			return err
+		}
+
+		return s.audit.Record(ctx, queries, audit.Event{
+			Action:     ActionProfileUpdated,
+			TargetType: audit.TargetChefProfile,
+			TargetID:   after.ID,
+			Before:     auditProfile(before),
+			After:      auditProfile(after),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return after, nil
 }
 
 // SearchProfiles lists chef profiles matching the provided filters.
@@ -384,6 +425,49 @@ func mapChefProfile(row db.ChefProfile) (*Profile, error) {
 	}, nil
 }
 
+// profileSnapshot is the audited state of a chef profile.
+type profileSnapshot struct {
+	FullName        *string         `json:"full_name"`
+	Headline        *string         `json:"headline"`
+	Summary         *string         `json:"summary"`
+	Location        *string         `json:"location"`
+	YearsExperience *int32          `json:"years_experience"`
+	Availability    *string         `json:"availability"`
+	Specialties     []string        `json:"specialties"`
+	WorkAreas       []string        `json:"work_areas"`
+	Languages       []string        `json:"languages"`
+	Bio             *string         `json:"bio"`
+	LearningFocus   []string        `json:"learning_focus"`
+	SkillTree       json.RawMessage `json:"skill_tree"`
+	PortfolioItems  json.RawMessage `json:"portfolio_items"`
+}
+
+func auditProfile(profile *Profile) profileSnapshot {
+	return profileSnapshot{
+		FullName:        profile.FullName,
+		Headline:        profile.Headline,
+		Summary:         profile.Summary,
+		Location:        profile.Location,
+		YearsExperience: profile.YearsExperience,
+		Availability:    profile.Availability,
+		Specialties:     profile.Specialties,
+		WorkAreas:       profile.WorkAreas,
+		Languages:       profile.Languages,
+		Bio:             profile.Bio,
+		LearningFocus:   profile.LearningFocus,
+		SkillTree:       rawJSON([]byte(profile.SkillTreeJSON)),
+		PortfolioItems:  rawJSON(profile.PortfolioItems),
+	}
+}
+
+// rawJSON embeds a stored JSON column, or null when it is unset.
+func rawJSON(value []byte) json.RawMessage {
+	if len(value) == 0 || !json.Valid(value) {
+		return nil
+	}
+	return json.RawMessage(value)
+}
+
 func validateSkillTreeJSON(raw string) error {
 	if strings.TrimSpace(raw) == "" {
 		return nil
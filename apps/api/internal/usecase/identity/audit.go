@@ -0,0 +1,37 @@
+package identity
+
+import (
+	"time"
+)
+
+// Audit actions recorded for accounts
+const (
+	ActionUserCreated              = "user_created"
+	ActionEmailVerified            = "email_verified"
+	ActionPasswordChanged          = "password_changed"
+	ActionPasswordReset            = "password_reset"
+	ActionMFAEnabled               = "mfa_enabled"
+	ActionRecoveryCodesRegenerated = "mfa_recovery_codes_regenerated"
+	ActionRoleAdded                = "role_added"
+	ActionIdentityLinked           = "identity_linked"
+	ActionIdentityUnlinked         = "identity_unlinked"
+	ActionAccountDeletionScheduled = "account_deletion_scheduled"
+	ActionAccountDeletionCancelled = "account_deletion_cancelled"
+	ActionAccountPurged            = "account_purged"
+)
+
+// accountSnapshot is the audited state of an account; fields left empty are
+// omitted so each event only shows what the action is about. Secrets such as
+// password hashes are never part of it
+type accountSnapshot struct {
+	Email                *string    `json:"email,omitempty"`
+	Roles                []string   `json:"roles,omitempty"`
+	EmailVerified        *bool      `json:"email_verified,omitempty"`
+	MFAEnabled           *bool      `json:"mfa_enabled,omitempty"`
+	Provider             string     `json:"provider,omitempty"`
+	DeletionScheduledFor *time.Time `json:"deletion_scheduled_for,omitempty"`
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
@@ -0,0 +1,133 @@
+package identity
+
+import (
+	"context"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ChangePasswordUseCase replaces the password of an authenticated user
+type ChangePasswordUseCase struct {
+	pool           db.Beginner
+	queries        *db.Queries
+	jwtManager     *auth.JWTManager
+	tokenStore     *auth.TokenStore
+	revocations    *auth.RevocationList
+	passwordPolicy *auth.PasswordPolicy
+	argon2Params   *auth.Argon2Params
+	audit          audit.Recorder
+}
+
+// NewChangePasswordUseCase creates a new change password use case
+func NewChangePasswordUseCase(pool db.Beginner, queries *db.Queries, jwtManager *auth.JWTManager, tokenStore *auth.TokenStore, revocations *auth.RevocationList, passwordPolicy *auth.PasswordPolicy, argon2Params *auth.Argon2Params, auditRecorder audit.Recorder) *ChangePasswordUseCase {
+	return &ChangePasswordUseCase{
+		pool:           pool,
+		queries:        queries,
+		jwtManager:     jwtManager,
+		tokenStore:     tokenStore,
+		revocations:    revocations,
+		passwordPolicy: passwordPolicy,
+		argon2Params:   argon2Params,
+		audit:          auditRecorder,
+	}
+}
+
+// ChangePasswordInput represents change password input
+type ChangePasswordInput struct {
+	UserID          uuid.UUID
+	CurrentPassword string
+	NewPassword     string
+	Session         auth.SessionMetadata
+	// MFA carries over whether the calling session passed a second factor
+	MFA bool
+}
+
+// ChangePasswordOutput represents change password output
+type ChangePasswordOutput struct {
+	AccessToken  string
+	RefreshToken string
+}
+
+// Execute executes the change password use case
+func (uc *ChangePasswordUseCase) Execute(ctx context.Context, input ChangePasswordInput) (*ChangePasswordOutput, error) {
+	if input.NewPassword == "" {
+		return nil, ErrPasswordRequired
+	}
+
+	pgUserID := pgtype.UUID{Bytes: input.UserID, Valid: true}
+
+	user, err := uc.queries.GetUserByID(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Re-verify the current password
+	match, err := auth.VerifyPassword(input.CurrentPassword, user.PasswordHash)
+	if err != nil {
+		return nil, err
+	}
+	if !match {
+		return nil, ErrInvalidCredentials
+	}
+
+	if err := uc.passwordPolicy.Check(input.NewPassword, user.Email); err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := auth.HashPassword(input.NewPassword, uc.argon2Params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pgx.BeginFunc(ctx, uc.pool, func(tx pgx.Tx) error {
+		queries := uc.queries.WithTx(tx)
+
+		if err := queries.UpdateUserPasswordHash(ctx, db.UpdateUserPasswordHashParams{
+			ID:           pgUserID,
+			PasswordHash: passwordHash,
+		}); err != nil {
+			return err
+		}
+
+		return uc.audit.Record(ctx, queries, audit.Event{
+			Action:     ActionPasswordChanged,
+			TargetType: audit.TargetUser,
+			TargetID:   input.UserID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Revoke every outstanding token, then sign the caller back in
+	if err := uc.tokenStore.RevokeAllUserTokens(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+	if err := uc.revocations.RevokeUserTokens(ctx, input.UserID); err != nil {
+		return nil, err
+	}
+
+	tokens, err := startSession(ctx, uc.jwtManager, uc.tokenStore, auth.TokenSubject{
+		UserID: input.UserID,
+		Email:  user.Email,
+		Role:   user.Role,
+		Roles:  user.Roles,
+		MFA:    input.MFA,
+	}, input.Session)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ChangePasswordOutput{
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}, nil
+}
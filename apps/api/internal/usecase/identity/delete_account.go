@@ -0,0 +1,506 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/mail"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/storage"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/chefnext/chefnext/apps/api/internal/usecase/restaurantteam"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	// accountPurgeBatchSize bounds how many accounts one purge pass anonymises
+	accountPurgeBatchSize = 100
+	// accountDeletionConfirmationTTL is how long a mailed deletion confirmation stays valid
+	accountDeletionConfirmationTTL = 30 * time.Minute
+)
+
+var (
+	ErrDeletionAlreadyScheduled = errors.New("account deletion is already scheduled")
+	ErrDeletionNotScheduled     = errors.New("account deletion is not scheduled")
+	ErrSoleRestaurantOwner      = errors.New("make another team member an owner before deleting this account")
+
+	// errDeletionNoLongerDue skips an account whose deletion was cancelled or
+	// purged since it was listed
+	errDeletionNoLongerDue = errors.New("account deletion is no longer due")
+)
+
+// AccountDeletionUseCase schedules, cancels and carries out account deletion.
+// Deletion waits for a grace period; afterwards personal data is removed and
+// records the other party still needs, like applications, are anonymised
+type AccountDeletionUseCase struct {
+	pool          db.Beginner
+	queries       *db.Queries
+	verifier      *mfaVerifier
+	tokenStore    *auth.TokenStore
+	revocations   *auth.RevocationList
+	oneTimeTokens *auth.OneTimeTokenStore
+	blobs         storage.BlobStore
+	mailer        mail.Sender
+	appBaseURL    string
+	gracePeriod   time.Duration
+	audit         audit.Recorder
+	log           *slog.Logger
+}
+
+// NewAccountDeletionUseCase creates a new account deletion use case
+func NewAccountDeletionUseCase(pool db.Beginner, queries *db.Queries, tokenStore *auth.TokenStore, revocations *auth.RevocationList, oneTimeTokens *auth.OneTimeTokenStore, secretBox *auth.SecretBox, blobs storage.BlobStore, mailer mail.Sender, appBaseURL string, gracePeriod time.Duration, auditRecorder audit.Recorder, log *slog.Logger) *AccountDeletionUseCase {
+	return &AccountDeletionUseCase{
+		pool:          pool,
+		queries:       queries,
+		verifier:      &mfaVerifier{queries: queries, secretBox: secretBox},
+		tokenStore:    tokenStore,
+		revocations:   revocations,
+		oneTimeTokens: oneTimeTokens,
+		blobs:         blobs,
+		mailer:        mailer,
+		appBaseURL:    appBaseURL,
+		gracePeriod:   gracePeriod,
+		audit:         auditRecorder,
+		log:           log,
+	}
+}
+
+// SendDeletionConfirmation mails a single-use link that confirms the deletion
+// in place of the password, for accounts created through OIDC or magic links
+func (uc *AccountDeletionUseCase) SendDeletionConfirmation(ctx context.Context, userID uuid.UUID) error {
+	user, err := uc.queries.GetUserByID(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+	if err == pgx.ErrNoRows {
+		return ErrUserNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	token, err := uc.oneTimeTokens.Issue(ctx, auth.AccountDeletionPurpose, userID, accountDeletionConfirmationTTL)
+	if err != nil {
+		return err
+	}
+
+	link := fmt.Sprintf("%s/settings/account/delete?token=%s", uc.appBaseURL, url.QueryEscape(token))
+	return uc.mailer.Send(ctx, mail.Message{
+		To:      []string{user.Email},
+		Subject: "Confirm the deletion of your ChefNext account",
+		Body: fmt.Sprintf(
+			"We received a request to delete your ChefNext account.\n\nOpen the link below to confirm it:\n\n%s\n\nThis link expires in 30 minutes and can only be used once. If you did not request this, you can ignore this email.\n",
+			link,
+		),
+	})
+}
+
+// RequestDeletionInput represents delete account input. One of Password,
+// MFACode or ConfirmationToken re-authenticates the user
+type RequestDeletionInput struct {
+	UserID            uuid.UUID
+	Password          string
+	MFACode           string
+	ConfirmationToken string
+}
+
+// RequestDeletionOutput represents delete account output
+type RequestDeletionOutput struct {
+	ScheduledFor time.Time
+}
+
+// RequestDeletion re-authenticates the user and schedules the account for
+// deletion after the grace period
+func (uc *AccountDeletionUseCase) RequestDeletion(ctx context.Context, input RequestDeletionInput) (*RequestDeletionOutput, error) {
+	pgUserID := pgtype.UUID{Bytes: input.UserID, Valid: true}
+
+	user, err := uc.queries.GetUserByID(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Checked first so a refused request does not use up a confirmation
+	// token or recovery code
+	if err := uc.ensureNotSoleOwner(ctx, pgUserID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.reauthenticate(ctx, input, user.PasswordHash); err != nil {
+		return nil, err
+	}
+
+	scheduledFor := time.Now().Add(uc.gracePeriod)
+	err = pgx.BeginFunc(ctx, uc.pool, func(tx pgx.Tx) error {
+		queries := uc.queries.WithTx(tx)
+
+		if _, err := queries.ScheduleUserDeletion(ctx, db.ScheduleUserDeletionParams{
+			ID:                   pgUserID,
+			DeletionScheduledFor: pgtype.Timestamptz{Time: scheduledFor, Valid: true},
+		}); err != nil {
+			if err == pgx.ErrNoRows {
+				return ErrDeletionAlreadyScheduled
+			}
+			return err
+		}
+
+		return uc.audit.Record(ctx, queries, audit.Event{
+			Action:     ActionAccountDeletionScheduled,
+			TargetType: audit.TargetUser,
+			TargetID:   input.UserID,
+			After:      accountSnapshot{DeletionScheduledFor: &scheduledFor},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The schedule stands even if the mail cannot be delivered
+	_ = uc.mailer.Send(ctx, mail.Message{
+		To:      []string{user.Email},
+		Subject: "Your ChefNext account is scheduled for deletion",
+		Body: fmt.Sprintf(
+			"We received a request to delete your ChefNext account.\n\nYour account and personal data will be deleted on %s (UTC).\nUntil then you can sign in and cancel the deletion from your account settings.\n\nIf you did not request this, sign in and cancel the deletion, then change your password.\n",
+			scheduledFor.UTC().Format("2006-01-02 15:04"),
+		),
+	})
+
+	return &RequestDeletionOutput{ScheduledFor: scheduledFor}, nil
+}
+
+// reauthenticate confirms the request comes from the account holder with a
+// mailed confirmation token, a two-factor code or the password
+func (uc *AccountDeletionUseCase) reauthenticate(ctx context.Context, input RequestDeletionInput, passwordHash string) error {
+	switch {
+	case input.ConfirmationToken != "":
+		userID, err := uc.oneTimeTokens.Consume(ctx, auth.AccountDeletionPurpose, input.ConfirmationToken)
+		if err == auth.ErrInvalidOneTimeToken || (err == nil && userID != input.UserID) {
+			return ErrInvalidCredentials
+		}
+		return err
+
+	case input.MFACode != "":
+		_, err := uc.verifier.verifyCode(ctx, input.UserID, input.MFACode)
+		if errors.Is(err, ErrInvalidMFACode) || errors.Is(err, ErrMFANotEnabled) {
+			return ErrInvalidCredentials
+		}
+		return err
+
+	default:
+		match, err := auth.VerifyPassword(input.Password, passwordHash)
+		if err != nil {
+			return err
+		}
+		if !match {
+			return ErrInvalidCredentials
+		}
+		return nil
+	}
+}
+
+// CancelDeletion keeps an account that is still within its grace period
+func (uc *AccountDeletionUseCase) CancelDeletion(ctx context.Context, userID uuid.UUID) error {
+	return pgx.BeginFunc(ctx, uc.pool, func(tx pgx.Tx) error {
+		queries := uc.queries.WithTx(tx)
+
+		rows, err := queries.CancelUserDeletion(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return ErrDeletionNotScheduled
+		}
+
+		return uc.audit.Record(ctx, queries, audit.Event{
+			Action:     ActionAccountDeletionCancelled,
+			TargetType: audit.TargetUser,
+			TargetID:   userID,
+		})
+	})
+}
+
+// Run purges accounts whose grace period has ended until ctx is cancelled
+func (uc *AccountDeletionUseCase) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if purged, err := uc.PurgeDue(ctx, time.Now()); err != nil {
+			uc.log.Error("account purge failed", "error", err)
+		} else if purged > 0 {
+			uc.log.Info("purged deleted accounts", "count", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeDue anonymises up to one batch of accounts scheduled for deletion
+// before now and returns how many were purged. An account that fails is
+// logged and retried on the next pass so it cannot hold up the others
+func (uc *AccountDeletionUseCase) PurgeDue(ctx context.Context, now time.Time) (int, error) {
+	ids, err := uc.queries.ListUsersDueForDeletion(ctx, db.ListUsersDueForDeletionParams{
+		DeletionScheduledFor: pgtype.Timestamptz{Time: now, Valid: true},
+		Limit:                accountPurgeBatchSize,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, id := range ids {
+		err := uc.purge(ctx, id, now)
+		if err == errDeletionNoLongerDue {
+			continue
+		}
+		if err != nil {
+			uc.log.Error("account purge failed", "user_id", uuid.UUID(id.Bytes).String(), "error", err)
+			continue
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// purge removes one account's personal data in a single transaction, so a
+// failed purge leaves the account untouched and is retried on the next pass.
+// Deleting KYC documents from blob storage is idempotent, so a retry after a
+// rollback completes it
+func (uc *AccountDeletionUseCase) purge(ctx context.Context, pgUserID pgtype.UUID, now time.Time) error {
+	userID := uuid.UUID(pgUserID.Bytes)
+
+	var promoted *db.RestaurantMember
+	err := pgx.BeginFunc(ctx, uc.pool, func(tx pgx.Tx) error {
+		queries := uc.queries.WithTx(tx)
+
+		// The account may have been cancelled since it was listed
+		if _, err := queries.LockUserDueForDeletion(ctx, db.LockUserDueForDeletionParams{
+			ID:                   pgUserID,
+			DeletionScheduledFor: pgtype.Timestamptz{Time: now, Valid: true},
+		}); err != nil {
+			if err == pgx.ErrNoRows {
+				return errDeletionNoLongerDue
+			}
+			return err
+		}
+
+		// Sign out everywhere before anything changes so no session outlives
+		// the account
+		if err := uc.tokenStore.RevokeAllUserTokens(ctx, userID); err != nil {
+			return err
+		}
+		if err := uc.revocations.RevokeUserTokens(ctx, userID); err != nil {
+			return err
+		}
+
+		// Recorded changes to the account and chef profile hold personal data
+		if err := queries.RedactAuditEventsByUser(ctx, pgUserID); err != nil {
+			return err
+		}
+
+		if err := purgeChefProfile(ctx, queries, pgUserID); err != nil {
+			return err
+		}
+		var err error
+		promoted, err = purgeRestaurantProfile(ctx, queries, pgUserID)
+		if err != nil {
+			return err
+		}
+
+		keys, err := queries.ListKYCDocumentKeysByUser(ctx, pgUserID)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if err := uc.blobs.Delete(ctx, key); err != nil {
+				return err
+			}
+		}
+		if err := queries.DeleteKYCSubmissionsByUser(ctx, pgUserID); err != nil {
+			return err
+		}
+
+		if err := queries.DeleteUserIdentitiesByUser(ctx, pgUserID); err != nil {
+			return err
+		}
+		if err := queries.DeleteTOTPCredentialByUser(ctx, pgUserID); err != nil {
+			return err
+		}
+		if err := queries.DeleteRecoveryCodesByUser(ctx, pgUserID); err != nil {
+			return err
+		}
+
+		if err := queries.AnonymizeUser(ctx, pgUserID); err != nil {
+			return err
+		}
+
+		return uc.audit.Record(ctx, queries, audit.Event{
+			Action:     ActionAccountPurged,
+			TargetType: audit.TargetUser,
+			TargetID:   userID,
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	if promoted != nil {
+		uc.notifyPromotedOwner(ctx, promoted)
+	}
+	return nil
+}
+
+// notifyPromotedOwner tells a member they now own the restaurant whose last
+// owner was purged. The promotion stands even if the mail cannot be delivered
+func (uc *AccountDeletionUseCase) notifyPromotedOwner(ctx context.Context, member *db.RestaurantMember) {
+	user, err := uc.queries.GetUserByID(ctx, member.UserID)
+	if err != nil {
+		uc.log.Error("failed to load promoted restaurant owner", "user_id", uuid.UUID(member.UserID.Bytes).String(), "error", err)
+		return
+	}
+
+	_ = uc.mailer.Send(ctx, mail.Message{
+		To:      []string{user.Email},
+		Subject: "You are now an owner of your ChefNext restaurant team",
+		Body:    "The last owner of your restaurant team has deleted their ChefNext account.\n\nAs the longest-standing member, you have been made an owner so the team can still be managed. You can now invite members and change their roles.\n",
+	})
+}
+
+// purgeChefProfile deletes the chef profile, or anonymises it when restaurants
+// still hold applications from this chef
+func purgeChefProfile(ctx context.Context, queries *db.Queries, pgUserID pgtype.UUID) error {
+	profile, err := queries.GetChefProfileByUserID(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	applications, err := queries.CountApplicationsByChefUser(ctx, pgUserID)
+	if err != nil {
+		return err
+	}
+	if applications == 0 {
+		return queries.DeleteChefProfile(ctx, profile.ID)
+	}
+
+	if err := queries.RedactApplicationsByChefUser(ctx, pgUserID); err != nil {
+		return err
+	}
+	return queries.AnonymizeChefProfile(ctx, pgUserID)
+}
+
+// ensureNotSoleOwner keeps a restaurant team from losing its last owner
+func (uc *AccountDeletionUseCase) ensureNotSoleOwner(ctx context.Context, pgUserID pgtype.UUID) error {
+	membership, err := uc.queries.GetRestaurantMembershipByUser(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if membership.Role != restaurantteam.RoleOwner {
+		return nil
+	}
+
+	members, err := uc.queries.CountRestaurantMembers(ctx, membership.RestaurantID)
+	if err != nil {
+		return err
+	}
+	owners, err := uc.queries.CountRestaurantOwners(ctx, membership.RestaurantID)
+	if err != nil {
+		return err
+	}
+	if members > 1 && owners <= 1 {
+		return ErrSoleRestaurantOwner
+	}
+	return nil
+}
+
+// purgeRestaurantProfile removes the user from their restaurant team. When
+// the user was its last owner, the longest-standing remaining member is made
+// an owner and returned so they can be told. A restaurant the user created is
+// deleted with its jobs once nobody else is on the team, or anonymised with
+// its jobs closed when chefs still have applications there
+func purgeRestaurantProfile(ctx context.Context, queries *db.Queries, pgUserID pgtype.UUID) (*db.RestaurantMember, error) {
+	promoted, err := leaveRestaurantTeam(ctx, queries, pgUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := queries.GetRestaurantProfileByUserID(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return promoted, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	remaining, err := queries.CountRestaurantMembers(ctx, profile.ID)
+	if err != nil {
+		return nil, err
+	}
+	if remaining > 0 {
+		return promoted, nil
+	}
+
+	applications, err := queries.CountApplicationsByRestaurantUser(ctx, pgUserID)
+	if err != nil {
+		return nil, err
+	}
+	if applications == 0 {
+		return promoted, queries.DeleteRestaurantProfile(ctx, profile.ID)
+	}
+
+	if err := queries.CloseJobsByRestaurantUser(ctx, pgUserID); err != nil {
+		return nil, err
+	}
+	return promoted, queries.AnonymizeRestaurantProfile(ctx, pgUserID)
+}
+
+// leaveRestaurantTeam deletes the user's membership with the owner rows locked,
+// so a concurrent demotion or removal cannot leave the team without an owner
+// between the check and the promotion. Deletion is only refused for sole
+// owners when it is requested, and the team may have changed since
+func leaveRestaurantTeam(ctx context.Context, queries *db.Queries, pgUserID pgtype.UUID) (*db.RestaurantMember, error) {
+	membership, err := queries.GetRestaurantMembershipByUser(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	owners, err := queries.LockRestaurantOwners(ctx, membership.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+	lastOwner := len(owners) == 1 && owners[0] == membership.ID
+
+	if err := queries.DeleteRestaurantMembershipsByUser(ctx, pgUserID); err != nil {
+		return nil, err
+	}
+	if !lastOwner {
+		return nil, nil
+	}
+
+	member, err := queries.PromoteLongestStandingRestaurantMember(ctx, membership.RestaurantID)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &member, nil
+}
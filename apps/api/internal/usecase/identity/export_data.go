@@ -0,0 +1,373 @@
+package identity
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// exportFormatVersion is bumped whenever the archive layout changes
+const exportFormatVersion = 1
+
+// ExportDataUseCase assembles a machine-readable archive of a user's data.
+// The platform has no messaging or reviews yet, so the archive covers the
+// account, profiles, jobs, applications and KYC submissions
+type ExportDataUseCase struct {
+	queries *db.Queries
+}
+
+// NewExportDataUseCase creates a new export data use case
+func NewExportDataUseCase(queries *db.Queries) *ExportDataUseCase {
+	return &ExportDataUseCase{
+		queries: queries,
+	}
+}
+
+// ExportDataOutput represents export data output
+type ExportDataOutput struct {
+	FileName string
+	Archive  []byte
+}
+
+type exportArchive struct {
+	FormatVersion        int                      `json:"format_version"`
+	ExportedAt           time.Time                `json:"exported_at"`
+	Account              exportAccount            `json:"account"`
+	LinkedIdentities     []exportIdentity         `json:"linked_identities"`
+	ChefProfile          *exportChefProfile       `json:"chef_profile,omitempty"`
+	RestaurantProfile    *exportRestaurantProfile `json:"restaurant_profile,omitempty"`
+	Jobs                 []exportJob              `json:"jobs"`
+	Applications         []exportApplication      `json:"applications"`
+	ApplicationsReceived []exportApplication      `json:"applications_received"`
+	KYCSubmissions       []exportKYCSubmission    `json:"kyc_submissions"`
+}
+
+type exportAccount struct {
+	ID                   string     `json:"id"`
+	Email                string     `json:"email"`
+	Role                 string     `json:"role"`
+	Roles                []string   `json:"roles"`
+	KYCStatus            string     `json:"kyc_status"`
+	EmailVerifiedAt      *time.Time `json:"email_verified_at,omitempty"`
+	MFAEnabled           bool       `json:"mfa_enabled"`
+	DeletionScheduledFor *time.Time `json:"deletion_scheduled_for,omitempty"`
+	CreatedAt            time.Time  `json:"created_at"`
+	UpdatedAt            time.Time  `json:"updated_at"`
+}
+
+type exportIdentity struct {
+	Provider    string     `json:"provider"`
+	Email       string     `json:"email,omitempty"`
+	LinkedAt    time.Time  `json:"linked_at"`
+	LastLoginAt *time.Time `json:"last_login_at,omitempty"`
+}
+
+type exportChefProfile struct {
+	ID              string          `json:"id"`
+	FullName        string          `json:"full_name,omitempty"`
+	Headline        string          `json:"headline,omitempty"`
+	Summary         string          `json:"summary,omitempty"`
+	Bio             string          `json:"bio,omitempty"`
+	Location        string          `json:"location,omitempty"`
+	YearsExperience *int32          `json:"years_experience,omitempty"`
+	Availability    string          `json:"availability,omitempty"`
+	Specialties     []string        `json:"specialties"`
+	WorkAreas       []string        `json:"work_areas"`
+	Languages       []string        `json:"languages"`
+	LearningFocus   []string        `json:"learning_focus"`
+	SkillTree       json.RawMessage `json:"skill_tree,omitempty"`
+	PortfolioItems  json.RawMessage `json:"portfolio_items,omitempty"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+type exportRestaurantProfile struct {
+	ID                 string          `json:"id"`
+	Name               string          `json:"name"`
+	DisplayName        string          `json:"display_name,omitempty"`
+	Tagline            string          `json:"tagline,omitempty"`
+	Description        string          `json:"description,omitempty"`
+	Address            string          `json:"address,omitempty"`
+	Location           string          `json:"location,omitempty"`
+	Seats              *int32          `json:"seats,omitempty"`
+	CuisineTypes       []string        `json:"cuisine_types"`
+	MentorshipStyle    string          `json:"mentorship_style,omitempty"`
+	CultureKeywords    []string        `json:"culture_keywords"`
+	Benefits           []string        `json:"benefits"`
+	SupportPrograms    []string        `json:"support_programs"`
+	LearningHighlights json.RawMessage `json:"learning_highlights,omitempty"`
+	CreatedAt          time.Time       `json:"created_at"`
+	UpdatedAt          time.Time       `json:"updated_at"`
+}
+
+type exportJob struct {
+	ID             string          `json:"id"`
+	Title          string          `json:"title"`
+	Description    string          `json:"description"`
+	RequiredSkills []string        `json:"required_skills"`
+	Location       string          `json:"location,omitempty"`
+	SalaryRange    string          `json:"salary_range,omitempty"`
+	EmploymentType string          `json:"employment_type,omitempty"`
+	Status         string          `json:"status"`
+	Metadata       json.RawMessage `json:"metadata,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+type exportApplication struct {
+	ID           string    `json:"id"`
+	JobID        string    `json:"job_id"`
+	JobTitle     string    `json:"job_title"`
+	Counterparty string    `json:"counterparty,omitempty"`
+	Status       string    `json:"status"`
+	CoverLetter  string    `json:"cover_letter,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// exportKYCSubmission carries submission details only; the documents are
+// the user's own uploads and stay encrypted at rest
+type exportKYCSubmission struct {
+	ID                 string     `json:"id"`
+	Status             string     `json:"status"`
+	BusinessName       string     `json:"business_name"`
+	RegistrationNumber string     `json:"registration_number"`
+	BusinessAddress    string     `json:"business_address"`
+	RepresentativeName string     `json:"representative_name"`
+	RejectionReasons   []string   `json:"rejection_reasons"`
+	SubmittedAt        time.Time  `json:"submitted_at"`
+	ReviewedAt         *time.Time `json:"reviewed_at,omitempty"`
+}
+
+// Execute builds the archive for the given user
+func (uc *ExportDataUseCase) Execute(ctx context.Context, userID uuid.UUID) (*ExportDataOutput, error) {
+	pgUserID := pgtype.UUID{Bytes: userID, Valid: true}
+	now := time.Now().UTC()
+
+	user, err := uc.queries.GetUserByID(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	mfaEnabled := false
+	credential, err := uc.queries.GetTOTPCredential(ctx, pgUserID)
+	switch {
+	case err == nil:
+		mfaEnabled = credential.ConfirmedAt.Valid
+	case err != pgx.ErrNoRows:
+		return nil, err
+	}
+
+	archive := exportArchive{
+		FormatVersion: exportFormatVersion,
+		ExportedAt:    now,
+		Account: exportAccount{
+			ID:                   userID.String(),
+			Email:                user.Email,
+			Role:                 user.Role,
+			Roles:                user.Roles,
+			KYCStatus:            user.KycStatus,
+			EmailVerifiedAt:      exportTimestamptz(user.EmailVerifiedAt),
+			MFAEnabled:           mfaEnabled,
+			DeletionScheduledFor: exportTimestamptz(user.DeletionScheduledFor),
+			CreatedAt:            user.CreatedAt.Time,
+			UpdatedAt:            user.UpdatedAt.Time,
+		},
+		LinkedIdentities:     []exportIdentity{},
+		Jobs:                 []exportJob{},
+		Applications:         []exportApplication{},
+		ApplicationsReceived: []exportApplication{},
+		KYCSubmissions:       []exportKYCSubmission{},
+	}
+
+	identities, err := uc.queries.ListUserIdentities(ctx, pgUserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, identity := range identities {
+		archive.LinkedIdentities = append(archive.LinkedIdentities, exportIdentity{
+			Provider:    identity.Provider,
+			Email:       identity.Email.String,
+			LinkedAt:    identity.CreatedAt.Time,
+			LastLoginAt: exportTimestamptz(identity.LastLoginAt),
+		})
+	}
+
+	chefProfile, err := uc.queries.GetChefProfileByUserID(ctx, pgUserID)
+	switch {
+	case err == nil:
+		archive.ChefProfile = toExportChefProfile(chefProfile)
+	case err != pgx.ErrNoRows:
+		return nil, err
+	}
+
+	restaurantProfile, err := uc.queries.GetRestaurantProfileByUserID(ctx, pgUserID)
+	switch {
+	case err == nil:
+		archive.RestaurantProfile = toExportRestaurantProfile(restaurantProfile)
+	case err != pgx.ErrNoRows:
+		return nil, err
+	}
+
+	jobs, err := uc.queries.ListJobsByRestaurantUser(ctx, pgUserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		archive.Jobs = append(archive.Jobs, exportJob{
+			ID:             uuid.UUID(job.ID.Bytes).String(),
+			Title:          job.Title,
+			Description:    job.Description,
+			RequiredSkills: nonNilStrings(job.RequiredSkills),
+			Location:       job.Location.String,
+			SalaryRange:    job.SalaryRange.String,
+			EmploymentType: job.EmploymentType.String,
+			Status:         string(job.Status),
+			Metadata:       exportJSON(job.Metadata),
+			CreatedAt:      job.CreatedAt.Time,
+			UpdatedAt:      job.UpdatedAt.Time,
+		})
+	}
+
+	sent, err := uc.queries.ListApplicationsByChefUser(ctx, pgUserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, application := range sent {
+		archive.Applications = append(archive.Applications, exportApplication{
+			ID:           uuid.UUID(application.ID.Bytes).String(),
+			JobID:        uuid.UUID(application.JobID.Bytes).String(),
+			JobTitle:     application.JobTitle,
+			Counterparty: application.RestaurantDisplayName.String,
+			Status:       string(application.Status),
+			CoverLetter:  application.CoverLetter.String,
+			CreatedAt:    application.CreatedAt.Time,
+			UpdatedAt:    application.UpdatedAt.Time,
+		})
+	}
+
+	received, err := uc.queries.ListApplicationsReceivedByRestaurantUser(ctx, pgUserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, application := range received {
+		archive.ApplicationsReceived = append(archive.ApplicationsReceived, exportApplication{
+			ID:           uuid.UUID(application.ID.Bytes).String(),
+			JobID:        uuid.UUID(application.JobID.Bytes).String(),
+			JobTitle:     application.JobTitle,
+			Counterparty: application.ChefFullName.String,
+			Status:       string(application.Status),
+			CoverLetter:  application.CoverLetter.String,
+			CreatedAt:    application.CreatedAt.Time,
+			UpdatedAt:    application.UpdatedAt.Time,
+		})
+	}
+
+	submissions, err := uc.queries.ListKYCSubmissionsByUser(ctx, pgUserID)
+	if err != nil {
+		return nil, err
+	}
+	for _, submission := range submissions {
+		archive.KYCSubmissions = append(archive.KYCSubmissions, exportKYCSubmission{
+			ID:                 uuid.UUID(submission.ID.Bytes).String(),
+			Status:             submission.Status,
+			BusinessName:       submission.BusinessName,
+			RegistrationNumber: submission.RegistrationNumber,
+			BusinessAddress:    submission.BusinessAddress,
+			RepresentativeName: submission.RepresentativeName,
+			RejectionReasons:   nonNilStrings(submission.RejectionReasons),
+			SubmittedAt:        submission.CreatedAt.Time,
+			ReviewedAt:         exportTimestamptz(submission.ReviewedAt),
+		})
+	}
+
+	data, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return &ExportDataOutput{
+		FileName: "chefnext-export-" + now.Format("20060102T150405Z") + ".json",
+		Archive:  data,
+	}, nil
+}
+
+func toExportChefProfile(profile db.ChefProfile) *exportChefProfile {
+	return &exportChefProfile{
+		ID:              uuid.UUID(profile.ID.Bytes).String(),
+		FullName:        profile.FullName.String,
+		Headline:        profile.Headline.String,
+		Summary:         profile.Summary.String,
+		Bio:             profile.Bio.String,
+		Location:        profile.Location.String,
+		YearsExperience: exportInt4(profile.YearsExperience),
+		Availability:    profile.Availability.String,
+		Specialties:     nonNilStrings(profile.Specialties),
+		WorkAreas:       nonNilStrings(profile.WorkAreas),
+		Languages:       nonNilStrings(profile.Languages),
+		LearningFocus:   nonNilStrings(profile.LearningFocus),
+		SkillTree:       exportJSON(profile.SkillTreeJson),
+		PortfolioItems:  exportJSON(profile.PortfolioItems),
+		CreatedAt:       profile.CreatedAt.Time,
+		UpdatedAt:       profile.UpdatedAt.Time,
+	}
+}
+
+func toExportRestaurantProfile(profile db.GetRestaurantProfileByUserIDRow) *exportRestaurantProfile {
+	return &exportRestaurantProfile{
+		ID:                 uuid.UUID(profile.ID.Bytes).String(),
+		Name:               profile.Name,
+		DisplayName:        profile.DisplayName.String,
+		Tagline:            profile.Tagline.String,
+		Description:        profile.Description.String,
+		Address:            profile.Address.String,
+		Location:           profile.Location.String,
+		Seats:              exportInt4(profile.Seats),
+		CuisineTypes:       nonNilStrings(profile.CuisineTypes),
+		MentorshipStyle:    profile.MentorshipStyle.String,
+		CultureKeywords:    nonNilStrings(profile.CultureKeywords),
+		Benefits:           nonNilStrings(profile.Benefits),
+		SupportPrograms:    nonNilStrings(profile.SupportPrograms),
+		LearningHighlights: exportJSON(profile.LearningHighlights),
+		CreatedAt:          profile.CreatedAt.Time,
+		UpdatedAt:          profile.UpdatedAt.Time,
+	}
+}
+
+func exportTimestamptz(value pgtype.Timestamptz) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	t := value.Time.UTC()
+	return &t
+}
+
+func exportInt4(value pgtype.Int4) *int32 {
+	if !value.Valid {
+		return nil
+	}
+	return &value.Int32
+}
+
+// exportJSON embeds stored JSONB as-is, dropping anything that is not valid JSON
+func exportJSON(value []byte) json.RawMessage {
+	if len(value) == 0 || !json.Valid(value) {
+		return nil
+	}
+	return json.RawMessage(value)
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,71 @@
+package identity
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// GetMeUseCase loads the authenticated user's account
+type GetMeUseCase struct {
+	queries *db.Queries
+}
+
+// NewGetMeUseCase creates a new get me use case
+func NewGetMeUseCase(queries *db.Queries) *GetMeUseCase {
+	return &GetMeUseCase{
+		queries: queries,
+	}
+}
+
+// GetMeInput represents get me input
+type GetMeInput struct {
+	UserID uuid.UUID
+	// ActiveRole is the role of the caller's session
+	ActiveRole string
+}
+
+// GetMeOutput represents get me output
+type GetMeOutput struct {
+	UserID        uuid.UUID
+	Email         string
+	Role          string
+	Roles         []string
+	EmailVerified bool
+	// DeletionScheduledFor is set while the account awaits deletion
+	DeletionScheduledFor *time.Time
+}
+
+// Execute executes the get me use case
+func (uc *GetMeUseCase) Execute(ctx context.Context, input GetMeInput) (*GetMeOutput, error) {
+	pgUserID := pgtype.UUID{Bytes: input.UserID, Valid: true}
+
+	user, err := uc.queries.GetUserByID(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	output := &GetMeOutput{
+		UserID:        input.UserID,
+		Email:         user.Email,
+		Role:          user.Role,
+		Roles:         user.Roles,
+		EmailVerified: user.EmailVerifiedAt.Valid,
+	}
+	// Another session may have switched the default since this one started
+	if slices.Contains(user.Roles, input.ActiveRole) {
+		output.Role = input.ActiveRole
+	}
+	if user.DeletionScheduledFor.Valid {
+		output.DeletionScheduledFor = &user.DeletionScheduledFor.Time
+	}
+	return output, nil
+}
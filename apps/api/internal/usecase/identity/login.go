@@ -3,8 +3,10 @@ package identity
 import (
 	"context"
 	"errors"
+	"sync"
 
 	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/security"
 	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
@@ -12,21 +14,47 @@ import (
 
 var (
 	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrAccountSuspended   = errors.New("account is suspended")
 )
 
 // LoginUseCase handles user login
 type LoginUseCase struct {
-	queries    *db.Queries
-	jwtManager *auth.JWTManager
-	tokenStore *auth.TokenStore
+	queries        *db.Queries
+	loginAttempts  *auth.LoginAttemptLimiter
+	securityEvents security.Recorder
+	argon2Params   *auth.Argon2Params
+	signIn         *signInFlow
+	// dummyHash is verified against for unknown emails so they take as long
+	// as a wrong password and do not reveal which accounts exist
+	dummyHash func() (string, error)
 }
 
 // NewLoginUseCase creates a new login use case
-func NewLoginUseCase(queries *db.Queries, jwtManager *auth.JWTManager, tokenStore *auth.TokenStore) *LoginUseCase {
+func NewLoginUseCase(
+	queries *db.Queries,
+	jwtManager *auth.JWTManager,
+	tokenStore *auth.TokenStore,
+	oneTimeTokenStore *auth.OneTimeTokenStore,
+	loginAttempts *auth.LoginAttemptLimiter,
+	mfaPolicy *auth.MFAPolicy,
+	securityEvents security.Recorder,
+	argon2Params *auth.Argon2Params,
+) *LoginUseCase {
 	return &LoginUseCase{
-		queries:    queries,
-		jwtManager: jwtManager,
-		tokenStore: tokenStore,
+		queries:        queries,
+		loginAttempts:  loginAttempts,
+		securityEvents: securityEvents,
+		argon2Params:   argon2Params,
+		dummyHash: sync.OnceValues(func() (string, error) {
+			return auth.HashPassword("chefnext-login-timing-dummy", argon2Params)
+		}),
+		signIn: &signInFlow{
+			queries:           queries,
+			jwtManager:        jwtManager,
+			tokenStore:        tokenStore,
+			oneTimeTokenStore: oneTimeTokenStore,
+			mfaPolicy:         mfaPolicy,
+		},
 	}
 }
 
@@ -34,64 +62,115 @@ func NewLoginUseCase(queries *db.Queries, jwtManager *auth.JWTManager, tokenStor
 type LoginInput struct {
 	Email    string
 	Password string
+	// Role optionally picks the active role; empty uses the one last active
+	Role    string
+	Session auth.SessionMetadata
 }
 
 // LoginOutput represents login output
+//
+// When MFARequired is set no tokens are issued; the client must complete the
+// login with VerifyMFAUseCase using MFAToken.
 type LoginOutput struct {
-	UserID       uuid.UUID
-	Email        string
-	Role         string
-	AccessToken  string
-	RefreshToken string
+	UserID                uuid.UUID
+	Email                 string
+	Role                  string
+	Roles                 []string
+	AccessToken           string
+	RefreshToken          string
+	MFARequired           bool
+	MFAToken              string
+	MFAEnrollmentRequired bool
 }
 
 // Execute executes the login use case
 func (uc *LoginUseCase) Execute(ctx context.Context, input LoginInput) (*LoginOutput, error) {
+	// Refuse to check passwords while the email or client IP is locked out
+	if err := uc.loginAttempts.Check(ctx, input.Email, input.Session.IPAddress); err != nil {
+		uc.recordEvent(ctx, security.LoginLocked, uuid.Nil, input)
+		return nil, err
+	}
+
 	// Get user by email
 	user, err := uc.queries.GetUserByEmail(ctx, input.Email)
 	if err == pgx.ErrNoRows {
-		return nil, ErrInvalidCredentials
+		if dummyHash, hashErr := uc.dummyHash(); hashErr == nil {
+			_, _, _ = auth.VerifyPasswordWithParams(input.Password, dummyHash, uc.argon2Params)
+		}
+		return nil, uc.failLogin(ctx, uuid.Nil, input)
+	}
+	if err != nil {
+		return nil, err
 	}
+
+	// Convert pgtype.UUID to uuid.UUID
+	userID, err := uuid.FromBytes(user.ID.Bytes[:])
 	if err != nil {
 		return nil, err
 	}
 
 	// Verify password
-	match, err := auth.VerifyPassword(input.Password, user.PasswordHash)
+	match, needsRehash, err := auth.VerifyPasswordWithParams(input.Password, user.PasswordHash, uc.argon2Params)
 	if err != nil {
 		return nil, err
 	}
 	if !match {
-		return nil, ErrInvalidCredentials
+		return nil, uc.failLogin(ctx, userID, input)
 	}
 
-	// Convert pgtype.UUID to uuid.UUID
-	userID, err := uuid.FromBytes(user.ID.Bytes[:])
-	if err != nil {
-		return nil, err
+	// Upgrade hashes made with older Argon2 parameters while the plaintext is
+	// at hand; a failure must not block the login as it is retried next time
+	if needsRehash {
+		if passwordHash, err := auth.HashPassword(input.Password, uc.argon2Params); err == nil {
+			_ = uc.queries.UpdateUserPasswordHash(ctx, db.UpdateUserPasswordHashParams{
+				ID:           user.ID,
+				PasswordHash: passwordHash,
+			})
+		}
 	}
 
-	// Generate tokens
-	accessToken, err := uc.jwtManager.GenerateAccessToken(userID, user.Email, user.Role)
-	if err != nil {
+	if err := useRole(ctx, uc.queries, &user, input.Role); err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := uc.jwtManager.GenerateRefreshToken(userID, user.Email, user.Role)
+	output, err := uc.signIn.complete(ctx, user, input.Session)
 	if err != nil {
 		return nil, err
 	}
 
-	// Store refresh token in Redis
-	if err := uc.tokenStore.StoreRefreshToken(ctx, userID, refreshToken); err != nil {
-		return nil, err
+	// Failed attempts are only reset once the second factor succeeds, so
+	// knowing the password does not buy unlimited code guesses
+	if !output.MFARequired {
+		if err := uc.loginAttempts.Reset(ctx, input.Email); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+// failLogin counts the failed attempt and reports a lockout if it triggered one
+func (uc *LoginUseCase) failLogin(ctx context.Context, userID uuid.UUID, input LoginInput) error {
+	uc.recordEvent(ctx, security.LoginFailed, userID, input)
+
+	err := uc.loginAttempts.RecordFailure(ctx, input.Email, input.Session.IPAddress)
+	if errors.Is(err, auth.ErrTooManyLoginAttempts) {
+		uc.recordEvent(ctx, security.LoginLocked, userID, input)
+		return err
+	}
+	if err != nil {
+		return err
 	}
 
-	return &LoginOutput{
-		UserID:       userID,
-		Email:        user.Email,
-		Role:         user.Role,
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
-	}, nil
+	return ErrInvalidCredentials
+}
+
+func (uc *LoginUseCase) recordEvent(ctx context.Context, eventType security.EventType, userID uuid.UUID, input LoginInput) {
+	uc.securityEvents.Record(ctx, security.Event{
+		Type:      eventType,
+		UserID:    userID,
+		IPAddress: input.Session.IPAddress,
+		UserAgent: input.Session.UserAgent,
+		Details:   map[string]string{"email": input.Email},
+	})
 }
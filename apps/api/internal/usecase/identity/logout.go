@@ -2,27 +2,31 @@ package identity
 
 import (
 	"context"
+	"errors"
 
 	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
 )
 
 // LogoutUseCase handles user logout
 type LogoutUseCase struct {
-	jwtManager *auth.JWTManager
-	tokenStore *auth.TokenStore
+	jwtManager  *auth.JWTManager
+	tokenStore  *auth.TokenStore
+	revocations *auth.RevocationList
 }
 
 // NewLogoutUseCase creates a new logout use case
-func NewLogoutUseCase(jwtManager *auth.JWTManager, tokenStore *auth.TokenStore) *LogoutUseCase {
+func NewLogoutUseCase(jwtManager *auth.JWTManager, tokenStore *auth.TokenStore, revocations *auth.RevocationList) *LogoutUseCase {
 	return &LogoutUseCase{
-		jwtManager: jwtManager,
-		tokenStore: tokenStore,
+		jwtManager:  jwtManager,
+		tokenStore:  tokenStore,
+		revocations: revocations,
 	}
 }
 
 // LogoutInput represents logout input
 type LogoutInput struct {
-	RefreshToken string
+	RefreshToken  string
+	AccessTokenID string
 }
 
 // LogoutOutput represents logout output
@@ -32,6 +36,11 @@ type LogoutOutput struct {
 
 // Execute executes the logout use case
 func (uc *LogoutUseCase) Execute(ctx context.Context, input LogoutInput) (*LogoutOutput, error) {
+	// The access token used for this call must stop working right away
+	if err := uc.revocations.RevokeToken(ctx, input.AccessTokenID); err != nil {
+		return nil, err
+	}
+
 	// Verify refresh token
 	claims, err := uc.jwtManager.VerifyRefreshToken(input.RefreshToken)
 	if err != nil {
@@ -40,8 +49,14 @@ func (uc *LogoutUseCase) Execute(ctx context.Context, input LogoutInput) (*Logou
 		return &LogoutOutput{Success: true}, nil
 	}
 
-	// Revoke refresh token
-	if err := uc.tokenStore.RevokeRefreshToken(ctx, claims.UserID); err != nil {
+	// Revoke the session the refresh token belongs to
+	err = uc.tokenStore.RevokeRefreshToken(ctx, claims.UserID, claims.SessionID)
+	if err != nil && !errors.Is(err, auth.ErrSessionNotFound) {
+		return nil, err
+	}
+
+	// Access tokens issued to the session are revoked along with it
+	if err := uc.revocations.RevokeSession(ctx, claims.SessionID); err != nil {
 		return nil, err
 	}
 
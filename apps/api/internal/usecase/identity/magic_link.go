@@ -0,0 +1,174 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/mail"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/security"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// magicLinkTTL is how long a login link stays valid
+const magicLinkTTL = 15 * time.Minute
+
+// MagicLinkUseCase signs users in with a link mailed to them instead of a
+// password, which also works for accounts that never set one
+//
+// Links are bound to the device that requested them: RequestMagicLink returns a
+// device binding the client keeps, and ConsumeMagicLink only succeeds when it
+// is presented together with the mailed token.
+type MagicLinkUseCase struct {
+	queries           *db.Queries
+	oneTimeTokenStore *auth.OneTimeTokenStore
+	throttle          *auth.RequestThrottle
+	securityEvents    security.Recorder
+	mailer            mail.Sender
+	appBaseURL        string
+	signIn            *signInFlow
+}
+
+// NewMagicLinkUseCase creates a new magic link use case
+func NewMagicLinkUseCase(
+	queries *db.Queries,
+	jwtManager *auth.JWTManager,
+	tokenStore *auth.TokenStore,
+	oneTimeTokenStore *auth.OneTimeTokenStore,
+	throttle *auth.RequestThrottle,
+	mfaPolicy *auth.MFAPolicy,
+	securityEvents security.Recorder,
+	mailer mail.Sender,
+	appBaseURL string,
+) *MagicLinkUseCase {
+	return &MagicLinkUseCase{
+		queries:           queries,
+		oneTimeTokenStore: oneTimeTokenStore,
+		throttle:          throttle,
+		securityEvents:    securityEvents,
+		mailer:            mailer,
+		appBaseURL:        appBaseURL,
+		signIn: &signInFlow{
+			queries:           queries,
+			jwtManager:        jwtManager,
+			tokenStore:        tokenStore,
+			oneTimeTokenStore: oneTimeTokenStore,
+			mfaPolicy:         mfaPolicy,
+		},
+	}
+}
+
+// RequestMagicLinkInput represents request magic link input
+type RequestMagicLinkInput struct {
+	Email string
+}
+
+// RequestMagicLinkOutput represents request magic link output
+type RequestMagicLinkOutput struct {
+	// DeviceBinding must be kept by the requesting client and sent back with
+	// the mailed token
+	DeviceBinding string
+}
+
+// Request mails a login link to the account registered with the email
+func (uc *MagicLinkUseCase) Request(ctx context.Context, input RequestMagicLinkInput) (*RequestMagicLinkOutput, error) {
+	// Count unknown emails too, so the endpoint cannot be used to flood any inbox
+	if err := uc.throttle.Allow(ctx, strings.ToLower(strings.TrimSpace(input.Email))); err != nil {
+		return nil, err
+	}
+
+	// A binding is returned whether or not the account exists to avoid leaking
+	// which emails are registered
+	binding, err := auth.NewTokenBinding()
+	if err != nil {
+		return nil, err
+	}
+	output := &RequestMagicLinkOutput{DeviceBinding: binding}
+
+	user, err := uc.queries.GetUserByEmail(ctx, input.Email)
+	if err == pgx.ErrNoRows {
+		return output, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if user.SuspendedAt.Valid {
+		return output, nil
+	}
+
+	userID, err := uuid.FromBytes(user.ID.Bytes[:])
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := uc.oneTimeTokenStore.IssueBound(ctx, auth.MagicLinkPurpose, userID, binding, magicLinkTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	link := fmt.Sprintf("%s/magic-link?token=%s", uc.appBaseURL, url.QueryEscape(token))
+	msg := mail.Message{
+		To:      []string{user.Email},
+		Subject: "Your ChefNext sign-in link",
+		Body: fmt.Sprintf(
+			"Open the link below on the same device where you asked for it to sign in to ChefNext:\n\n%s\n\nThis link expires in 15 minutes and can only be used once. If you did not try to sign in, you can ignore this email.\n",
+			link,
+		),
+	}
+	if err := uc.mailer.Send(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// ConsumeMagicLinkInput represents consume magic link input
+type ConsumeMagicLinkInput struct {
+	Token         string
+	DeviceBinding string
+	Session       auth.SessionMetadata
+}
+
+// Consume signs the user in with a mailed link; users with MFA still have to
+// complete the second factor
+func (uc *MagicLinkUseCase) Consume(ctx context.Context, input ConsumeMagicLinkInput) (*LoginOutput, error) {
+	userID, err := uc.oneTimeTokenStore.ConsumeBound(ctx, auth.MagicLinkPurpose, input.Token, input.DeviceBinding)
+	if errors.Is(err, auth.ErrTokenBindingMismatch) {
+		uc.securityEvents.Record(ctx, security.Event{
+			Type:      security.MagicLinkDeviceMismatch,
+			UserID:    userID,
+			IPAddress: input.Session.IPAddress,
+			UserAgent: input.Session.UserAgent,
+		})
+		return nil, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	pgUserID := pgtype.UUID{Bytes: userID, Valid: true}
+
+	user, err := uc.queries.GetUserByID(ctx, pgUserID)
+	if err == pgx.ErrNoRows || (err == nil && user.DeletedAt.Valid) {
+		return nil, auth.ErrInvalidOneTimeToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Opening the link proves control of the mailbox
+	if !user.EmailVerifiedAt.Valid {
+		if err := uc.queries.MarkUserEmailVerified(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return uc.signIn.complete(ctx, user, input.Session)
+}
@@ -0,0 +1,130 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const (
+	mfaIssuer       = "ChefNext"
+	mfaChallengeTTL = 5 * time.Minute
+)
+
+var (
+	ErrMFANotEnabled           = errors.New("two-factor authentication is not enabled")
+	ErrMFAAlreadyEnabled       = errors.New("two-factor authentication is already enabled")
+	ErrMFAEnrollmentNotStarted = errors.New("two-factor enrollment has not been started")
+	ErrInvalidMFACode          = errors.New("invalid two-factor code")
+)
+
+// mfaVerifier checks second factors against a user's stored credentials
+type mfaVerifier struct {
+	queries   *db.Queries
+	secretBox *auth.SecretBox
+}
+
+// totpSecret decrypts the user's TOTP secret; the user ID is bound as
+// associated data so a secret cannot be copied onto another account
+func (v *mfaVerifier) totpSecret(credential db.UserTotpCredential, userID uuid.UUID) ([]byte, error) {
+	return v.secretBox.Open(credential.SecretEncrypted, userID[:])
+}
+
+// mfaEnabled reports whether the user has a confirmed TOTP credential
+func mfaEnabled(ctx context.Context, queries *db.Queries, pgUserID pgtype.UUID) (bool, error) {
+	credential, err := queries.GetTOTPCredential(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return credential.ConfirmedAt.Valid, nil
+}
+
+// verifyTOTP checks a TOTP code and marks its time step as used so the same
+// code cannot be replayed
+func (v *mfaVerifier) verifyTOTP(ctx context.Context, userID uuid.UUID, code string) error {
+	pgUserID := pgtype.UUID{Bytes: userID, Valid: true}
+
+	credential, err := v.queries.GetTOTPCredential(ctx, pgUserID)
+	if err == pgx.ErrNoRows || (err == nil && !credential.ConfirmedAt.Valid) {
+		return ErrMFANotEnabled
+	}
+	if err != nil {
+		return err
+	}
+
+	secret, err := v.totpSecret(credential, userID)
+	if err != nil {
+		return err
+	}
+
+	step, ok := auth.ValidateTOTP(secret, code, time.Now())
+	if !ok {
+		return ErrInvalidMFACode
+	}
+
+	updated, err := v.queries.UseTOTPStep(ctx, db.UseTOTPStepParams{
+		UserID:       pgUserID,
+		LastUsedStep: step,
+	})
+	if err != nil {
+		return err
+	}
+	if updated == 0 {
+		return ErrInvalidMFACode
+	}
+	return nil
+}
+
+// verifyCode accepts either a TOTP code or an unused recovery code and reports
+// which one was used
+func (v *mfaVerifier) verifyCode(ctx context.Context, userID uuid.UUID, code string) (bool, error) {
+	err := v.verifyTOTP(ctx, userID, code)
+	if !errors.Is(err, ErrInvalidMFACode) {
+		return false, err
+	}
+
+	pgUserID := pgtype.UUID{Bytes: userID, Valid: true}
+
+	used, err := v.queries.UseRecoveryCode(ctx, db.UseRecoveryCodeParams{
+		UserID:   pgUserID,
+		CodeHash: auth.HashRecoveryCode(code),
+	})
+	if err != nil {
+		return false, err
+	}
+	if used == 0 {
+		return false, ErrInvalidMFACode
+	}
+	return true, nil
+}
+
+// replaceRecoveryCodes generates a fresh set of recovery codes, invalidating
+// any previous ones
+func replaceRecoveryCodes(ctx context.Context, queries *db.Queries, pgUserID pgtype.UUID) ([]string, error) {
+	codes, err := auth.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	hashes := make([]string, 0, len(codes))
+	for _, code := range codes {
+		hashes = append(hashes, auth.HashRecoveryCode(code))
+	}
+
+	if err := queries.ReplaceRecoveryCodes(ctx, db.ReplaceRecoveryCodesParams{
+		UserID:     pgUserID,
+		CodeHashes: hashes,
+	}); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
@@ -0,0 +1,230 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// MFAEnrollmentUseCase enrolls an authenticated user in TOTP two-factor
+// authentication and manages their recovery codes
+type MFAEnrollmentUseCase struct {
+	pool        db.Beginner
+	queries     *db.Queries
+	jwtManager  *auth.JWTManager
+	tokenStore  *auth.TokenStore
+	revocations *auth.RevocationList
+	verifier    *mfaVerifier
+	audit       audit.Recorder
+}
+
+// NewMFAEnrollmentUseCase creates a new MFA enrollment use case
+func NewMFAEnrollmentUseCase(pool db.Beginner, queries *db.Queries, jwtManager *auth.JWTManager, tokenStore *auth.TokenStore, revocations *auth.RevocationList, secretBox *auth.SecretBox, auditRecorder audit.Recorder) *MFAEnrollmentUseCase {
+	return &MFAEnrollmentUseCase{
+		pool:        pool,
+		queries:     queries,
+		jwtManager:  jwtManager,
+		tokenStore:  tokenStore,
+		revocations: revocations,
+		verifier: &mfaVerifier{
+			queries:   queries,
+			secretBox: secretBox,
+		},
+		audit: auditRecorder,
+	}
+}
+
+// BeginTOTPEnrollmentOutput contains the secret to load into an authenticator app
+type BeginTOTPEnrollmentOutput struct {
+	Secret     string
+	OTPAuthURI string
+}
+
+// BeginTOTPEnrollment generates a new TOTP secret for the user; it only takes
+// effect once confirmed with a code from the authenticator app
+func (uc *MFAEnrollmentUseCase) BeginTOTPEnrollment(ctx context.Context, userID uuid.UUID) (*BeginTOTPEnrollmentOutput, error) {
+	pgUserID := pgtype.UUID{Bytes: userID, Valid: true}
+
+	user, err := uc.queries.GetUserByID(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := uc.verifier.secretBox.Seal(secret, userID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// The upsert leaves a confirmed credential untouched
+	updated, err := uc.queries.UpsertPendingTOTPCredential(ctx, db.UpsertPendingTOTPCredentialParams{
+		UserID:          pgUserID,
+		SecretEncrypted: encrypted,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if updated == 0 {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	return &BeginTOTPEnrollmentOutput{
+		Secret:     auth.EncodeTOTPSecret(secret),
+		OTPAuthURI: auth.TOTPURI(mfaIssuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmTOTPEnrollmentInput represents confirm TOTP enrollment input
+type ConfirmTOTPEnrollmentInput struct {
+	UserID    uuid.UUID
+	SessionID string
+	Code      string
+	Session   auth.SessionMetadata
+}
+
+// ConfirmTOTPEnrollmentOutput contains the recovery codes, shown only once, and
+// tokens for a session that counts as signed in with MFA
+type ConfirmTOTPEnrollmentOutput struct {
+	RecoveryCodes []string
+	AccessToken   string
+	RefreshToken  string
+}
+
+// ConfirmTOTPEnrollment activates the pending TOTP secret once the user proves
+// their authenticator produces valid codes
+func (uc *MFAEnrollmentUseCase) ConfirmTOTPEnrollment(ctx context.Context, input ConfirmTOTPEnrollmentInput) (*ConfirmTOTPEnrollmentOutput, error) {
+	pgUserID := pgtype.UUID{Bytes: input.UserID, Valid: true}
+
+	user, err := uc.queries.GetUserByID(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := uc.queries.GetTOTPCredential(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return nil, ErrMFAEnrollmentNotStarted
+	}
+	if err != nil {
+		return nil, err
+	}
+	if credential.ConfirmedAt.Valid {
+		return nil, ErrMFAAlreadyEnabled
+	}
+
+	secret, err := uc.verifier.totpSecret(credential, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	step, ok := auth.ValidateTOTP(secret, input.Code, time.Now())
+	if !ok {
+		return nil, ErrInvalidMFACode
+	}
+
+	var recoveryCodes []string
+	err = pgx.BeginFunc(ctx, uc.pool, func(tx pgx.Tx) error {
+		queries := uc.queries.WithTx(tx)
+
+		confirmed, err := queries.ConfirmTOTPCredential(ctx, db.ConfirmTOTPCredentialParams{
+			UserID:       pgUserID,
+			LastUsedStep: step,
+		})
+		if err != nil {
+			return err
+		}
+		if confirmed == 0 {
+			return ErrMFAAlreadyEnabled
+		}
+
+		recoveryCodes, err = replaceRecoveryCodes(ctx, queries, pgUserID)
+		if err != nil {
+			return err
+		}
+
+		return uc.audit.Record(ctx, queries, audit.Event{
+			Action:     ActionMFAEnabled,
+			TargetType: audit.TargetUser,
+			TargetID:   input.UserID,
+			Before:     accountSnapshot{MFAEnabled: ptr(false)},
+			After:      accountSnapshot{MFAEnabled: ptr(true)},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Swap the calling session for one that is marked as MFA-verified
+	err = uc.tokenStore.RevokeRefreshToken(ctx, input.UserID, input.SessionID)
+	if err != nil && !errors.Is(err, auth.ErrSessionNotFound) {
+		return nil, err
+	}
+	if err := uc.revocations.RevokeSession(ctx, input.SessionID); err != nil {
+		return nil, err
+	}
+
+	tokens, err := startSession(ctx, uc.jwtManager, uc.tokenStore, auth.TokenSubject{
+		UserID: input.UserID,
+		Email:  user.Email,
+		Role:   user.Role,
+		Roles:  user.Roles,
+		MFA:    true,
+	}, input.Session)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConfirmTOTPEnrollmentOutput{
+		RecoveryCodes: recoveryCodes,
+		AccessToken:   tokens.AccessToken,
+		RefreshToken:  tokens.RefreshToken,
+	}, nil
+}
+
+// RegenerateRecoveryCodes replaces the user's recovery codes after checking a
+// current TOTP code
+func (uc *MFAEnrollmentUseCase) RegenerateRecoveryCodes(ctx context.Context, userID uuid.UUID, code string) ([]string, error) {
+	if err := uc.verifier.verifyTOTP(ctx, userID, code); err != nil {
+		return nil, err
+	}
+
+	pgUserID := pgtype.UUID{Bytes: userID, Valid: true}
+
+	var recoveryCodes []string
+	err := pgx.BeginFunc(ctx, uc.pool, func(tx pgx.Tx) error {
+		queries := uc.queries.WithTx(tx)
+
+		var err error
+		recoveryCodes, err = replaceRecoveryCodes(ctx, queries, pgUserID)
+		if err != nil {
+			return err
+		}
+
+		return uc.audit.Record(ctx, queries, audit.Event{
+			Action:     ActionRecoveryCodesRegenerated,
+			TargetType: audit.TargetUser,
+			TargetID:   userID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return recoveryCodes, nil
+}
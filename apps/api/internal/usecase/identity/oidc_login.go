@@ -0,0 +1,345 @@
+package identity
+
+import (
+	"context"
+	"errors"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/oidc"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrOIDCEmailRequired     = errors.New("identity provider did not return an email address")
+	ErrOIDCEmailNotVerified  = errors.New("identity provider has not verified the email address; register with a password instead")
+	ErrOIDCAccountExists     = errors.New("an account with this email already exists; sign in and link the provider instead")
+	ErrIdentityAlreadyLinked = errors.New("this provider account is linked to another user")
+	ErrIdentityNotLinked     = errors.New("no identity from this provider is linked")
+)
+
+// OIDCLoginUseCase signs users in through external OpenID Connect providers
+// and links provider identities to ChefNext accounts
+type OIDCLoginUseCase struct {
+	pool         db.Beginner
+	queries      *db.Queries
+	providers    *oidc.Registry
+	states       *oidc.StateStore
+	argon2Params *auth.Argon2Params
+	signIn       *signInFlow
+	audit        audit.Recorder
+}
+
+// NewOIDCLoginUseCase creates a new OIDC login use case
+func NewOIDCLoginUseCase(
+	pool db.Beginner,
+	queries *db.Queries,
+	jwtManager *auth.JWTManager,
+	tokenStore *auth.TokenStore,
+	oneTimeTokenStore *auth.OneTimeTokenStore,
+	mfaPolicy *auth.MFAPolicy,
+	providers *oidc.Registry,
+	states *oidc.StateStore,
+	argon2Params *auth.Argon2Params,
+	auditRecorder audit.Recorder,
+) *OIDCLoginUseCase {
+	return &OIDCLoginUseCase{
+		pool:         pool,
+		queries:      queries,
+		providers:    providers,
+		states:       states,
+		argon2Params: argon2Params,
+		signIn: &signInFlow{
+			queries:           queries,
+			jwtManager:        jwtManager,
+			tokenStore:        tokenStore,
+			oneTimeTokenStore: oneTimeTokenStore,
+			mfaPolicy:         mfaPolicy,
+		},
+		audit: auditRecorder,
+	}
+}
+
+// StartOIDCLoginInput represents start OIDC login input
+type StartOIDCLoginInput struct {
+	Provider string
+	// Role is required if the callback ends up creating a new account
+	Role string
+	// LinkUserID links the provider identity to this user instead of signing in
+	LinkUserID uuid.UUID
+}
+
+// StartLogin returns the provider URL to redirect the user to
+func (uc *OIDCLoginUseCase) StartLogin(ctx context.Context, input StartOIDCLoginInput) (string, error) {
+	if input.Role != "" && input.Role != "CHEF" && input.Role != "RESTAURANT" {
+		return "", ErrInvalidRole
+	}
+
+	provider, err := uc.providers.Get(input.Provider)
+	if err != nil {
+		return "", err
+	}
+
+	nonce, err := oidc.RandomString()
+	if err != nil {
+		return "", err
+	}
+	codeVerifier, err := oidc.RandomString()
+	if err != nil {
+		return "", err
+	}
+
+	state, err := uc.states.Save(ctx, &oidc.LoginState{
+		Provider:     input.Provider,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		Role:         input.Role,
+		LinkUserID:   input.LinkUserID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return provider.AuthCodeURL(ctx, state, nonce, codeVerifier)
+}
+
+// CompleteOIDCLoginInput represents complete OIDC login input
+type CompleteOIDCLoginInput struct {
+	State   string
+	Code    string
+	Session auth.SessionMetadata
+}
+
+// CompleteOIDCLoginOutput represents complete OIDC login output
+//
+// Login is nil when the flow linked an identity to an already signed-in user.
+type CompleteOIDCLoginOutput struct {
+	Login          *LoginOutput
+	Provider       string
+	AccountCreated bool
+	Linked         bool
+}
+
+// CompleteLogin handles the provider callback: it exchanges the code, verifies
+// the ID token and signs in, links, or creates the matching user
+func (uc *OIDCLoginUseCase) CompleteLogin(ctx context.Context, input CompleteOIDCLoginInput) (*CompleteOIDCLoginOutput, error) {
+	state, err := uc.states.Consume(ctx, input.State)
+	if err != nil {
+		return nil, err
+	}
+
+	provider, err := uc.providers.Get(state.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := provider.Exchange(ctx, input.Code, state.CodeVerifier)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Nonce != state.Nonce {
+		return nil, oidc.ErrInvalidIDToken
+	}
+
+	identity, err := uc.queries.GetUserIdentity(ctx, db.GetUserIdentityParams{
+		Provider: state.Provider,
+		Subject:  claims.Subject,
+	})
+	switch {
+	case err == nil:
+		return uc.signInLinked(ctx, state, claims, identity, input.Session)
+	case err != pgx.ErrNoRows:
+		return nil, err
+	case state.LinkUserID != uuid.Nil:
+		return uc.link(ctx, state, claims)
+	default:
+		return uc.createAccount(ctx, state, claims, input.Session)
+	}
+}
+
+// signInLinked signs in the user an identity is already linked to
+func (uc *OIDCLoginUseCase) signInLinked(ctx context.Context, state *oidc.LoginState, claims *oidc.IDTokenClaims, identity db.UserIdentity, session auth.SessionMetadata) (*CompleteOIDCLoginOutput, error) {
+	if state.LinkUserID != uuid.Nil {
+		if identity.UserID.Bytes != state.LinkUserID {
+			return nil, ErrIdentityAlreadyLinked
+		}
+		return &CompleteOIDCLoginOutput{Provider: state.Provider, Linked: true}, nil
+	}
+
+	if err := uc.queries.TouchUserIdentity(ctx, db.TouchUserIdentityParams{
+		ID:    identity.ID,
+		Email: optionalText(claims.Email),
+	}); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.queries.GetUserByID(ctx, identity.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	login, err := uc.signIn.complete(ctx, user, session)
+	if err != nil {
+		return nil, err
+	}
+	return &CompleteOIDCLoginOutput{Login: login, Provider: state.Provider}, nil
+}
+
+// link attaches a new provider identity to the signed-in user who started the flow
+func (uc *OIDCLoginUseCase) link(ctx context.Context, state *oidc.LoginState, claims *oidc.IDTokenClaims) (*CompleteOIDCLoginOutput, error) {
+	pgUserID := pgtype.UUID{Bytes: state.LinkUserID, Valid: true}
+
+	err := pgx.BeginFunc(ctx, uc.pool, func(tx pgx.Tx) error {
+		queries := uc.queries.WithTx(tx)
+
+		if _, err := queries.CreateUserIdentity(ctx, db.CreateUserIdentityParams{
+			UserID:   pgUserID,
+			Provider: state.Provider,
+			Subject:  claims.Subject,
+			Email:    optionalText(claims.Email),
+		}); err != nil {
+			return err
+		}
+
+		return uc.audit.Record(ctx, queries, audit.Event{
+			Action:      ActionIdentityLinked,
+			TargetType:  audit.TargetUser,
+			TargetID:    state.LinkUserID,
+			ActorUserID: state.LinkUserID,
+			After:       accountSnapshot{Provider: state.Provider},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CompleteOIDCLoginOutput{Provider: state.Provider, Linked: true}, nil
+}
+
+// createAccount registers a new user for an unknown provider identity; an
+// existing account with the same email must be linked explicitly so a provider
+// cannot be used to take it over. The provider must have verified the email,
+// otherwise anyone could claim an address they do not own
+func (uc *OIDCLoginUseCase) createAccount(ctx context.Context, state *oidc.LoginState, claims *oidc.IDTokenClaims, session auth.SessionMetadata) (*CompleteOIDCLoginOutput, error) {
+	if claims.Email == "" {
+		return nil, ErrOIDCEmailRequired
+	}
+	if !claims.EmailVerified {
+		return nil, ErrOIDCEmailNotVerified
+	}
+
+	_, err := uc.queries.GetUserByEmail(ctx, claims.Email)
+	if err == nil {
+		return nil, ErrOIDCAccountExists
+	}
+	if err != pgx.ErrNoRows {
+		return nil, err
+	}
+
+	if state.Role != "CHEF" && state.Role != "RESTAURANT" {
+		return nil, ErrInvalidRole
+	}
+
+	// The account has no usable password until the user sets one via reset
+	randomPassword, err := oidc.RandomString()
+	if err != nil {
+		return nil, err
+	}
+	passwordHash, err := auth.HashPassword(randomPassword, uc.argon2Params)
+	if err != nil {
+		return nil, err
+	}
+
+	// The user and its identity are created together so a failure cannot leave
+	// an account without a way to sign in
+	var user db.User
+	err = pgx.BeginFunc(ctx, uc.pool, func(tx pgx.Tx) error {
+		queries := uc.queries.WithTx(tx)
+
+		var err error
+		user, err = queries.CreateUser(ctx, db.CreateUserParams{
+			Email:        claims.Email,
+			PasswordHash: passwordHash,
+			Role:         state.Role,
+			KycStatus:    "pending",
+		})
+		if err != nil {
+			return err
+		}
+
+		if _, err := queries.CreateUserIdentity(ctx, db.CreateUserIdentityParams{
+			UserID:   user.ID,
+			Provider: state.Provider,
+			Subject:  claims.Subject,
+			Email:    optionalText(claims.Email),
+		}); err != nil {
+			return err
+		}
+
+		if err := queries.MarkUserEmailVerified(ctx, user.ID); err != nil {
+			return err
+		}
+
+		return uc.audit.Record(ctx, queries, audit.Event{
+			Action:      ActionUserCreated,
+			TargetType:  audit.TargetUser,
+			TargetID:    uuid.UUID(user.ID.Bytes),
+			ActorUserID: uuid.UUID(user.ID.Bytes),
+			After: accountSnapshot{
+				Email:         ptr(user.Email),
+				Roles:         user.Roles,
+				EmailVerified: ptr(true),
+				Provider:      state.Provider,
+			},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	login, err := uc.signIn.complete(ctx, user, session)
+	if err != nil {
+		return nil, err
+	}
+	return &CompleteOIDCLoginOutput{Login: login, Provider: state.Provider, AccountCreated: true}, nil
+}
+
+// ListIdentities returns the provider identities linked to the user
+func (uc *OIDCLoginUseCase) ListIdentities(ctx context.Context, userID uuid.UUID) ([]db.UserIdentity, error) {
+	pgUserID := pgtype.UUID{Bytes: userID, Valid: true}
+	return uc.queries.ListUserIdentities(ctx, pgUserID)
+}
+
+// UnlinkIdentity removes the user's identity from the provider
+func (uc *OIDCLoginUseCase) UnlinkIdentity(ctx context.Context, userID uuid.UUID, provider string) error {
+	pgUserID := pgtype.UUID{Bytes: userID, Valid: true}
+
+	return pgx.BeginFunc(ctx, uc.pool, func(tx pgx.Tx) error {
+		queries := uc.queries.WithTx(tx)
+
+		deleted, err := queries.DeleteUserIdentity(ctx, db.DeleteUserIdentityParams{
+			UserID:   pgUserID,
+			Provider: provider,
+		})
+		if err != nil {
+			return err
+		}
+		if deleted == 0 {
+			return ErrIdentityNotLinked
+		}
+
+		return uc.audit.Record(ctx, queries, audit.Event{
+			Action:     ActionIdentityUnlinked,
+			TargetType: audit.TargetUser,
+			TargetID:   userID,
+			Before:     accountSnapshot{Provider: provider},
+		})
+	})
+}
+
+func optionalText(value string) pgtype.Text {
+	return pgtype.Text{String: value, Valid: value != ""}
+}
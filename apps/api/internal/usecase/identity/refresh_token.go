@@ -2,8 +2,11 @@ package identity
 
 import (
 	"context"
+	"errors"
+	"slices"
 
 	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/security"
 	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -11,23 +14,28 @@ import (
 
 // RefreshTokenUseCase handles token refresh
 type RefreshTokenUseCase struct {
-	queries    *db.Queries
-	jwtManager *auth.JWTManager
-	tokenStore *auth.TokenStore
+	queries        *db.Queries
+	jwtManager     *auth.JWTManager
+	tokenStore     *auth.TokenStore
+	revocations    *auth.RevocationList
+	securityEvents security.Recorder
 }
 
 // NewRefreshTokenUseCase creates a new refresh token use case
-func NewRefreshTokenUseCase(queries *db.Queries, jwtManager *auth.JWTManager, tokenStore *auth.TokenStore) *RefreshTokenUseCase {
+func NewRefreshTokenUseCase(queries *db.Queries, jwtManager *auth.JWTManager, tokenStore *auth.TokenStore, revocations *auth.RevocationList, securityEvents security.Recorder) *RefreshTokenUseCase {
 	return &RefreshTokenUseCase{
-		queries:    queries,
-		jwtManager: jwtManager,
-		tokenStore: tokenStore,
+		queries:        queries,
+		jwtManager:     jwtManager,
+		tokenStore:     tokenStore,
+		revocations:    revocations,
+		securityEvents: securityEvents,
 	}
 }
 
 // RefreshTokenInput represents refresh token input
 type RefreshTokenInput struct {
 	RefreshToken string
+	Session      auth.SessionMetadata
 }
 
 // RefreshTokenOutput represents refresh token output
@@ -44,46 +52,65 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, input RefreshTokenIn
 		return nil, err
 	}
 
-	// Validate token in Redis
-	valid, err := uc.tokenStore.ValidateRefreshToken(ctx, claims.UserID, input.RefreshToken)
-	if err != nil {
-		return nil, err
-	}
-	if !valid {
-		return nil, auth.ErrInvalidToken
-	}
-
 	// Convert uuid.UUID to pgtype.UUID for database query
-	var pgUserID pgtype.UUID
-	if err := pgUserID.Scan(claims.UserID[:]); err != nil {
-		return nil, err
-	}
+	pgUserID := pgtype.UUID{Bytes: claims.UserID, Valid: true}
 
 	// Get user from database to ensure they still exist
 	user, err := uc.queries.GetUserByID(ctx, pgUserID)
 	if err != nil {
 		return nil, err
 	}
+	if user.SuspendedAt.Valid {
+		return nil, ErrAccountSuspended
+	}
+
+	// Keep the role the session switched to while it is still granted
+	role := user.Role
+	if slices.Contains(user.Roles, claims.Role) {
+		role = claims.Role
+	}
+
+	return uc.rotate(ctx, claims, user, role, input.RefreshToken, input.Session)
+}
 
+// rotate issues new tokens acting as role for the session of the presented
+// refresh token and retires that token
+func (uc *RefreshTokenUseCase) rotate(ctx context.Context, claims *auth.Claims, user db.User, role, presentedToken string, session auth.SessionMetadata) (*RefreshTokenOutput, error) {
 	// Convert pgtype.UUID back to uuid.UUID
 	userID, err := uuid.FromBytes(user.ID.Bytes[:])
 	if err != nil {
 		return nil, err
 	}
 
-	// Generate new tokens
-	newAccessToken, err := uc.jwtManager.GenerateAccessToken(userID, user.Email, user.Role)
+	// Generate new tokens for the same session, keeping how it was established
+	subject := auth.TokenSubject{
+		UserID:    userID,
+		Email:     user.Email,
+		Role:      role,
+		Roles:     user.Roles,
+		SessionID: claims.SessionID,
+		MFA:       claims.MFA,
+	}
+
+	newAccessToken, err := uc.jwtManager.GenerateAccessToken(subject)
 	if err != nil {
 		return nil, err
 	}
 
-	newRefreshToken, err := uc.jwtManager.GenerateRefreshToken(userID, user.Email, user.Role)
+	newRefreshToken, err := uc.jwtManager.GenerateRefreshToken(subject)
 	if err != nil {
 		return nil, err
 	}
 
-	// Store new refresh token
-	if err := uc.tokenStore.StoreRefreshToken(ctx, userID, newRefreshToken); err != nil {
+	// Swap in the new refresh token; this only succeeds if the presented one is current
+	err = uc.tokenStore.RotateRefreshToken(ctx, userID, claims.SessionID, presentedToken, newRefreshToken, session)
+	if errors.Is(err, auth.ErrRefreshTokenReused) {
+		return nil, uc.handleReuse(ctx, claims, session)
+	}
+	if errors.Is(err, auth.ErrSessionNotFound) {
+		return nil, auth.ErrInvalidToken
+	}
+	if err != nil {
 		return nil, err
 	}
 
@@ -92,3 +119,26 @@ func (uc *RefreshTokenUseCase) Execute(ctx context.Context, input RefreshTokenIn
 		RefreshToken: newRefreshToken,
 	}, nil
 }
+
+// handleReuse revokes the whole token family after an already-rotated refresh
+// token is replayed, since either the legitimate client or an attacker holds a
+// stolen copy and we cannot tell which
+func (uc *RefreshTokenUseCase) handleReuse(ctx context.Context, claims *auth.Claims, session auth.SessionMetadata) error {
+	err := uc.tokenStore.RevokeRefreshToken(ctx, claims.UserID, claims.SessionID)
+	if err != nil && !errors.Is(err, auth.ErrSessionNotFound) {
+		return err
+	}
+	if err := uc.revocations.RevokeSession(ctx, claims.SessionID); err != nil {
+		return err
+	}
+
+	uc.securityEvents.Record(ctx, security.Event{
+		Type:      security.RefreshTokenReused,
+		UserID:    claims.UserID,
+		SessionID: claims.SessionID,
+		IPAddress: session.IPAddress,
+		UserAgent: session.UserAgent,
+	})
+
+	return auth.ErrRefreshTokenReused
+}
@@ -3,7 +3,9 @@ package identity
 import (
 	"context"
 	"errors"
+	"log/slog"
 
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
 	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
 	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
 	"github.com/google/uuid"
@@ -17,17 +19,29 @@ var (
 
 // RegisterUseCase handles user registration
 type RegisterUseCase struct {
-	queries    *db.Queries
-	jwtManager *auth.JWTManager
-	tokenStore *auth.TokenStore
+	pool                  db.Beginner
+	queries               *db.Queries
+	jwtManager            *auth.JWTManager
+	tokenStore            *auth.TokenStore
+	passwordPolicy        *auth.PasswordPolicy
+	argon2Params          *auth.Argon2Params
+	sendVerificationEmail *SendVerificationEmailUseCase
+	audit                 audit.Recorder
+	log                   *slog.Logger
 }
 
 // NewRegisterUseCase creates a new register use case
-func NewRegisterUseCase(queries *db.Queries, jwtManager *auth.JWTManager, tokenStore *auth.TokenStore) *RegisterUseCase {
+func NewRegisterUseCase(pool db.Beginner, queries *db.Queries, jwtManager *auth.JWTManager, tokenStore *auth.TokenStore, passwordPolicy *auth.PasswordPolicy, argon2Params *auth.Argon2Params, sendVerificationEmail *SendVerificationEmailUseCase, auditRecorder audit.Recorder, log *slog.Logger) *RegisterUseCase {
 	return &RegisterUseCase{
-		queries:    queries,
-		jwtManager: jwtManager,
-		tokenStore: tokenStore,
+		pool:                  pool,
+		queries:               queries,
+		jwtManager:            jwtManager,
+		tokenStore:            tokenStore,
+		passwordPolicy:        passwordPolicy,
+		argon2Params:          argon2Params,
+		sendVerificationEmail: sendVerificationEmail,
+		audit:                 auditRecorder,
+		log:                   log,
 	}
 }
 
@@ -36,15 +50,17 @@ type RegisterInput struct {
 	Email    string
 	Password string
 	Role     string
+	Session  auth.SessionMetadata
 }
 
 // RegisterOutput represents registration output
 type RegisterOutput struct {
-	UserID       uuid.UUID
-	Email        string
-	Role         string
-	AccessToken  string
-	RefreshToken string
+	UserID                uuid.UUID
+	Email                 string
+	Role                  string
+	AccessToken           string
+	RefreshToken          string
+	VerificationEmailSent bool
 }
 
 // Execute executes the register use case
@@ -54,6 +70,10 @@ func (uc *RegisterUseCase) Execute(ctx context.Context, input RegisterInput) (*R
 		return nil, ErrInvalidRole
 	}
 
+	if err := uc.passwordPolicy.Check(input.Password, input.Email); err != nil {
+		return nil, err
+	}
+
 	// Check if email already exists
 	_, err := uc.queries.GetUserByEmail(ctx, input.Email)
 	if err == nil {
@@ -64,49 +84,64 @@ func (uc *RegisterUseCase) Execute(ctx context.Context, input RegisterInput) (*R
 	}
 
 	// Hash password
-	passwordHash, err := auth.HashPassword(input.Password, nil)
+	passwordHash, err := auth.HashPassword(input.Password, uc.argon2Params)
 	if err != nil {
 		return nil, err
 	}
 
 	// Create user
-	user, err := uc.queries.CreateUser(ctx, db.CreateUserParams{
-		Email:        input.Email,
-		PasswordHash: passwordHash,
-		Role:         input.Role,
-		KycStatus:    "pending",
+	var user db.User
+	err = pgx.BeginFunc(ctx, uc.pool, func(tx pgx.Tx) error {
+		queries := uc.queries.WithTx(tx)
+
+		var err error
+		user, err = queries.CreateUser(ctx, db.CreateUserParams{
+			Email:        input.Email,
+			PasswordHash: passwordHash,
+			Role:         input.Role,
+			KycStatus:    "pending",
+		})
+		if err != nil {
+			return err
+		}
+
+		return uc.audit.Record(ctx, queries, audit.Event{
+			Action:      ActionUserCreated,
+			TargetType:  audit.TargetUser,
+			TargetID:    uuid.UUID(user.ID.Bytes),
+			ActorUserID: uuid.UUID(user.ID.Bytes),
+			After:       accountSnapshot{Email: ptr(user.Email), Roles: user.Roles},
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
-
-	// Convert pgtype.UUID to uuid.UUID
-	userID, err := uuid.FromBytes(user.ID.Bytes[:])
+	userID := uuid.UUID(user.ID.Bytes)
+
+	// Start a session for the registering device
+	tokens, err := startSession(ctx, uc.jwtManager, uc.tokenStore, auth.TokenSubject{
+		UserID: userID,
+		Email:  user.Email,
+		Role:   user.Role,
+		Roles:  user.Roles,
+	}, input.Session)
 	if err != nil {
 		return nil, err
 	}
 
-	// Generate tokens
-	accessToken, err := uc.jwtManager.GenerateAccessToken(userID, user.Email, user.Role)
-	if err != nil {
-		return nil, err
-	}
-
-	refreshToken, err := uc.jwtManager.GenerateRefreshToken(userID, user.Email, user.Role)
-	if err != nil {
-		return nil, err
-	}
-
-	// Store refresh token in Redis
-	if err := uc.tokenStore.StoreRefreshToken(ctx, userID, refreshToken); err != nil {
-		return nil, err
+	// Send the verification mail; a delivery failure must not undo the
+	// registration since the user can request another mail after login
+	_, sendErr := uc.sendVerificationEmail.Execute(ctx, SendVerificationEmailInput{UserID: userID})
+	if sendErr != nil {
+		uc.log.ErrorContext(ctx, "send verification email failed", slog.String("user_id", userID.String()), slog.Any("error", sendErr))
 	}
 
 	return &RegisterOutput{
-		UserID:       userID,
-		Email:        user.Email,
-		Role:         user.Role,
-		AccessToken:  accessToken,
-		RefreshToken: refreshToken,
+		UserID:                userID,
+		Email:                 user.Email,
+		Role:                  user.Role,
+		AccessToken:           tokens.AccessToken,
+		RefreshToken:          tokens.RefreshToken,
+		VerificationEmailSent: sendErr == nil,
 	}, nil
 }
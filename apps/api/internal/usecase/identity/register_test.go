@@ -0,0 +1,295 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/mail"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/redis/go-redis/v9"
+)
+
+var verificationLinkPattern = regexp.MustCompile(`/verify-email\?token=(\S+)`)
+
+func TestRegisterAndVerifyEmail(t *testing.T) {
+	ctx := context.Background()
+	users := newFakeUsers()
+	recorder := &fakeRecorder{}
+	mailer := mail.NewMemorySender()
+
+	client := redis.NewClient(&redis.Options{Addr: miniredis.RunT(t).Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	signingKeyBox, err := auth.NewSecretBox("test-encryption-key", auth.SigningKeysPurpose, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyManager := auth.NewKeyManager(auth.NewKeyStore(client, signingKeyBox), 24*time.Hour, time.Hour, 24*time.Hour, slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err := keyManager.Init(ctx); err != nil {
+		t.Fatal(err)
+	}
+	oneTimeTokens := auth.NewOneTimeTokenStore(client, []byte("test-one-time-token-key"))
+	argon2Params := auth.DefaultArgon2Params()
+	argon2Params.Memory = 1024
+	argon2Params.Iterations = 1
+	argon2Params.Parallelism = 1
+
+	queries := db.New(users)
+	register := NewRegisterUseCase(
+		users,
+		queries,
+		auth.NewJWTManager(keyManager, 15*time.Minute, 24*time.Hour),
+		auth.NewTokenStore(client, 24*time.Hour),
+		auth.NewPasswordPolicy(12, nil, nil),
+		argon2Params,
+		NewSendVerificationEmailUseCase(queries, oneTimeTokens, mailer, "https://app.example.com"),
+		recorder,
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+	verifyEmail := NewVerifyEmailUseCase(users, queries, oneTimeTokens, recorder)
+
+	registered, err := register.Execute(ctx, RegisterInput{
+		Email:    "chef@example.com",
+		Password: "correct horse battery",
+		Role:     "CHEF",
+	})
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	if registered.AccessToken == "" || registered.RefreshToken == "" {
+		t.Error("register did not start a session")
+	}
+	if !registered.VerificationEmailSent {
+		t.Error("register reported the verification mail as not sent")
+	}
+
+	msg, ok := mailer.Last()
+	if !ok {
+		t.Fatal("no verification mail was sent")
+	}
+	if len(msg.To) != 1 || msg.To[0] != "chef@example.com" {
+		t.Errorf("verification mail sent to %v", msg.To)
+	}
+	match := verificationLinkPattern.FindStringSubmatch(msg.Body)
+	if match == nil {
+		t.Fatalf("no verification link in mail body %q", msg.Body)
+	}
+	token, err := url.QueryUnescape(match[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user := users.get(registered.UserID); user.EmailVerifiedAt.Valid {
+		t.Fatal("email verified before the link was opened")
+	}
+
+	if _, err := verifyEmail.Execute(ctx, VerifyEmailInput{Token: token}); err != nil {
+		t.Fatalf("verify email: %v", err)
+	}
+	if user := users.get(registered.UserID); !user.EmailVerifiedAt.Valid {
+		t.Error("email not verified after opening the link")
+	}
+
+	if _, err := verifyEmail.Execute(ctx, VerifyEmailInput{Token: token}); !errors.Is(err, auth.ErrInvalidOneTimeToken) {
+		t.Errorf("reusing the link: got %v, want %v", err, auth.ErrInvalidOneTimeToken)
+	}
+
+	if _, err := register.Execute(ctx, RegisterInput{
+		Email:    "chef@example.com",
+		Password: "another horse battery",
+		Role:     "CHEF",
+	}); !errors.Is(err, ErrEmailAlreadyExists) {
+		t.Errorf("registering the email again: got %v, want %v", err, ErrEmailAlreadyExists)
+	}
+
+	want := []string{ActionUserCreated, ActionEmailVerified}
+	if got := recorder.actions(); !reflect.DeepEqual(got, want) {
+		t.Errorf("audit actions = %v, want %v", got, want)
+	}
+}
+
+// fakeRecorder keeps the actions of recorded audit events and checks they are
+// written with the transaction's queries
+type fakeRecorder struct {
+	mu     sync.Mutex
+	events []audit.Event
+}
+
+func (r *fakeRecorder) Record(ctx context.Context, queries *db.Queries, event audit.Event) error {
+	if queries == nil {
+		return errors.New("audit event recorded outside a transaction")
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *fakeRecorder) actions() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	actions := make([]string, 0, len(r.events))
+	for _, event := range r.events {
+		actions = append(actions, event.Action)
+	}
+	return actions
+}
+
+// fakeUsers stands in for Postgres, answering the users queries run by
+// registration and email verification. Transactions apply writes immediately
+type fakeUsers struct {
+	mu    sync.Mutex
+	users map[uuid.UUID]db.User
+}
+
+func newFakeUsers() *fakeUsers {
+	return &fakeUsers{users: map[uuid.UUID]db.User{}}
+}
+
+func (f *fakeUsers) get(id uuid.UUID) db.User {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.users[id]
+}
+
+func (f *fakeUsers) Begin(ctx context.Context) (pgx.Tx, error) {
+	return &fakeTx{users: f}, nil
+}
+
+func (f *fakeUsers) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch name := queryName(sql); name {
+	case "MarkUserEmailVerified":
+		id := uuid.UUID(args[0].(pgtype.UUID).Bytes)
+		user, ok := f.users[id]
+		if !ok {
+			return pgconn.NewCommandTag("UPDATE 0"), nil
+		}
+		if !user.EmailVerifiedAt.Valid {
+			user.EmailVerifiedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+		}
+		f.users[id] = user
+		return pgconn.NewCommandTag("UPDATE 1"), nil
+	default:
+		return pgconn.CommandTag{}, fmt.Errorf("fake users: unexpected exec %s", name)
+	}
+}
+
+func (f *fakeUsers) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return nil, fmt.Errorf("fake users: unexpected query %s", queryName(sql))
+}
+
+func (f *fakeUsers) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch name := queryName(sql); name {
+	case "GetUserByEmail":
+		for _, user := range f.users {
+			if user.Email == args[0].(string) {
+				return userRow{user: user}
+			}
+		}
+		return userRow{err: pgx.ErrNoRows}
+	case "GetUserByID":
+		user, ok := f.users[uuid.UUID(args[0].(pgtype.UUID).Bytes)]
+		if !ok {
+			return userRow{err: pgx.ErrNoRows}
+		}
+		return userRow{user: user}
+	case "CreateUser":
+		id := uuid.New()
+		now := pgtype.Timestamptz{Time: time.Now(), Valid: true}
+		user := db.User{
+			ID:           pgtype.UUID{Bytes: id, Valid: true},
+			Email:        args[0].(string),
+			PasswordHash: args[1].(string),
+			Role:         args[2].(string),
+			KycStatus:    args[3].(string),
+			CreatedAt:    now,
+			UpdatedAt:    now,
+			Roles:        []string{args[2].(string)},
+		}
+		f.users[id] = user
+		return userRow{user: user}
+	default:
+		return userRow{err: fmt.Errorf("fake users: unexpected query %s", name)}
+	}
+}
+
+// queryName returns the sqlc query name from the "-- name:" comment that
+// starts every generated statement
+func queryName(sql string) string {
+	fields := strings.Fields(strings.TrimPrefix(sql, "-- name:"))
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
+
+// userRow scans a user into the destinations of a SELECT * or RETURNING *,
+// which sqlc lists in the order of the db.User fields
+type userRow struct {
+	user db.User
+	err  error
+}
+
+func (r userRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	fields := reflect.ValueOf(r.user)
+	if len(dest) != fields.NumField() {
+		return fmt.Errorf("fake users: scanning %d columns into %d destinations", fields.NumField(), len(dest))
+	}
+	for i := range dest {
+		reflect.ValueOf(dest[i]).Elem().Set(fields.Field(i))
+	}
+	return nil
+}
+
+// fakeTx runs statements directly against fakeUsers; pgx.Tx is embedded only
+// to satisfy the methods the flow never calls
+type fakeTx struct {
+	pgx.Tx
+	users *fakeUsers
+}
+
+func (tx *fakeTx) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	return tx.users.Exec(ctx, sql, args...)
+}
+
+func (tx *fakeTx) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return tx.users.Query(ctx, sql, args...)
+}
+
+func (tx *fakeTx) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return tx.users.QueryRow(ctx, sql, args...)
+}
+
+func (tx *fakeTx) Commit(ctx context.Context) error {
+	return nil
+}
+
+func (tx *fakeTx) Rollback(ctx context.Context) error {
+	return nil
+}
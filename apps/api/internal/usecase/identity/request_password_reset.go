@@ -0,0 +1,82 @@
+package identity
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/mail"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// passwordResetTTL is how long a password reset link stays valid
+const passwordResetTTL = time.Hour
+
+// RequestPasswordResetUseCase mails a password reset link
+type RequestPasswordResetUseCase struct {
+	queries    *db.Queries
+	tokenStore *auth.OneTimeTokenStore
+	mailer     mail.Sender
+	appBaseURL string
+}
+
+// NewRequestPasswordResetUseCase creates a new request password reset use case
+func NewRequestPasswordResetUseCase(queries *db.Queries, tokenStore *auth.OneTimeTokenStore, mailer mail.Sender, appBaseURL string) *RequestPasswordResetUseCase {
+	return &RequestPasswordResetUseCase{
+		queries:    queries,
+		tokenStore: tokenStore,
+		mailer:     mailer,
+		appBaseURL: appBaseURL,
+	}
+}
+
+// RequestPasswordResetInput represents request password reset input
+type RequestPasswordResetInput struct {
+	Email string
+}
+
+// RequestPasswordResetOutput represents request password reset output
+type RequestPasswordResetOutput struct {
+	Success bool
+}
+
+// Execute executes the request password reset use case
+func (uc *RequestPasswordResetUseCase) Execute(ctx context.Context, input RequestPasswordResetInput) (*RequestPasswordResetOutput, error) {
+	user, err := uc.queries.GetUserByEmail(ctx, input.Email)
+	if err == pgx.ErrNoRows {
+		// Report success for unknown emails to avoid leaking which accounts exist
+		return &RequestPasswordResetOutput{Success: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := uuid.FromBytes(user.ID.Bytes[:])
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := uc.tokenStore.Issue(ctx, auth.PasswordResetPurpose, userID, passwordResetTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	link := fmt.Sprintf("%s/reset-password?token=%s", uc.appBaseURL, url.QueryEscape(token))
+	msg := mail.Message{
+		To:      []string{user.Email},
+		Subject: "Reset your ChefNext password",
+		Body: fmt.Sprintf(
+			"We received a request to reset the password for your ChefNext account.\n\nOpen the link below to choose a new password:\n\n%s\n\nThis link expires in 1 hour and can only be used once. If you did not request a reset, you can ignore this email.\n",
+			link,
+		),
+	}
+	if err := uc.mailer.Send(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &RequestPasswordResetOutput{Success: true}, nil
+}
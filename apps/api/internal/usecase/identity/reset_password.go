@@ -0,0 +1,121 @@
+package identity
+
+import (
+	"context"
+	"errors"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrPasswordRequired = errors.New("password is required")
+)
+
+// ResetPasswordUseCase sets a new password from a password reset token
+type ResetPasswordUseCase struct {
+	pool              db.Beginner
+	queries           *db.Queries
+	oneTimeTokenStore *auth.OneTimeTokenStore
+	tokenStore        *auth.TokenStore
+	revocations       *auth.RevocationList
+	passwordPolicy    *auth.PasswordPolicy
+	argon2Params      *auth.Argon2Params
+	audit             audit.Recorder
+}
+
+// NewResetPasswordUseCase creates a new reset password use case
+func NewResetPasswordUseCase(pool db.Beginner, queries *db.Queries, oneTimeTokenStore *auth.OneTimeTokenStore, tokenStore *auth.TokenStore, revocations *auth.RevocationList, passwordPolicy *auth.PasswordPolicy, argon2Params *auth.Argon2Params, auditRecorder audit.Recorder) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		pool:              pool,
+		queries:           queries,
+		oneTimeTokenStore: oneTimeTokenStore,
+		tokenStore:        tokenStore,
+		revocations:       revocations,
+		passwordPolicy:    passwordPolicy,
+		argon2Params:      argon2Params,
+		audit:             auditRecorder,
+	}
+}
+
+// ResetPasswordInput represents reset password input
+type ResetPasswordInput struct {
+	Token       string
+	NewPassword string
+}
+
+// ResetPasswordOutput represents reset password output
+type ResetPasswordOutput struct {
+	Success bool
+}
+
+// Execute executes the reset password use case
+func (uc *ResetPasswordUseCase) Execute(ctx context.Context, input ResetPasswordInput) (*ResetPasswordOutput, error) {
+	if input.NewPassword == "" {
+		return nil, ErrPasswordRequired
+	}
+
+	// Check the policy before consuming the token so the user can retry with
+	// a stronger password from the same reset link
+	userID, err := uc.oneTimeTokenStore.Peek(ctx, auth.PasswordResetPurpose, input.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	pgUserID := pgtype.UUID{Bytes: userID, Valid: true}
+
+	user, err := uc.queries.GetUserByID(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return nil, auth.ErrInvalidOneTimeToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.passwordPolicy.Check(input.NewPassword, user.Email); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.oneTimeTokenStore.Consume(ctx, auth.PasswordResetPurpose, input.Token); err != nil {
+		return nil, err
+	}
+
+	passwordHash, err := auth.HashPassword(input.NewPassword, uc.argon2Params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = pgx.BeginFunc(ctx, uc.pool, func(tx pgx.Tx) error {
+		queries := uc.queries.WithTx(tx)
+
+		if err := queries.UpdateUserPasswordHash(ctx, db.UpdateUserPasswordHashParams{
+			ID:           pgUserID,
+			PasswordHash: passwordHash,
+		}); err != nil {
+			return err
+		}
+
+		return uc.audit.Record(ctx, queries, audit.Event{
+			Action:      ActionPasswordReset,
+			TargetType:  audit.TargetUser,
+			TargetID:    userID,
+			ActorUserID: userID,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign out every device that may have been used by someone else
+	if err := uc.tokenStore.RevokeAllUserTokens(ctx, userID); err != nil {
+		return nil, err
+	}
+	if err := uc.revocations.RevokeUserTokens(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return &ResetPasswordOutput{Success: true}, nil
+}
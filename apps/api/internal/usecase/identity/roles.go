@@ -0,0 +1,168 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+var (
+	ErrRoleNotGranted     = errors.New("role is not granted to this account")
+	ErrRoleAlreadyGranted = errors.New("role is already granted to this account")
+	ErrAdminRolesFixed    = errors.New("admin accounts cannot hold other roles")
+)
+
+// RolesUseCase lets a user hold both CHEF and RESTAURANT and switch which one
+// their session acts as
+//
+// The active role a session acts as is its token's role claim. The role last
+// switched to is also stored as the user's default for future logins.
+type RolesUseCase struct {
+	pool          db.Beginner
+	queries       *db.Queries
+	jwtManager    *auth.JWTManager
+	refreshTokens *RefreshTokenUseCase
+	audit         audit.Recorder
+}
+
+// NewRolesUseCase creates a new roles use case
+func NewRolesUseCase(pool db.Beginner, queries *db.Queries, jwtManager *auth.JWTManager, refreshTokens *RefreshTokenUseCase, auditRecorder audit.Recorder) *RolesUseCase {
+	return &RolesUseCase{
+		pool:          pool,
+		queries:       queries,
+		jwtManager:    jwtManager,
+		refreshTokens: refreshTokens,
+		audit:         auditRecorder,
+	}
+}
+
+// AddRole grants the user another self-service role and returns every role
+// they now hold; the current session keeps its active role
+func (uc *RolesUseCase) AddRole(ctx context.Context, userID uuid.UUID, role string) ([]string, error) {
+	if role != "CHEF" && role != "RESTAURANT" {
+		return nil, ErrInvalidRole
+	}
+
+	pgUserID := pgtype.UUID{Bytes: userID, Valid: true}
+
+	user, err := uc.queries.GetUserByID(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(user.Roles, "ADMIN") {
+		return nil, ErrAdminRolesFixed
+	}
+
+	var updated db.User
+	err = pgx.BeginFunc(ctx, uc.pool, func(tx pgx.Tx) error {
+		queries := uc.queries.WithTx(tx)
+
+		var err error
+		updated, err = queries.AddUserRole(ctx, db.AddUserRoleParams{
+			ID:   pgUserID,
+			Role: role,
+		})
+		if err == pgx.ErrNoRows {
+			return ErrRoleAlreadyGranted
+		}
+		if err != nil {
+			return err
+		}
+
+		return uc.audit.Record(ctx, queries, audit.Event{
+			Action:     ActionRoleAdded,
+			TargetType: audit.TargetUser,
+			TargetID:   userID,
+			Before:     accountSnapshot{Roles: user.Roles},
+			After:      accountSnapshot{Roles: updated.Roles},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated.Roles, nil
+}
+
+// SwitchRoleInput represents switch role input
+type SwitchRoleInput struct {
+	UserID uuid.UUID
+	// RefreshToken is the current session's refresh token, which is rotated
+	RefreshToken string
+	Role         string
+	Session      auth.SessionMetadata
+}
+
+// SwitchRole issues new tokens for the current session acting as another
+// granted role
+func (uc *RolesUseCase) SwitchRole(ctx context.Context, input SwitchRoleInput) (*RefreshTokenOutput, error) {
+	claims, err := uc.jwtManager.VerifyRefreshToken(input.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	// The refresh token must belong to the caller, not just be valid
+	if claims.UserID != input.UserID {
+		return nil, auth.ErrInvalidToken
+	}
+
+	pgUserID := pgtype.UUID{Bytes: input.UserID, Valid: true}
+
+	user, err := uc.queries.GetUserByID(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if user.SuspendedAt.Valid {
+		return nil, ErrAccountSuspended
+	}
+	if !slices.Contains(user.Roles, input.Role) {
+		return nil, ErrRoleNotGranted
+	}
+
+	output, err := uc.refreshTokens.rotate(ctx, claims, user, input.Role, input.RefreshToken, input.Session)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only remember the choice once the session actually switched
+	if _, err := uc.queries.SetUserActiveRole(ctx, db.SetUserActiveRoleParams{
+		ID:   pgUserID,
+		Role: input.Role,
+	}); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// useRole makes role the user's active role for a new login; an empty role
+// keeps the default
+func useRole(ctx context.Context, queries *db.Queries, user *db.User, role string) error {
+	if role == "" || role == user.Role {
+		return nil
+	}
+	if !slices.Contains(user.Roles, role) {
+		return ErrRoleNotGranted
+	}
+
+	if _, err := queries.SetUserActiveRole(ctx, db.SetUserActiveRoleParams{
+		ID:   user.ID,
+		Role: role,
+	}); err != nil {
+		return err
+	}
+	user.Role = role
+	return nil
+}
@@ -0,0 +1,89 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/mail"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// emailVerificationTTL is how long a verification link stays valid
+const emailVerificationTTL = 24 * time.Hour
+
+var (
+	ErrUserNotFound         = errors.New("user not found")
+	ErrEmailAlreadyVerified = errors.New("email already verified")
+)
+
+// SendVerificationEmailUseCase mails an email verification link to a user
+type SendVerificationEmailUseCase struct {
+	queries    *db.Queries
+	tokenStore *auth.OneTimeTokenStore
+	mailer     mail.Sender
+	appBaseURL string
+}
+
+// NewSendVerificationEmailUseCase creates a new send verification email use case
+func NewSendVerificationEmailUseCase(queries *db.Queries, tokenStore *auth.OneTimeTokenStore, mailer mail.Sender, appBaseURL string) *SendVerificationEmailUseCase {
+	return &SendVerificationEmailUseCase{
+		queries:    queries,
+		tokenStore: tokenStore,
+		mailer:     mailer,
+		appBaseURL: appBaseURL,
+	}
+}
+
+// SendVerificationEmailInput represents send verification email input
+type SendVerificationEmailInput struct {
+	UserID uuid.UUID
+}
+
+// SendVerificationEmailOutput represents send verification email output
+type SendVerificationEmailOutput struct {
+	Success bool
+}
+
+// Execute executes the send verification email use case
+func (uc *SendVerificationEmailUseCase) Execute(ctx context.Context, input SendVerificationEmailInput) (*SendVerificationEmailOutput, error) {
+	pgUserID := pgtype.UUID{Bytes: input.UserID, Valid: true}
+
+	user, err := uc.queries.GetUserByID(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if user.EmailVerifiedAt.Valid {
+		return nil, ErrEmailAlreadyVerified
+	}
+
+	token, err := uc.tokenStore.Issue(ctx, auth.EmailVerificationPurpose, input.UserID, emailVerificationTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	link := fmt.Sprintf("%s/verify-email?token=%s", uc.appBaseURL, url.QueryEscape(token))
+	msg := mail.Message{
+		To:      []string{user.Email},
+		Subject: "Confirm your ChefNext email address",
+		Body: fmt.Sprintf(
+			"Welcome to ChefNext!\n\nPlease confirm your email address by opening the link below:\n\n%s\n\nThis link expires in 24 hours. If you did not create a ChefNext account, you can ignore this email.\n",
+			link,
+		),
+	}
+	if err := uc.mailer.Send(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	return &SendVerificationEmailOutput{Success: true}, nil
+}
@@ -0,0 +1,105 @@
+package identity
+
+import (
+	"context"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+)
+
+// tokenPair holds the credentials handed to a client after it signs in
+type tokenPair struct {
+	SessionID    string
+	AccessToken  string
+	RefreshToken string
+}
+
+// startSession creates a new device session and issues its access/refresh token pair
+func startSession(ctx context.Context, jwtManager *auth.JWTManager, tokenStore *auth.TokenStore, subject auth.TokenSubject, metadata auth.SessionMetadata) (*tokenPair, error) {
+	session := auth.NewSession(subject.UserID, metadata)
+	subject.SessionID = session.ID
+
+	accessToken, err := jwtManager.GenerateAccessToken(subject)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := jwtManager.GenerateRefreshToken(subject)
+	if err != nil {
+		return nil, err
+	}
+
+	// Store refresh token in Redis
+	if err := tokenStore.StoreRefreshToken(ctx, session, refreshToken); err != nil {
+		return nil, err
+	}
+
+	return &tokenPair{
+		SessionID:    session.ID,
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// signInFlow finishes a login once the first factor has been checked: users
+// with MFA get a challenge token, everyone else a new session
+type signInFlow struct {
+	queries           *db.Queries
+	jwtManager        *auth.JWTManager
+	tokenStore        *auth.TokenStore
+	oneTimeTokenStore *auth.OneTimeTokenStore
+	mfaPolicy         *auth.MFAPolicy
+}
+
+func (f *signInFlow) complete(ctx context.Context, user db.User, metadata auth.SessionMetadata) (*LoginOutput, error) {
+	if user.SuspendedAt.Valid {
+		return nil, ErrAccountSuspended
+	}
+
+	userID, err := uuid.FromBytes(user.ID.Bytes[:])
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := mfaEnabled(ctx, f.queries, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if enabled {
+		mfaToken, err := f.oneTimeTokenStore.Issue(ctx, auth.MFAChallengePurpose, userID, mfaChallengeTTL)
+		if err != nil {
+			return nil, err
+		}
+
+		return &LoginOutput{
+			UserID:      userID,
+			Email:       user.Email,
+			Role:        user.Role,
+			Roles:       user.Roles,
+			MFARequired: true,
+			MFAToken:    mfaToken,
+		}, nil
+	}
+
+	tokens, err := startSession(ctx, f.jwtManager, f.tokenStore, auth.TokenSubject{
+		UserID: userID,
+		Email:  user.Email,
+		Role:   user.Role,
+		Roles:  user.Roles,
+	}, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LoginOutput{
+		UserID:       userID,
+		Email:        user.Email,
+		Role:         user.Role,
+		Roles:        user.Roles,
+		AccessToken:  tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+		// Roles that require MFA can only enroll until they set it up
+		MFAEnrollmentRequired: f.mfaPolicy.Requires(user.Roles...),
+	}, nil
+}
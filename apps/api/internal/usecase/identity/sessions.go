@@ -0,0 +1,57 @@
+package identity
+
+import (
+	"context"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/google/uuid"
+)
+
+// SessionsUseCase lists and revokes a user's device sessions
+type SessionsUseCase struct {
+	tokenStore  *auth.TokenStore
+	revocations *auth.RevocationList
+}
+
+// NewSessionsUseCase creates a new sessions use case
+func NewSessionsUseCase(tokenStore *auth.TokenStore, revocations *auth.RevocationList) *SessionsUseCase {
+	return &SessionsUseCase{
+		tokenStore:  tokenStore,
+		revocations: revocations,
+	}
+}
+
+// ListSessions returns every active session of the user
+func (uc *SessionsUseCase) ListSessions(ctx context.Context, userID uuid.UUID) ([]*auth.Session, error) {
+	return uc.tokenStore.ListSessions(ctx, userID)
+}
+
+// RevokeSession signs out a single session of the user
+func (uc *SessionsUseCase) RevokeSession(ctx context.Context, userID uuid.UUID, sessionID string) error {
+	if err := uc.tokenStore.RevokeRefreshToken(ctx, userID, sessionID); err != nil {
+		return err
+	}
+	return uc.revocations.RevokeSession(ctx, sessionID)
+}
+
+// RevokeOtherSessions signs out every session of the user except the current one
+func (uc *SessionsUseCase) RevokeOtherSessions(ctx context.Context, userID uuid.UUID, currentSessionID string) error {
+	sessions, err := uc.tokenStore.ListSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.tokenStore.RevokeOtherSessions(ctx, userID, currentSessionID); err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.ID == currentSessionID {
+			continue
+		}
+		if err := uc.revocations.RevokeSession(ctx, session.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
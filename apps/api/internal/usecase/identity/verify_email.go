@@ -0,0 +1,72 @@
+package identity
+
+import (
+	"context"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// VerifyEmailUseCase confirms a user's email address
+type VerifyEmailUseCase struct {
+	pool       db.Beginner
+	queries    *db.Queries
+	tokenStore *auth.OneTimeTokenStore
+	audit      audit.Recorder
+}
+
+// NewVerifyEmailUseCase creates a new verify email use case
+func NewVerifyEmailUseCase(pool db.Beginner, queries *db.Queries, tokenStore *auth.OneTimeTokenStore, auditRecorder audit.Recorder) *VerifyEmailUseCase {
+	return &VerifyEmailUseCase{
+		pool:       pool,
+		queries:    queries,
+		tokenStore: tokenStore,
+		audit:      auditRecorder,
+	}
+}
+
+// VerifyEmailInput represents verify email input
+type VerifyEmailInput struct {
+	Token string
+}
+
+// VerifyEmailOutput represents verify email output
+type VerifyEmailOutput struct {
+	Success bool
+}
+
+// Execute executes the verify email use case
+func (uc *VerifyEmailUseCase) Execute(ctx context.Context, input VerifyEmailInput) (*VerifyEmailOutput, error) {
+	// Consuming the token makes the link single-use
+	userID, err := uc.tokenStore.Consume(ctx, auth.EmailVerificationPurpose, input.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	pgUserID := pgtype.UUID{Bytes: userID, Valid: true}
+
+	err = pgx.BeginFunc(ctx, uc.pool, func(tx pgx.Tx) error {
+		queries := uc.queries.WithTx(tx)
+
+		if err := queries.MarkUserEmailVerified(ctx, pgUserID); err != nil {
+			return err
+		}
+
+		return uc.audit.Record(ctx, queries, audit.Event{
+			Action:      ActionEmailVerified,
+			TargetType:  audit.TargetUser,
+			TargetID:    userID,
+			ActorUserID: userID,
+			Before:      accountSnapshot{EmailVerified: ptr(false)},
+			After:       accountSnapshot{EmailVerified: ptr(true)},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerifyEmailOutput{Success: true}, nil
+}
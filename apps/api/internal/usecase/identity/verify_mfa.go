@@ -0,0 +1,153 @@
+package identity
+
+import (
+	"context"
+	"errors"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/security"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// VerifyMFAUseCase completes a two-step login by checking the second factor
+// against the challenge issued by LoginUseCase
+type VerifyMFAUseCase struct {
+	queries           *db.Queries
+	jwtManager        *auth.JWTManager
+	tokenStore        *auth.TokenStore
+	oneTimeTokenStore *auth.OneTimeTokenStore
+	loginAttempts     *auth.LoginAttemptLimiter
+	securityEvents    security.Recorder
+	verifier          *mfaVerifier
+}
+
+// NewVerifyMFAUseCase creates a new verify MFA use case
+func NewVerifyMFAUseCase(
+	queries *db.Queries,
+	jwtManager *auth.JWTManager,
+	tokenStore *auth.TokenStore,
+	oneTimeTokenStore *auth.OneTimeTokenStore,
+	loginAttempts *auth.LoginAttemptLimiter,
+	securityEvents security.Recorder,
+	secretBox *auth.SecretBox,
+) *VerifyMFAUseCase {
+	return &VerifyMFAUseCase{
+		queries:           queries,
+		jwtManager:        jwtManager,
+		tokenStore:        tokenStore,
+		oneTimeTokenStore: oneTimeTokenStore,
+		loginAttempts:     loginAttempts,
+		securityEvents:    securityEvents,
+		verifier: &mfaVerifier{
+			queries:   queries,
+			secretBox: secretBox,
+		},
+	}
+}
+
+// VerifyMFAInput represents verify MFA input
+type VerifyMFAInput struct {
+	MFAToken string
+	Code     string
+	Session  auth.SessionMetadata
+}
+
+// VerifyMFAOutput represents verify MFA output
+type VerifyMFAOutput struct {
+	UserID                 uuid.UUID
+	Email                  string
+	Role                   string
+	Roles                  []string
+	AccessToken            string
+	RefreshToken           string
+	RecoveryCodesRemaining int64
+}
+
+// Execute executes the verify MFA use case
+func (uc *VerifyMFAUseCase) Execute(ctx context.Context, input VerifyMFAInput) (*VerifyMFAOutput, error) {
+	// The challenge stays valid across wrong codes until it expires
+	userID, err := uc.oneTimeTokenStore.Peek(ctx, auth.MFAChallengePurpose, input.MFAToken)
+	if err != nil {
+		return nil, err
+	}
+
+	pgUserID := pgtype.UUID{Bytes: userID, Valid: true}
+
+	user, err := uc.queries.GetUserByID(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return nil, auth.ErrInvalidOneTimeToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	if user.SuspendedAt.Valid {
+		return nil, ErrAccountSuspended
+	}
+
+	// Wrong codes count towards the same lockout as wrong passwords
+	if err := uc.loginAttempts.Check(ctx, user.Email, input.Session.IPAddress); err != nil {
+		return nil, err
+	}
+
+	usedRecoveryCode, err := uc.verifier.verifyCode(ctx, userID, input.Code)
+	if errors.Is(err, ErrInvalidMFACode) {
+		uc.recordEvent(ctx, security.MFAFailed, userID, input)
+		if err := uc.loginAttempts.RecordFailure(ctx, user.Email, input.Session.IPAddress); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidMFACode
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Consume the challenge so a concurrent attempt cannot reuse it
+	if _, err := uc.oneTimeTokenStore.Consume(ctx, auth.MFAChallengePurpose, input.MFAToken); err != nil {
+		return nil, err
+	}
+
+	if err := uc.loginAttempts.Reset(ctx, user.Email); err != nil {
+		return nil, err
+	}
+	if usedRecoveryCode {
+		uc.recordEvent(ctx, security.MFARecoveryCodeUsed, userID, input)
+	}
+
+	remaining, err := uc.queries.CountUnusedRecoveryCodes(ctx, pgUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := startSession(ctx, uc.jwtManager, uc.tokenStore, auth.TokenSubject{
+		UserID: userID,
+		Email:  user.Email,
+		Role:   user.Role,
+		Roles:  user.Roles,
+		MFA:    true,
+	}, input.Session)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VerifyMFAOutput{
+		UserID:                 userID,
+		Email:                  user.Email,
+		Role:                   user.Role,
+		Roles:                  user.Roles,
+		AccessToken:            tokens.AccessToken,
+		RefreshToken:           tokens.RefreshToken,
+		RecoveryCodesRemaining: remaining,
+	}, nil
+}
+
+func (uc *VerifyMFAUseCase) recordEvent(ctx context.Context, eventType security.EventType, userID uuid.UUID, input VerifyMFAInput) {
+	uc.securityEvents.Record(ctx, security.Event{
+		Type:      eventType,
+		UserID:    userID,
+		IPAddress: input.Session.IPAddress,
+		UserAgent: input.Session.UserAgent,
+	})
+}
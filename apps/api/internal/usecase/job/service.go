@@ -7,7 +7,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
 	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/chefnext/chefnext/apps/api/internal/usecase/kyc"
+	"github.com/chefnext/chefnext/apps/api/internal/usecase/restaurantteam"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
@@ -21,16 +24,36 @@ var (
 	ErrApplicationExists        = errors.New("application already exists")
 	ErrApplicationNotFound      = errors.New("application not found")
 	ErrJobNotPublished          = errors.New("job is not open for applications")
+	ErrEmailNotVerified         = errors.New("email address must be verified first")
+	ErrRestaurantNotVerified    = errors.New("restaurant must pass business verification before publishing jobs")
+)
+
+// Metrics counts job and application events for monitoring.
+type Metrics interface {
+	JobPublished()
+	ApplicationCreated()
+	ApplicationStatusChanged(status string)
+}
+
+// Audit actions recorded for jobs and applications.
+const (
+	ActionJobCreated               = "job_created"
+	ActionJobUpdated               = "job_updated"
+	ActionApplicationCreated       = "application_created"
+	ActionApplicationStatusChanged = "application_status_changed"
 )
 
 // Service coordinates job and application workflows against the data store.
 type Service struct {
+	pool    db.Beginner
 	queries *db.Queries
+	metrics Metrics
+	audit   audit.Recorder
 }
 
 // NewService wires the job/application service.
-func NewService(queries *db.Queries) *Service {
-	return &Service{queries: queries}
+func NewService(pool db.Beginner, queries *db.Queries, metrics Metrics, auditRecorder audit.Recorder) *Service {
+	return &Service{pool: pool, queries: queries, metrics: metrics, audit: auditRecorder}
 }
 
 // Job represents a job posting with optional restaurant context.
@@ -150,18 +173,31 @@ type UpdateApplicationStatusInput struct {
 	Status        db.ApplicationStatus
 }
 
-// CreateJob creates a job for the authenticated restaurant owner.
+// CreateJob creates a job for the restaurant the user manages jobs for.
 func (s *Service) CreateJob(ctx context.Context, userID uuid.UUID, input CreateJobInput) (*Job, error) {
-	restaurant, err := s.getRestaurantProfileByUser(ctx, userID)
+	if err := s.ensureEmailVerified(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	restaurant, err := s.getRestaurantForMember(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
+	if !restaurantteam.Allows(restaurant.role, restaurantteam.PermissionManageJobs) {
+		return nil, ErrForbidden
+	}
 
 	status := db.JobStatusDRAFT
 	if input.Status != nil {
 		status = *input.Status
 	}
 
+	if status == db.JobStatusPUBLISHED {
+		if err := s.ensureKYCVerified(ctx, restaurant.id); err != nil {
+			return nil, err
+		}
+	}
+
 	params := db.CreateJobParams{
 		RestaurantID:   restaurant.id,
 		Title:          strings.TrimSpace(input.Title),
@@ -174,24 +210,52 @@ func (s *Service) CreateJob(ctx context.Context, userID uuid.UUID, input CreateJ
 		Metadata:       metadataOrDefault(input.Metadata),
 	}
 
-	row, err := s.queries.CreateJob(ctx, params)
+	var job *Job
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		row, err := queries.CreateJob(ctx, params)
+		if err != nil {
+			return err
+		}
+		job, err = mapJobFromColumns(jobColumnsFromCreate(row), restaurant.toSummary())
+		if err != nil {
+			return err
+		}
+
+		return s.audit.Record(ctx, queries, audit.Event{
+			Action:       ActionJobCreated,
+			TargetType:   audit.TargetJob,
+			TargetID:     job.ID,
+			RestaurantID: job.RestaurantID,
+			After:        auditJob(job),
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
+	if job.Status == db.JobStatusPUBLISHED {
+		s.metrics.JobPublished()
+	}
 
-	summary := restaurant.toSummary()
-	return mapJobFromColumns(jobColumnsFromCreate(row), summary)
+	return job, nil
 }
 
-// UpdateJob updates mutable job fields owned by the restaurant user.
+// UpdateJob updates mutable job fields for members who manage the restaurant's jobs.
 func (s *Service) UpdateJob(ctx context.Context, userID uuid.UUID, input UpdateJobInput) (*Job, error) {
 	ownership, err := s.getJobOwnership(ctx, input.JobID)
 	if err != nil {
 		return nil, err
 	}
 
-	if ownership.restaurantUserID != userID {
-		return nil, ErrForbidden
+	if err := s.authorizeMember(ctx, ownership.restaurantID, userID, restaurantteam.PermissionManageJobs); err != nil {
+		return nil, err
+	}
+
+	if input.Status != nil && *input.Status == db.JobStatusPUBLISHED {
+		if err := s.ensureKYCVerified(ctx, ownership.restaurantID); err != nil {
+			return nil, err
+		}
 	}
 
 	params := db.UpdateJobParams{
@@ -214,26 +278,56 @@ func (s *Service) UpdateJob(ctx context.Context, userID uuid.UUID, input UpdateJ
 		params.Metadata = metadataOrDefault(*input.Metadata)
 	}
 
-	row, err := s.queries.UpdateJob(ctx, params)
+	// Pull restaurant summary for response.
+	summary, err := s.getRestaurantSummaryByID(ctx, ownership.restaurantID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Pull restaurant summary for response.
-	summary, err := s.getRestaurantSummaryByID(ctx, ownership.restaurantID)
+	var job *Job
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		existing, err := queries.GetJobByID(ctx, ownership.jobID)
+		if err != nil {
+			return err
+		}
+		before, err := mapJobFromColumns(jobColumnsFromGet(existing), nil)
+		if err != nil {
+			return err
+		}
+
+		row, err := queries.UpdateJob(ctx, params)
+		if err != nil {
+			return err
+		}
+		job, err = mapJobFromColumns(jobColumnsFromUpdate(row), summary)
+		if err != nil {
+			return err
+		}
+
+		return s.audit.Record(ctx, queries, audit.Event{
+			Action:       ActionJobUpdated,
+			TargetType:   audit.TargetJob,
+			TargetID:     job.ID,
+			RestaurantID: job.RestaurantID,
+			Before:       auditJob(before),
+			After:        auditJob(job),
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
+	if job.Status == db.JobStatusPUBLISHED && ownership.status != db.JobStatusPUBLISHED {
+		s.metrics.JobPublished()
+	}
 
-	return mapJobFromColumns(jobColumnsFromUpdate(row), summary)
+	return job, nil
 }
 
 // GetJob fetches a published job (or any job if owner) by ID.
 func (s *Service) GetJob(ctx context.Context, jobID uuid.UUID) (*Job, error) {
-	pgID, err := toPgUUID(jobID)
-	if err != nil {
-		return nil, err
-	}
+	pgID := toPgUUID(jobID)
 
 	row, err := s.queries.GetJobByID(ctx, pgID)
 	if err == pgx.ErrNoRows {
@@ -247,9 +341,9 @@ func (s *Service) GetJob(ctx context.Context, jobID uuid.UUID) (*Job, error) {
 	return mapJobFromColumns(jobColumnsFromGet(row), summary)
 }
 
-// ListJobsForRestaurant returns jobs owned by the authenticated restaurant.
+// ListJobsForRestaurant returns jobs of the restaurant the user belongs to.
 func (s *Service) ListJobsForRestaurant(ctx context.Context, userID uuid.UUID, input ListJobsInput) (*JobListOutput, error) {
-	restaurant, err := s.getRestaurantProfileByUser(ctx, userID)
+	restaurant, err := s.getRestaurantForMember(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -312,6 +406,10 @@ func (s *Service) SearchJobs(ctx context.Context, input SearchJobsInput) (*JobSe
 
 // CreateApplication allows chefs to apply to a published job.
 func (s *Service) CreateApplication(ctx context.Context, userID uuid.UUID, input CreateApplicationInput) (*Application, error) {
+	if err := s.ensureEmailVerified(ctx, userID); err != nil {
+		return nil, err
+	}
+
 	job, err := s.GetJob(ctx, input.JobID)
 	if err != nil {
 		return nil, err
@@ -331,27 +429,48 @@ func (s *Service) CreateApplication(ctx context.Context, userID uuid.UUID, input
 	}
 
 	params := db.CreateApplicationParams{
-		JobID:         toPgUUIDMust(job.ID),
+		JobID:         toPgUUID(job.ID),
 		ChefProfileID: chef.id,
 		Status:        db.ApplicationStatusPENDING,
 		CoverLetter:   textParam(input.CoverLetter),
 	}
 
-	created, err := s.queries.CreateApplication(ctx, params)
+	var application *Application
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		created, err := queries.CreateApplication(ctx, params)
+		if err != nil {
+			return err
+		}
+		application, err = mapApplicationBase(created, &JobSummary{
+			ID:             job.ID,
+			Title:          job.Title,
+			Status:         job.Status,
+			RestaurantName: summaryName(job.Restaurant),
+		}, &ChefSummary{
+			ProfileID: chef.uuid,
+			FullName:  chef.fullName,
+			Location:  chef.location,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.audit.Record(ctx, queries, audit.Event{
+			Action:       ActionApplicationCreated,
+			TargetType:   audit.TargetApplication,
+			TargetID:     application.ID,
+			RestaurantID: job.RestaurantID,
+			After:        auditApplication(application.JobID, application.Status),
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
+	s.metrics.ApplicationCreated()
 
-	return mapApplicationBase(created, &JobSummary{
-		ID:             job.ID,
-		Title:          job.Title,
-		Status:         job.Status,
-		RestaurantName: summaryName(job.Restaurant),
-	}, &ChefSummary{
-		ProfileID: chef.uuid,
-		FullName:  chef.fullName,
-		Location:  chef.location,
-	})
+	return application, nil
 }
 
 // ListApplicationsForChef lists applications for the chef user.
@@ -394,10 +513,13 @@ func (s *Service) ListApplicationsForChef(ctx context.Context, userID uuid.UUID,
 
 // ListApplicationsForRestaurant lists applications submitted to the restaurant's jobs.
 func (s *Service) ListApplicationsForRestaurant(ctx context.Context, userID uuid.UUID, limit, offset int32) ([]*Application, error) {
-	restaurant, err := s.getRestaurantProfileByUser(ctx, userID)
+	restaurant, err := s.getRestaurantForMember(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
+	if !restaurantteam.Allows(restaurant.role, restaurantteam.PermissionViewApplications) {
+		return nil, ErrForbidden
+	}
 
 	rows, err := s.queries.ListApplicationsForRestaurant(ctx, db.ListApplicationsForRestaurantParams{
 		RestaurantID: restaurant.id,
@@ -434,9 +556,9 @@ func (s *Service) ListApplicationsForRestaurant(ctx context.Context, userID uuid
 	return apps, nil
 }
 
-// UpdateApplicationStatus lets restaurant owners accept/reject.
+// UpdateApplicationStatus lets team members who review applications accept/reject.
 func (s *Service) UpdateApplicationStatus(ctx context.Context, userID uuid.UUID, input UpdateApplicationStatusInput) (*Application, error) {
-	ownership, err := s.queries.GetApplicationOwnership(ctx, toPgUUIDMust(input.ApplicationID))
+	ownership, err := s.queries.GetApplicationOwnership(ctx, toPgUUID(input.ApplicationID))
 	if err == pgx.ErrNoRows {
 		return nil, ErrApplicationNotFound
 	}
@@ -444,21 +566,38 @@ func (s *Service) UpdateApplicationStatus(ctx context.Context, userID uuid.UUID,
 		return nil, err
 	}
 
-	restaurantUserID, err := uuidFromPg(ownership.RestaurantUserID)
-	if err != nil {
+	if err := s.authorizeMember(ctx, ownership.RestaurantID, userID, restaurantteam.PermissionReviewApplications); err != nil {
 		return nil, err
 	}
-	if restaurantUserID != userID {
-		return nil, ErrForbidden
-	}
 
-	updated, err := s.queries.UpdateApplicationStatus(ctx, db.UpdateApplicationStatusParams{
-		ID:     ownership.ID,
-		Status: input.Status,
+	var updated db.Application
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		var err error
+		updated, err = queries.UpdateApplicationStatus(ctx, db.UpdateApplicationStatusParams{
+			ID:     ownership.ID,
+			Status: input.Status,
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.audit.Record(ctx, queries, audit.Event{
+			Action:       ActionApplicationStatusChanged,
+			TargetType:   audit.TargetApplication,
+			TargetID:     input.ApplicationID,
+			RestaurantID: uuid.UUID(ownership.RestaurantID.Bytes),
+			Before:       auditApplication(uuid.UUID(ownership.JobID.Bytes), ownership.Status),
+			After:        auditApplication(uuid.UUID(updated.JobID.Bytes), updated.Status),
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
+	if updated.Status != ownership.Status {
+		s.metrics.ApplicationStatusChanged(string(updated.Status))
+	}
 
 	jobRow, err := s.queries.GetJobByID(ctx, ownership.JobID)
 	if err != nil {
@@ -483,13 +622,42 @@ func (s *Service) UpdateApplicationStatus(ctx context.Context, userID uuid.UUID,
 
 // Helper and mapping utilities below.
 
-func (s *Service) getRestaurantProfileByUser(ctx context.Context, userID uuid.UUID) (*restaurantProfileRow, error) {
-	pgID, err := toPgUUID(userID)
+func (s *Service) ensureEmailVerified(ctx context.Context, userID uuid.UUID) error {
+	pgID := toPgUUID(userID)
+
+	user, err := s.queries.GetUserByID(ctx, pgID)
 	if err != nil {
-		return nil, err
+		return err
+	}
+
+	if !user.EmailVerifiedAt.Valid {
+		return ErrEmailNotVerified
+	}
+
+	return nil
+}
+
+// ensureKYCVerified keeps unverified restaurants to drafts until an admin has
+// approved the KYC submission of the account that created the restaurant.
+func (s *Service) ensureKYCVerified(ctx context.Context, restaurantID pgtype.UUID) error {
+	status, err := s.queries.GetRestaurantKYCStatus(ctx, restaurantID)
+	if err != nil {
+		return err
 	}
 
-	row, err := s.queries.GetRestaurantProfileByUserID(ctx, pgID)
+	if status != kyc.StatusVerified {
+		return ErrRestaurantNotVerified
+	}
+
+	return nil
+}
+
+// getRestaurantForMember resolves the restaurant the user belongs to along
+// with their team role.
+func (s *Service) getRestaurantForMember(ctx context.Context, userID uuid.UUID) (*restaurantProfileRow, error) {
+	pgID := toPgUUID(userID)
+
+	row, err := s.queries.GetRestaurantMembershipByUser(ctx, pgID)
 	if err == pgx.ErrNoRows {
 		return nil, ErrRestaurantProfileMissing
 	}
@@ -498,13 +666,35 @@ func (s *Service) getRestaurantProfileByUser(ctx context.Context, userID uuid.UU
 	}
 
 	return &restaurantProfileRow{
-		id:          row.ID,
+		id:          row.RestaurantID,
 		displayName: row.DisplayName,
 		tagline:     row.Tagline,
 		location:    row.Location,
+		role:        row.Role,
 	}, nil
 }
 
+// authorizeMember checks that the user's team role on the restaurant grants
+// the permission.
+func (s *Service) authorizeMember(ctx context.Context, restaurantID pgtype.UUID, userID uuid.UUID, permission restaurantteam.Permission) error {
+	member, err := s.queries.GetRestaurantMember(ctx, db.GetRestaurantMemberParams{
+		RestaurantID: restaurantID,
+		UserID:       toPgUUID(userID),
+	})
+	if err == pgx.ErrNoRows {
+		return ErrForbidden
+	}
+	if err != nil {
+		return err
+	}
+
+	if !restaurantteam.Allows(member.Role, permission) {
+		return ErrForbidden
+	}
+
+	return nil
+}
+
 func (s *Service) getRestaurantSummaryByID(ctx context.Context, restaurantID pgtype.UUID) (*RestaurantSummary, error) {
 	row, err := s.queries.GetRestaurantProfileByID(ctx, restaurantID)
 	if err != nil {
@@ -520,10 +710,7 @@ func (s *Service) getRestaurantSummaryByID(ctx context.Context, restaurantID pgt
 }
 
 func (s *Service) getChefProfileByUser(ctx context.Context, userID uuid.UUID) (*chefProfileRow, error) {
-	pgID, err := toPgUUID(userID)
-	if err != nil {
-		return nil, err
-	}
+	pgID := toPgUUID(userID)
 
 	row, err := s.queries.GetChefProfileByUserID(ctx, pgID)
 	if err == pgx.ErrNoRows {
@@ -547,7 +734,7 @@ func (s *Service) getChefProfileByUser(ctx context.Context, userID uuid.UUID) (*
 }
 
 func (s *Service) ensureNoExistingApplication(ctx context.Context, jobID uuid.UUID, chefID pgtype.UUID) error {
-	jobPg := toPgUUIDMust(jobID)
+	jobPg := toPgUUID(jobID)
 	_, err := s.queries.GetApplicationByJobAndChef(ctx, db.GetApplicationByJobAndChefParams{
 		JobID:         jobPg,
 		ChefProfileID: chefID,
@@ -563,7 +750,7 @@ func (s *Service) ensureNoExistingApplication(ctx context.Context, jobID uuid.UU
 }
 
 func (s *Service) getJobOwnership(ctx context.Context, jobID uuid.UUID) (*jobOwnership, error) {
-	pgID := toPgUUIDMust(jobID)
+	pgID := toPgUUID(jobID)
 	row, err := s.queries.GetJobOwnership(ctx, pgID)
 	if err == pgx.ErrNoRows {
 		return nil, ErrJobNotFound
@@ -572,18 +759,51 @@ func (s *Service) getJobOwnership(ctx context.Context, jobID uuid.UUID) (*jobOwn
 		return nil, err
 	}
 
-	restaurantUserID, err := uuidFromPg(row.RestaurantUserID)
-	if err != nil {
-		return nil, err
-	}
-
 	return &jobOwnership{
-		jobID:            row.ID,
-		restaurantID:     row.RestaurantID,
-		restaurantUserID: restaurantUserID,
+		jobID:        row.ID,
+		restaurantID: row.RestaurantID,
+		status:       row.Status,
 	}, nil
 }
 
+// --- Audit snapshots ---
+
+// jobSnapshot is the audited state of a job.
+type jobSnapshot struct {
+	Title          string          `json:"title"`
+	Description    string          `json:"description"`
+	RequiredSkills []string        `json:"required_skills"`
+	Location       *string         `json:"location"`
+	SalaryRange    *string         `json:"salary_range"`
+	EmploymentType *string         `json:"employment_type"`
+	Status         db.JobStatus    `json:"status"`
+	Metadata       json.RawMessage `json:"metadata"`
+}
+
+func auditJob(job *Job) jobSnapshot {
+	return jobSnapshot{
+		Title:          job.Title,
+		Description:    job.Description,
+		RequiredSkills: job.RequiredSkills,
+		Location:       job.Location,
+		SalaryRange:    job.SalaryRange,
+		EmploymentType: job.EmploymentType,
+		Status:         job.Status,
+		Metadata:       job.Metadata,
+	}
+}
+
+// applicationSnapshot is the audited state of an application; the cover
+// letter is left out so it is not kept after the chef deletes their account.
+type applicationSnapshot struct {
+	JobID  uuid.UUID            `json:"job_id"`
+	Status db.ApplicationStatus `json:"status"`
+}
+
+func auditApplication(jobID uuid.UUID, status db.ApplicationStatus) applicationSnapshot {
+	return applicationSnapshot{JobID: jobID, Status: status}
+}
+
 // --- Mapping helpers ---
 
 type jobColumns struct {
@@ -822,18 +1042,8 @@ func nullJobStatus(status *db.JobStatus) db.NullJobStatus {
 	return db.NullJobStatus{Valid: true, JobStatus: *status}
 }
 
-func toPgUUID(id uuid.UUID) (pgtype.UUID, error) {
-	var pgID pgtype.UUID
-	if err := pgID.Scan(id[:]); err != nil {
-		return pgtype.UUID{}, err
-	}
-	return pgID, nil
-}
-
-func toPgUUIDMust(id uuid.UUID) pgtype.UUID {
-	var pgID pgtype.UUID
-	_ = pgID.Scan(id[:])
-	return pgID
+func toPgUUID(id uuid.UUID) pgtype.UUID {
+	return pgtype.UUID{Bytes: id, Valid: true}
 }
 
 func uuidFromPg(value pgtype.UUID) (uuid.UUID, error) {
@@ -856,6 +1066,8 @@ type restaurantProfileRow struct {
 	displayName pgtype.Text
 	tagline     pgtype.Text
 	location    pgtype.Text
+	// role is the caller's team role on the restaurant
+	role string
 }
 
 func (r *restaurantProfileRow) toSummary() *RestaurantSummary {
@@ -870,7 +1082,7 @@ type chefProfileRow struct {
 }
 
 type jobOwnership struct {
-	jobID            pgtype.UUID
-	restaurantID     pgtype.UUID
-	restaurantUserID uuid.UUID
+	jobID        pgtype.UUID
+	restaurantID pgtype.UUID
+	status       db.JobStatus
 }
@@ -0,0 +1,712 @@
+package kyc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/auth"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/mail"
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/storage"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// User-level KYC statuses stored in users.kyc_status.
+const (
+	StatusPending   = "pending"
+	StatusSubmitted = "submitted"
+	StatusVerified  = "verified"
+	StatusRejected  = "rejected"
+)
+
+// Submission statuses stored in kyc_submissions.status.
+const (
+	SubmissionSubmitted = "submitted"
+	SubmissionApproved  = "approved"
+	SubmissionRejected  = "rejected"
+)
+
+// Audit actions recorded for reviews.
+const (
+	ActionApproved = "kyc_approved"
+	ActionRejected = "kyc_rejected"
+)
+
+// Document types accepted with a submission.
+const (
+	DocumentBusinessRegistration = "BUSINESS_REGISTRATION"
+	DocumentIdentity             = "IDENTITY_DOCUMENT"
+	DocumentProofOfAddress       = "PROOF_OF_ADDRESS"
+)
+
+const (
+	maxDocuments    = 6
+	maxDocumentSize = 5 << 20
+	maxFieldLength  = 255
+)
+
+// allowedContentTypes are the document formats reviewers can open.
+var allowedContentTypes = map[string]bool{
+	"application/pdf": true,
+	"image/jpeg":      true,
+	"image/png":       true,
+}
+
+var (
+	ErrSubmissionNotFound      = errors.New("kyc submission not found")
+	ErrDocumentNotFound        = errors.New("kyc document not found")
+	ErrSubmissionPending       = errors.New("a kyc submission is already waiting for review")
+	ErrAlreadyVerified         = errors.New("account is already verified")
+	ErrSubmissionAlreadyClosed = errors.New("kyc submission has already been reviewed")
+	ErrRejectionReasonRequired = errors.New("at least one rejection reason is required")
+	ErrInvalidSubmission       = errors.New("invalid kyc submission")
+)
+
+// Service manages KYC submissions and their review.
+type Service struct {
+	pool       db.Beginner
+	queries    *db.Queries
+	blobs      storage.BlobStore
+	secretBox  *auth.SecretBox
+	mailer     mail.Sender
+	appBaseURL string
+	audit      audit.Recorder
+}
+
+// NewService wires the KYC service. Documents are encrypted with secretBox
+// before they are handed to the blob store.
+func NewService(pool db.Beginner, queries *db.Queries, blobs storage.BlobStore, secretBox *auth.SecretBox, mailer mail.Sender, appBaseURL string, auditRecorder audit.Recorder) *Service {
+	return &Service{
+		pool:       pool,
+		queries:    queries,
+		blobs:      blobs,
+		secretBox:  secretBox,
+		mailer:     mailer,
+		appBaseURL: appBaseURL,
+		audit:      auditRecorder,
+	}
+}
+
+// Submission is a set of business details and documents sent for review.
+type Submission struct {
+	ID                 uuid.UUID
+	UserID             uuid.UUID
+	UserEmail          string
+	Status             string
+	BusinessName       string
+	RegistrationNumber string
+	BusinessAddress    string
+	RepresentativeName string
+	RejectionReasons   []string
+	ReviewerNote       string
+	ReviewedAt         *time.Time
+	CreatedAt          time.Time
+	Documents          []*Document
+}
+
+// Document describes an uploaded file without its content.
+type Document struct {
+	ID           uuid.UUID
+	DocumentType string
+	FileName     string
+	ContentType  string
+	SizeBytes    int64
+	SHA256       string
+	CreatedAt    time.Time
+}
+
+// DocumentContent is a decrypted document ready to be shown to a reviewer.
+type DocumentContent struct {
+	Document *Document
+	Data     []byte
+}
+
+// DocumentUpload is a file attached to a submission.
+type DocumentUpload struct {
+	DocumentType string
+	FileName     string
+	ContentType  string
+	Data         []byte
+}
+
+// SubmitInput holds the business registration details and documents.
+type SubmitInput struct {
+	BusinessName       string
+	RegistrationNumber string
+	BusinessAddress    string
+	RepresentativeName string
+	Documents          []DocumentUpload
+}
+
+// StatusOutput reports a user's KYC status and latest submission, if any.
+type StatusOutput struct {
+	Status           string
+	LatestSubmission *Submission
+}
+
+// ListSubmissionsInput filters the review queue.
+type ListSubmissionsInput struct {
+	Status string
+	Limit  int32
+	Offset int32
+}
+
+// SubmissionListOutput wraps paginated submissions.
+type SubmissionListOutput struct {
+	Submissions []*Submission
+	Total       int64
+}
+
+// ReviewInput records a reviewer decision.
+type ReviewInput struct {
+	SubmissionID uuid.UUID
+	ReviewerID   uuid.UUID
+	Reasons      []string
+	Note         string
+}
+
+// ValidationError lists the problems found in a submission.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+// FieldViolation describes one invalid field.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, 0, len(e.Violations))
+	for _, violation := range e.Violations {
+		parts = append(parts, violation.Field+": "+violation.Description)
+	}
+	return "invalid kyc submission: " + strings.Join(parts, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidSubmission
+}
+
+// Submit stores the documents and queues the submission for review.
+func (s *Service) Submit(ctx context.Context, userID uuid.UUID, input SubmitInput) (*Submission, error) {
+	input = normalizeSubmitInput(input)
+	if err := validateSubmitInput(input); err != nil {
+		return nil, err
+	}
+
+	pgUserID := toPgUUID(userID)
+	user, err := s.queries.GetUserByID(ctx, pgUserID)
+	if err != nil {
+		return nil, err
+	}
+	switch user.KycStatus {
+	case StatusVerified:
+		return nil, ErrAlreadyVerified
+	case StatusSubmitted:
+		return nil, ErrSubmissionPending
+	}
+
+	submissionID := uuid.New()
+	if _, err := s.queries.CreateKYCSubmission(ctx, db.CreateKYCSubmissionParams{
+		ID:                 toPgUUID(submissionID),
+		UserID:             pgUserID,
+		BusinessName:       input.BusinessName,
+		RegistrationNumber: input.RegistrationNumber,
+		BusinessAddress:    input.BusinessAddress,
+		RepresentativeName: input.RepresentativeName,
+	}); err != nil {
+		if isUniqueViolation(err) {
+			return nil, ErrSubmissionPending
+		}
+		return nil, err
+	}
+
+	// Without transactions a half-stored submission is removed again so the
+	// user can retry; the open-submission index would otherwise block them
+	storedKeys, err := s.storeDocuments(ctx, userID, submissionID, input.Documents)
+	if err != nil {
+		s.discardSubmission(submissionID, storedKeys)
+		return nil, err
+	}
+
+	if err := s.queries.UpdateUserKYCStatus(ctx, db.UpdateUserKYCStatusParams{
+		ID:        pgUserID,
+		KycStatus: StatusSubmitted,
+	}); err != nil {
+		s.discardSubmission(submissionID, storedKeys)
+		return nil, err
+	}
+
+	submission, err := s.GetSubmission(ctx, submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Notifications are best-effort; the status is always visible in the app
+	_ = s.notify(ctx, user.Email, "We received your ChefNext verification documents",
+		"Thank you for submitting your business verification documents.\n\n"+
+			"Our team will review them shortly and let you know the result by email.\n")
+
+	return submission, nil
+}
+
+// GetStatus returns the user's KYC status with their latest submission.
+func (s *Service) GetStatus(ctx context.Context, userID uuid.UUID) (*StatusOutput, error) {
+	pgUserID := toPgUUID(userID)
+	user, err := s.queries.GetUserByID(ctx, pgUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &StatusOutput{Status: user.KycStatus}
+
+	row, err := s.queries.GetLatestKYCSubmissionByUser(ctx, pgUserID)
+	if err == pgx.ErrNoRows {
+		return out, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := s.withDocuments(ctx, row.KycSubmission, row.UserEmail)
+	if err != nil {
+		return nil, err
+	}
+	out.LatestSubmission = submission
+	return out, nil
+}
+
+// GetSubmission returns a submission with its documents.
+func (s *Service) GetSubmission(ctx context.Context, submissionID uuid.UUID) (*Submission, error) {
+	row, err := s.queries.GetKYCSubmissionByID(ctx, toPgUUID(submissionID))
+	if err == pgx.ErrNoRows {
+		return nil, ErrSubmissionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.withDocuments(ctx, row.KycSubmission, row.UserEmail)
+}
+
+// ListSubmissions returns submissions oldest first so the review queue is
+// worked in order.
+func (s *Service) ListSubmissions(ctx context.Context, input ListSubmissionsInput) (*SubmissionListOutput, error) {
+	params := db.ListKYCSubmissionsParams{
+		Limit:  clampLimit(input.Limit),
+		Offset: input.Offset,
+	}
+	if input.Status != "" {
+		params.Status = pgtype.Text{String: input.Status, Valid: true}
+	}
+
+	rows, err := s.queries.ListKYCSubmissions(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	submissions := make([]*Submission, 0, len(rows))
+	var total int64
+	for _, row := range rows {
+		submission, err := mapSubmission(row.KycSubmission, row.UserEmail)
+		if err != nil {
+			return nil, err
+		}
+		total = row.TotalCount
+		submissions = append(submissions, submission)
+	}
+
+	return &SubmissionListOutput{Submissions: submissions, Total: total}, nil
+}
+
+// GetDocument loads and decrypts a document for a reviewer.
+func (s *Service) GetDocument(ctx context.Context, documentID uuid.UUID) (*DocumentContent, error) {
+	row, err := s.queries.GetKYCDocumentByID(ctx, toPgUUID(documentID))
+	if err == pgx.ErrNoRows {
+		return nil, ErrDocumentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	object, err := s.blobs.Get(ctx, row.StorageKey)
+	if errors.Is(err, storage.ErrObjectNotFound) {
+		return nil, ErrDocumentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.secretBox.Open(object.Data, []byte(row.StorageKey))
+	if err != nil {
+		return nil, fmt.Errorf("decrypt kyc document: %w", err)
+	}
+
+	document, err := mapDocument(row)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DocumentContent{Document: document, Data: data}, nil
+}
+
+// Approve marks the submission approved and the user verified.
+func (s *Service) Approve(ctx context.Context, input ReviewInput) (*Submission, error) {
+	submission, err := s.review(ctx, input, SubmissionApproved, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = s.notify(ctx, submission.UserEmail, "Your ChefNext business is verified",
+		fmt.Sprintf("Good news! Your business verification has been approved.\n\n"+
+			"You can now publish job postings on ChefNext:\n\n%s/restaurant/jobs\n", s.appBaseURL))
+
+	return submission, nil
+}
+
+// Reject marks the submission rejected with the reasons shown to the user,
+// who may submit again.
+func (s *Service) Reject(ctx context.Context, input ReviewInput) (*Submission, error) {
+	reasons := make([]string, 0, len(input.Reasons))
+	for _, reason := range input.Reasons {
+		if reason = strings.TrimSpace(reason); reason != "" {
+			reasons = append(reasons, reason)
+		}
+	}
+	if len(reasons) == 0 {
+		return nil, ErrRejectionReasonRequired
+	}
+
+	submission, err := s.review(ctx, input, SubmissionRejected, reasons)
+	if err != nil {
+		return nil, err
+	}
+
+	var body strings.Builder
+	body.WriteString("Unfortunately we could not verify your business with the documents you sent.\n\nReasons:\n")
+	for _, reason := range reasons {
+		fmt.Fprintf(&body, "- %s\n", reason)
+	}
+	fmt.Fprintf(&body, "\nYou can correct the details and submit again here:\n\n%s/restaurant/verification\n", s.appBaseURL)
+	_ = s.notify(ctx, submission.UserEmail, "Your ChefNext verification needs attention", body.String())
+
+	return submission, nil
+}
+
+// IsVerified reports whether the user has passed KYC.
+func (s *Service) IsVerified(ctx context.Context, userID uuid.UUID) (bool, error) {
+	user, err := s.queries.GetUserByID(ctx, toPgUUID(userID))
+	if err != nil {
+		return false, err
+	}
+	return user.KycStatus == StatusVerified, nil
+}
+
+func (s *Service) review(ctx context.Context, input ReviewInput, decision string, reasons []string) (*Submission, error) {
+	current, err := s.GetSubmission(ctx, input.SubmissionID)
+	if err != nil {
+		return nil, err
+	}
+	if current.Status != SubmissionSubmitted {
+		return nil, ErrSubmissionAlreadyClosed
+	}
+
+	if reasons == nil {
+		reasons = []string{}
+	}
+	note := strings.TrimSpace(input.Note)
+	userStatus, action := StatusRejected, ActionRejected
+	if decision == SubmissionApproved {
+		userStatus, action = StatusVerified, ActionApproved
+	}
+
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		if _, err := queries.ReviewKYCSubmission(ctx, db.ReviewKYCSubmissionParams{
+			ID:               toPgUUID(input.SubmissionID),
+			Status:           decision,
+			RejectionReasons: reasons,
+			ReviewerNote:     pgtype.Text{String: note, Valid: note != ""},
+			ReviewedBy:       toPgUUID(input.ReviewerID),
+		}); err != nil {
+			// Another reviewer closed it first
+			if err == pgx.ErrNoRows {
+				return ErrSubmissionAlreadyClosed
+			}
+			return err
+		}
+
+		if err := queries.UpdateUserKYCStatus(ctx, db.UpdateUserKYCStatusParams{
+			ID:        toPgUUID(current.UserID),
+			KycStatus: userStatus,
+		}); err != nil {
+			return err
+		}
+
+		return s.audit.Record(ctx, queries, audit.Event{
+			Action:     action,
+			TargetType: audit.TargetKYCSubmission,
+			TargetID:   input.SubmissionID,
+			Before:     auditReview{Status: current.Status},
+			After:      auditReview{Status: decision, RejectionReasons: reasons, ReviewerNote: note},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetSubmission(ctx, input.SubmissionID)
+}
+
+// auditReview is the audited state of a submission under review; empty fields
+// are omitted.
+type auditReview struct {
+	Status           string   `json:"status"`
+	RejectionReasons []string `json:"rejection_reasons,omitempty"`
+	ReviewerNote     string   `json:"reviewer_note,omitempty"`
+}
+
+// storeDocuments encrypts and uploads every document, returning the keys
+// written so far even on failure
+func (s *Service) storeDocuments(ctx context.Context, userID, submissionID uuid.UUID, uploads []DocumentUpload) ([]string, error) {
+	keys := make([]string, 0, len(uploads))
+	for _, upload := range uploads {
+		documentID := uuid.New()
+		key := fmt.Sprintf("kyc/%s/%s/%s", userID, submissionID, documentID)
+
+		sealed, err := s.secretBox.Seal(upload.Data, []byte(key))
+		if err != nil {
+			return keys, err
+		}
+		if err := s.blobs.Put(ctx, storage.Object{
+			Key:         key,
+			ContentType: "application/octet-stream",
+			Data:        sealed,
+		}); err != nil {
+			return keys, err
+		}
+		keys = append(keys, key)
+
+		sum := sha256.Sum256(upload.Data)
+		if _, err := s.queries.CreateKYCDocument(ctx, db.CreateKYCDocumentParams{
+			ID:           toPgUUID(documentID),
+			SubmissionID: toPgUUID(submissionID),
+			DocumentType: upload.DocumentType,
+			FileName:     upload.FileName,
+			ContentType:  upload.ContentType,
+			SizeBytes:    int64(len(upload.Data)),
+			Sha256:       hex.EncodeToString(sum[:]),
+			StorageKey:   key,
+		}); err != nil {
+			return keys, err
+		}
+	}
+	return keys, nil
+}
+
+// discardSubmission removes a submission that could not be stored completely.
+// It runs on a fresh context because the request context may be the reason
+// the submission failed.
+func (s *Service) discardSubmission(submissionID uuid.UUID, keys []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, key := range keys {
+		_ = s.blobs.Delete(ctx, key)
+	}
+	_ = s.queries.DeleteKYCSubmission(ctx, toPgUUID(submissionID))
+}
+
+func (s *Service) withDocuments(ctx context.Context, row db.KycSubmission, email string) (*Submission, error) {
+	submission, err := mapSubmission(row, email)
+	if err != nil {
+		return nil, err
+	}
+
+	documents, err := s.queries.ListKYCDocumentsBySubmission(ctx, row.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	submission.Documents = make([]*Document, 0, len(documents))
+	for _, row := range documents {
+		document, err := mapDocument(row)
+		if err != nil {
+			return nil, err
+		}
+		submission.Documents = append(submission.Documents, document)
+	}
+	return submission, nil
+}
+
+func (s *Service) notify(ctx context.Context, email, subject, body string) error {
+	return s.mailer.Send(ctx, mail.Message{
+		To:      []string{email},
+		Subject: subject,
+		Body:    body,
+	})
+}
+
+func normalizeSubmitInput(input SubmitInput) SubmitInput {
+	input.BusinessName = strings.TrimSpace(input.BusinessName)
+	input.RegistrationNumber = strings.TrimSpace(input.RegistrationNumber)
+	input.BusinessAddress = strings.TrimSpace(input.BusinessAddress)
+	input.RepresentativeName = strings.TrimSpace(input.RepresentativeName)
+	for i := range input.Documents {
+		doc := &input.Documents[i]
+		doc.DocumentType = strings.ToUpper(strings.TrimSpace(doc.DocumentType))
+		doc.FileName = path.Base(strings.ReplaceAll(strings.TrimSpace(doc.FileName), "\\", "/"))
+		doc.ContentType = strings.ToLower(strings.TrimSpace(doc.ContentType))
+	}
+	return input
+}
+
+func validateSubmitInput(input SubmitInput) error {
+	var violations []FieldViolation
+	requireField := func(field, value string) {
+		switch {
+		case value == "":
+			violations = append(violations, FieldViolation{Field: field, Description: "is required"})
+		case utf8.RuneCountInString(value) > maxFieldLength:
+			violations = append(violations, FieldViolation{Field: field, Description: fmt.Sprintf("must be at most %d characters", maxFieldLength)})
+		}
+	}
+	requireField("business_name", input.BusinessName)
+	requireField("registration_number", input.RegistrationNumber)
+	requireField("business_address", input.BusinessAddress)
+	requireField("representative_name", input.RepresentativeName)
+
+	if len(input.Documents) > maxDocuments {
+		violations = append(violations, FieldViolation{Field: "documents", Description: fmt.Sprintf("at most %d documents can be attached", maxDocuments)})
+	}
+
+	provided := make(map[string]bool)
+	for i, doc := range input.Documents {
+		field := fmt.Sprintf("documents[%d]", i)
+		switch doc.DocumentType {
+		case DocumentBusinessRegistration, DocumentIdentity, DocumentProofOfAddress:
+			provided[doc.DocumentType] = true
+		default:
+			violations = append(violations, FieldViolation{Field: field + ".document_type", Description: "is not a supported document type"})
+		}
+		if doc.FileName == "" || doc.FileName == "." || doc.FileName == "/" || utf8.RuneCountInString(doc.FileName) > maxFieldLength {
+			violations = append(violations, FieldViolation{Field: field + ".file_name", Description: "is required"})
+		}
+		switch {
+		case len(doc.Data) == 0:
+			violations = append(violations, FieldViolation{Field: field + ".content", Description: "is empty"})
+		case len(doc.Data) > maxDocumentSize:
+			violations = append(violations, FieldViolation{Field: field + ".content", Description: fmt.Sprintf("must be at most %d MB", maxDocumentSize>>20)})
+		case !allowedContentTypes[doc.ContentType]:
+			violations = append(violations, FieldViolation{Field: field + ".content_type", Description: "must be application/pdf, image/jpeg or image/png"})
+		case sniffContentType(doc.Data) != doc.ContentType:
+			// Reviewers open these files, so the declared type must match
+			violations = append(violations, FieldViolation{Field: field + ".content", Description: "does not match content_type"})
+		}
+	}
+
+	for _, required := range []string{DocumentBusinessRegistration, DocumentIdentity} {
+		if !provided[required] {
+			violations = append(violations, FieldViolation{Field: "documents", Description: "a " + required + " document is required"})
+		}
+	}
+
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+	return nil
+}
+
+func sniffContentType(data []byte) string {
+	contentType, _, _ := strings.Cut(http.DetectContentType(data), ";")
+	return contentType
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+// --- Mapping helpers ---
+
+func mapSubmission(row db.KycSubmission, email string) (*Submission, error) {
+	id, err := uuidFromPg(row.ID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := uuidFromPg(row.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	submission := &Submission{
+		ID:                 id,
+		UserID:             userID,
+		UserEmail:          email,
+		Status:             row.Status,
+		BusinessName:       row.BusinessName,
+		RegistrationNumber: row.RegistrationNumber,
+		BusinessAddress:    row.BusinessAddress,
+		RepresentativeName: row.RepresentativeName,
+		RejectionReasons:   row.RejectionReasons,
+		ReviewerNote:       row.ReviewerNote.String,
+		CreatedAt:          row.CreatedAt.Time,
+	}
+	if row.ReviewedAt.Valid {
+		reviewedAt := row.ReviewedAt.Time
+		submission.ReviewedAt = &reviewedAt
+	}
+	return submission, nil
+}
+
+func mapDocument(row db.KycDocument) (*Document, error) {
+	id, err := uuidFromPg(row.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Document{
+		ID:           id,
+		DocumentType: row.DocumentType,
+		FileName:     row.FileName,
+		ContentType:  row.ContentType,
+		SizeBytes:    row.SizeBytes,
+		SHA256:       row.Sha256,
+		CreatedAt:    row.CreatedAt.Time,
+	}, nil
+}
+
+func clampLimit(limit int32) int32 {
+	if limit <= 0 {
+		return 20
+	}
+	if limit > 100 {
+		return 100
+	}
+	return limit
+}
+
+func toPgUUID(id uuid.UUID) pgtype.UUID {
+	return pgtype.UUID{Bytes: id, Valid: true}
+}
+
+func uuidFromPg(value pgtype.UUID) (uuid.UUID, error) {
+	if !value.Valid {
+		return uuid.UUID{}, errors.New("invalid uuid value")
+	}
+	return uuid.FromBytes(value.Bytes[:])
+}
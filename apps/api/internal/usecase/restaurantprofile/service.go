@@ -2,31 +2,43 @@ package restaurantprofile
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"strings"
 	"time"
 
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/audit"
 	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/chefnext/chefnext/apps/api/internal/usecase/restaurantteam"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
 var (
 	ErrProfileAlreadyExists      = errors.New("restaurant profile already exists")
 	ErrProfileNotFound           = errors.New("restaurant profile not found")
-	ErrInvalidName               = errors.New("restaurant name is required")
 	ErrUnauthorizedProfileAccess = errors.New("cannot modify another user's restaurant profile")
+	ErrAlreadyTeamMember         = errors.New("account already belongs to another restaurant team")
+)
+
+// Audit actions recorded for restaurant profiles.
+const (
+	ActionProfileCreated = "restaurant_profile_created"
+	ActionProfileUpdated = "restaurant_profile_updated"
 )
 
 // Service coordinates restaurant profile operations.
 type Service struct {
+	pool    db.Beginner
 	queries *db.Queries
+	audit   audit.Recorder
 }
 
 // NewService constructs a Service instance.
-func NewService(queries *db.Queries) *Service {
-	return &Service{queries: queries}
+func NewService(pool db.Beginner, queries *db.Queries, auditRecorder audit.Recorder) *Service {
+	return &Service{pool: pool, queries: queries, audit: auditRecorder}
 }
 
 // Profile represents a restaurant profile in domain form.
@@ -95,17 +107,12 @@ type SearchOutput struct {
 	Total    int32
 }
 
-// CreateProfile inserts a new restaurant profile for the current user.
+// CreateProfile inserts a new restaurant profile with the current user as its
+// first owner.
 func (s *Service) CreateProfile(ctx context.Context, input CreateInput) (*Profile, error) {
 	displayName := strings.TrimSpace(input.DisplayName)
-	if displayName == "" {
-		return nil, ErrInvalidName
-	}
 
-	var userID pgtype.UUID
-	if err := userID.Scan(input.UserID[:]); err != nil {
-		return nil, err
-	}
+	userID := pgtype.UUID{Bytes: input.UserID, Valid: true}
 
 	if _, err := s.queries.GetRestaurantProfileByUserID(ctx, userID); err == nil {
 		return nil, ErrProfileAlreadyExists
@@ -113,33 +120,74 @@ func (s *Service) CreateProfile(ctx context.Context, input CreateInput) (*Profil
 		return nil, err
 	}
 
-	profile, err := s.queries.CreateRestaurantProfile(ctx, db.CreateRestaurantProfileParams{
-		UserID:             userID,
-		DisplayName:        pgtype.Text{String: displayName, Valid: displayName != ""},
-		Tagline:            pgtype.Text{String: input.Tagline, Valid: input.Tagline != ""},
-		Location:           pgtype.Text{String: input.Location, Valid: input.Location != ""},
-		Seats:              pgtype.Int4{Int32: input.Seats, Valid: true},
-		CuisineTypes:       input.CuisineTypes,
-		MentorshipStyle:    pgtype.Text{String: input.MentorshipStyle, Valid: input.MentorshipStyle != ""},
-		Description:        pgtype.Text{String: input.Description, Valid: input.Description != ""},
-		CultureKeywords:    input.CultureKeywords,
-		Benefits:           input.Benefits,
-		SupportPrograms:    input.SupportPrograms,
-		LearningHighlights: input.LearningHighlights,
+	if _, err := s.queries.GetRestaurantMembershipByUser(ctx, userID); err == nil {
+		return nil, ErrAlreadyTeamMember
+	} else if err != pgx.ErrNoRows {
+		return nil, err
+	}
+
+	// A profile without its owner membership would be unreachable, so both are
+	// created together.
+	var created *Profile
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		profile, err := queries.CreateRestaurantProfile(ctx, db.CreateRestaurantProfileParams{
+			UserID:             userID,
+			DisplayName:        pgtype.Text{String: displayName, Valid: displayName != ""},
+			Tagline:            pgtype.Text{String: input.Tagline, Valid: input.Tagline != ""},
+			Location:           pgtype.Text{String: input.Location, Valid: input.Location != ""},
+			Seats:              pgtype.Int4{Int32: input.Seats, Valid: true},
+			CuisineTypes:       input.CuisineTypes,
+			MentorshipStyle:    pgtype.Text{String: input.MentorshipStyle, Valid: input.MentorshipStyle != ""},
+			Description:        pgtype.Text{String: input.Description, Valid: input.Description != ""},
+			CultureKeywords:    input.CultureKeywords,
+			Benefits:           input.Benefits,
+			SupportPrograms:    input.SupportPrograms,
+			LearningHighlights: input.LearningHighlights,
+		})
+		if isUniqueViolation(err) {
+			return ErrProfileAlreadyExists
+		}
+		if err != nil {
+			return err
+		}
+
+		_, err = queries.CreateRestaurantMember(ctx, db.CreateRestaurantMemberParams{
+			RestaurantID: profile.ID,
+			UserID:       userID,
+			Role:         restaurantteam.RoleOwner,
+		})
+		if isUniqueViolation(err) {
+			return ErrAlreadyTeamMember
+		}
+		if err != nil {
+			return err
+		}
+
+		created, err = mapProfileFromCreate(profile)
+		if err != nil {
+			return err
+		}
+
+		return s.audit.Record(ctx, queries, audit.Event{
+			Action:       ActionProfileCreated,
+			TargetType:   audit.TargetRestaurantProfile,
+			TargetID:     created.ID,
+			RestaurantID: created.ID,
+			After:        auditProfile(created),
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return mapProfileFromCreate(profile)
+	return created, nil
 }
 
 // GetProfile fetches a profile by id.
 func (s *Service) GetProfile(ctx context.Context, profileID uuid.UUID) (*Profile, error) {
-	var pgID pgtype.UUID
-	if err := pgID.Scan(profileID[:]); err != nil {
-		return nil, err
-	}
+	pgID := pgtype.UUID{Bytes: profileID, Valid: true}
 
 	profile, err := s.queries.GetRestaurantProfileByID(ctx, pgID)
 	if err == pgx.ErrNoRows {
@@ -152,14 +200,20 @@ func (s *Service) GetProfile(ctx context.Context, profileID uuid.UUID) (*Profile
 	return mapProfileFromGet(profile)
 }
 
-// GetProfileByUser fetches the authenticated user's profile.
+// GetProfileByUser fetches the profile of the restaurant the authenticated
+// user belongs to.
 func (s *Service) GetProfileByUser(ctx context.Context, userID uuid.UUID) (*Profile, error) {
-	var pgID pgtype.UUID
-	if err := pgID.Scan(userID[:]); err != nil {
+	pgID := pgtype.UUID{Bytes: userID, Valid: true}
+
+	membership, err := s.queries.GetRestaurantMembershipByUser(ctx, pgID)
+	if err == pgx.ErrNoRows {
+		return nil, ErrProfileNotFound
+	}
+	if err != nil {
 		return nil, err
 	}
 
-	profile, err := s.queries.GetRestaurantProfileByUserID(ctx, pgID)
+	profile, err := s.queries.GetRestaurantProfileByID(ctx, membership.RestaurantID)
 	if err == pgx.ErrNoRows {
 		return nil, ErrProfileNotFound
 	}
@@ -167,15 +221,12 @@ func (s *Service) GetProfileByUser(ctx context.Context, userID uuid.UUID) (*Prof
 		return nil, err
 	}
 
-	return mapProfileFromGetByUser(profile)
+	return mapProfileFromGet(profile)
 }
 
 // UpdateProfile modifies an existing restaurant profile.
 func (s *Service) UpdateProfile(ctx context.Context, input UpdateInput) (*Profile, error) {
-	var pgID pgtype.UUID
-	if err := pgID.Scan(input.ProfileID[:]); err != nil {
-		return nil, err
-	}
+	pgID := pgtype.UUID{Bytes: input.ProfileID, Valid: true}
 
 	existing, err := s.queries.GetRestaurantProfileByID(ctx, pgID)
 	if err == pgx.ErrNoRows {
@@ -185,11 +236,19 @@ func (s *Service) UpdateProfile(ctx context.Context, input UpdateInput) (*Profil
 		return nil, err
 	}
 
-	existingUserID, err := uuid.FromBytes(existing.UserID.Bytes[:])
+	pgUserID := pgtype.UUID{Bytes: input.UserID, Valid: true}
+
+	member, err := s.queries.GetRestaurantMember(ctx, db.GetRestaurantMemberParams{
+		RestaurantID: pgID,
+		UserID:       pgUserID,
+	})
+	if err == pgx.ErrNoRows {
+		return nil, ErrUnauthorizedProfileAccess
+	}
 	if err != nil {
 		return nil, err
 	}
-	if existingUserID != input.UserID {
+	if !restaurantteam.Allows(member.Role, restaurantteam.PermissionManageProfile) {
 		return nil, ErrUnauthorizedProfileAccess
 	}
 
@@ -209,11 +268,7 @@ func (s *Service) UpdateProfile(ctx context.Context, input UpdateInput) (*Profil
 	}
 
 	if input.DisplayName != nil {
-		trimmed := strings.TrimSpace(*input.DisplayName)
-		if trimmed == "" {
-			return nil, ErrInvalidName
-		}
-		params.DisplayName = pgtype.Text{String: trimmed, Valid: true}
+		params.DisplayName = pgtype.Text{String: strings.TrimSpace(*input.DisplayName), Valid: true}
 	}
 
 	if input.Tagline != nil {
@@ -256,12 +311,38 @@ func (s *Service) UpdateProfile(ctx context.Context, input UpdateInput) (*Profil
 		params.LearningHighlights = *input.LearningHighlights
 	}
 
-	updated, err := s.queries.UpdateRestaurantProfile(ctx, params)
+	before, err := mapProfileFromGet(existing)
+	if err != nil {
+		return nil, err
+	}
+
+	var after *Profile
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		updated, err := queries.UpdateRestaurantProfile(ctx, params)
+		if err != nil {
+			return err
+		}
+		after, err = mapProfileFromUpdate(updated)
+		if err != nil {
+			return err
+		}
+
+		return s.audit.Record(ctx, queries, audit.Event{
+			Action:       ActionProfileUpdated,
+			TargetType:   audit.TargetRestaurantProfile,
+			TargetID:     after.ID,
+			RestaurantID: after.ID,
+			Before:       auditProfile(before),
+			After:        auditProfile(after),
+		})
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return mapProfileFromUpdate(updated)
+	return after, nil
 }
 
 // SearchProfiles lists restaurant profiles matching the filters.
@@ -314,15 +395,6 @@ func mapProfileFromGet(row db.GetRestaurantProfileByIDRow) (*Profile, error) {
 	)
 }
 
-func mapProfileFromGetByUser(row db.GetRestaurantProfileByUserIDRow) (*Profile, error) {
-	return mapProfileCommon(
-		row.ID, row.UserID, row.DisplayName, row.Tagline, row.Location,
-		row.Seats, row.CuisineTypes, row.MentorshipStyle, row.Description,
-		row.CultureKeywords, row.Benefits, row.SupportPrograms,
-		row.LearningHighlights, row.CreatedAt, row.UpdatedAt,
-	)
-}
-
 func mapProfileFromUpdate(row db.UpdateRestaurantProfileRow) (*Profile, error) {
 	return mapProfileCommon(
 		row.ID, row.UserID, row.DisplayName, row.Tagline, row.Location,
@@ -407,6 +479,40 @@ func mapProfileCommon(
 	}, nil
 }
 
+// profileSnapshot is the audited state of a restaurant profile.
+type profileSnapshot struct {
+	DisplayName        *string         `json:"display_name"`
+	Tagline            *string         `json:"tagline"`
+	Location           *string         `json:"location"`
+	Seats              *int32          `json:"seats"`
+	CuisineTypes       []string        `json:"cuisine_types"`
+	MentorshipStyle    *string         `json:"mentorship_style"`
+	Description        *string         `json:"description"`
+	CultureKeywords    []string        `json:"culture_keywords"`
+	Benefits           []string        `json:"benefits"`
+	SupportPrograms    []string        `json:"support_programs"`
+	LearningHighlights json.RawMessage `json:"learning_highlights"`
+}
+
+func auditProfile(profile *Profile) profileSnapshot {
+	snapshot := profileSnapshot{
+		DisplayName:     profile.DisplayName,
+		Tagline:         profile.Tagline,
+		Location:        profile.Location,
+		Seats:           profile.Seats,
+		CuisineTypes:    profile.CuisineTypes,
+		MentorshipStyle: profile.MentorshipStyle,
+		Description:     profile.Description,
+		CultureKeywords: profile.CultureKeywords,
+		Benefits:        profile.Benefits,
+		SupportPrograms: profile.SupportPrograms,
+	}
+	if json.Valid(profile.LearningHighlights) {
+		snapshot.LearningHighlights = profile.LearningHighlights
+	}
+	return snapshot
+}
+
 func clampLimit(limit int32) int32 {
 	if limit <= 0 {
 		return 20
@@ -418,3 +524,10 @@ func clampLimit(limit int32) int32 {
 
 	return limit
 }
+
+// isUniqueViolation reports whether err is a unique constraint violation, as
+// raised when a concurrent request created the same row first.
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -0,0 +1,498 @@
+package restaurantteam
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	netmail "net/mail"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/chefnext/chefnext/apps/api/internal/pkg/mail"
+	"github.com/chefnext/chefnext/apps/api/internal/repository/db"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Team roles stored in restaurant_members.role.
+const (
+	RoleOwner     = "OWNER"
+	RoleManager   = "MANAGER"
+	RoleRecruiter = "RECRUITER"
+	RoleViewer    = "VIEWER"
+)
+
+// Permission names an action a team role may be allowed to take.
+type Permission string
+
+const (
+	PermissionManageProfile      Permission = "manage_profile"
+	PermissionManageJobs         Permission = "manage_jobs"
+	PermissionViewApplications   Permission = "view_applications"
+	PermissionReviewApplications Permission = "review_applications"
+	PermissionManageMembers      Permission = "manage_members"
+	PermissionManageAPIKeys      Permission = "manage_api_keys"
+	PermissionViewAuditLog       Permission = "view_audit_log"
+)
+
+var rolePermissions = map[string][]Permission{
+	RoleOwner: {
+		PermissionManageProfile,
+		PermissionManageJobs,
+		PermissionViewApplications,
+		PermissionReviewApplications,
+		PermissionManageMembers,
+		PermissionManageAPIKeys,
+		PermissionViewAuditLog,
+	},
+	RoleManager: {
+		PermissionManageProfile,
+		PermissionManageJobs,
+		PermissionViewApplications,
+		PermissionReviewApplications,
+		PermissionManageAPIKeys,
+	},
+	RoleRecruiter: {
+		PermissionViewApplications,
+		PermissionReviewApplications,
+	},
+	RoleViewer: {
+		PermissionViewApplications,
+	},
+}
+
+// invitationTTL is how long an emailed invitation can be accepted.
+const invitationTTL = 7 * 24 * time.Hour
+
+const restaurantAccountRole = "RESTAURANT"
+
+var (
+	ErrNotMember              = errors.New("not a member of a restaurant team")
+	ErrForbidden              = errors.New("team role does not allow this action")
+	ErrInvalidRole            = errors.New("invalid team role")
+	ErrInvalidEmail           = errors.New("a valid email address is required")
+	ErrMemberNotFound         = errors.New("team member not found")
+	ErrAlreadyMember          = errors.New("account already belongs to a restaurant team")
+	ErrLastOwner              = errors.New("a restaurant must keep at least one owner")
+	ErrInvitationNotFound     = errors.New("invitation not found")
+	ErrInvitationInvalid      = errors.New("invitation is invalid or has expired")
+	ErrInvitationEmailInvalid = errors.New("invitation was sent to a different email address")
+	ErrNotRestaurantAccount   = errors.New("only restaurant accounts can join a restaurant team")
+)
+
+// Allows reports whether a team role grants the permission.
+func Allows(role string, permission Permission) bool {
+	for _, granted := range rolePermissions[role] {
+		if granted == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidRole reports whether role is one of the team roles.
+func ValidRole(role string) bool {
+	_, ok := rolePermissions[role]
+	return ok
+}
+
+// Service manages restaurant team members and invitations.
+type Service struct {
+	pool       db.Beginner
+	queries    *db.Queries
+	mailer     mail.Sender
+	appBaseURL string
+}
+
+// NewService wires the restaurant team service.
+func NewService(pool db.Beginner, queries *db.Queries, mailer mail.Sender, appBaseURL string) *Service {
+	return &Service{
+		pool:       pool,
+		queries:    queries,
+		mailer:     mailer,
+		appBaseURL: appBaseURL,
+	}
+}
+
+// Member is a login with access to a restaurant.
+type Member struct {
+	ID           uuid.UUID
+	RestaurantID uuid.UUID
+	UserID       uuid.UUID
+	Email        string
+	Role         string
+	CreatedAt    time.Time
+}
+
+// Invitation is an emailed offer to join a restaurant team.
+type Invitation struct {
+	ID        uuid.UUID
+	Email     string
+	Role      string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// InviteInput describes who to invite and with which role.
+type InviteInput struct {
+	Email string
+	Role  string
+}
+
+// ListMembers returns the caller's restaurant team.
+func (s *Service) ListMembers(ctx context.Context, userID uuid.UUID) ([]*Member, error) {
+	membership, err := s.membership(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListRestaurantMembers(ctx, membership.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*Member, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, mapMember(row.RestaurantMember, row.UserEmail))
+	}
+	return members, nil
+}
+
+// InviteMember emails an invitation to join the caller's restaurant. Inviting
+// the same address again replaces its open invitation.
+func (s *Service) InviteMember(ctx context.Context, userID uuid.UUID, input InviteInput) (*Invitation, error) {
+	membership, err := s.authorize(ctx, userID, PermissionManageMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	email := strings.ToLower(strings.TrimSpace(input.Email))
+	if address, err := netmail.ParseAddress(email); err != nil || address.Address != email {
+		return nil, ErrInvalidEmail
+	}
+	if !ValidRole(input.Role) {
+		return nil, ErrInvalidRole
+	}
+
+	members, err := s.queries.ListRestaurantMembers(ctx, membership.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range members {
+		if strings.EqualFold(member.UserEmail, email) {
+			return nil, ErrAlreadyMember
+		}
+	}
+
+	token, tokenHash, err := newInvitationToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.queries.RevokeOpenRestaurantInvitation(ctx, db.RevokeOpenRestaurantInvitationParams{
+		RestaurantID: membership.RestaurantID,
+		Email:        email,
+	}); err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Now().Add(invitationTTL)
+	row, err := s.queries.CreateRestaurantInvitation(ctx, db.CreateRestaurantInvitationParams{
+		RestaurantID: membership.RestaurantID,
+		Email:        email,
+		Role:         input.Role,
+		TokenHash:    tokenHash,
+		InvitedBy:    pgtype.UUID{Bytes: userID, Valid: true},
+		ExpiresAt:    pgtype.Timestamptz{Time: expiresAt, Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	restaurantName := "a restaurant"
+	if membership.DisplayName.Valid && membership.DisplayName.String != "" {
+		restaurantName = membership.DisplayName.String
+	}
+	link := fmt.Sprintf("%s/restaurant/invitations/accept?token=%s", s.appBaseURL, url.QueryEscape(token))
+	if err := s.mailer.Send(ctx, mail.Message{
+		To:      []string{email},
+		Subject: fmt.Sprintf("You've been invited to join %s on ChefNext", restaurantName),
+		Body: fmt.Sprintf(
+			"You have been invited to join %s on ChefNext as %s.\n\nSign in or create a restaurant account with this email address, then open the link below to accept:\n\n%s\n\nThis invitation expires in 7 days.\n",
+			restaurantName,
+			strings.ToLower(input.Role),
+			link,
+		),
+	}); err != nil {
+		return nil, err
+	}
+
+	return mapInvitation(row), nil
+}
+
+// ListInvitations returns the caller's restaurant's open invitations.
+func (s *Service) ListInvitations(ctx context.Context, userID uuid.UUID) ([]*Invitation, error) {
+	membership, err := s.authorize(ctx, userID, PermissionManageMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.ListOpenRestaurantInvitations(ctx, membership.RestaurantID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations := make([]*Invitation, 0, len(rows))
+	for _, row := range rows {
+		invitations = append(invitations, mapInvitation(row))
+	}
+	return invitations, nil
+}
+
+// RevokeInvitation withdraws an invitation that has not been accepted yet.
+func (s *Service) RevokeInvitation(ctx context.Context, userID, invitationID uuid.UUID) error {
+	membership, err := s.authorize(ctx, userID, PermissionManageMembers)
+	if err != nil {
+		return err
+	}
+
+	rows, err := s.queries.RevokeRestaurantInvitation(ctx, db.RevokeRestaurantInvitationParams{
+		ID:           pgtype.UUID{Bytes: invitationID, Valid: true},
+		RestaurantID: membership.RestaurantID,
+	})
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return ErrInvitationNotFound
+	}
+	return nil
+}
+
+// AcceptInvitation adds the caller to the inviting restaurant. The caller must
+// be signed in to a restaurant account with the invited email address.
+func (s *Service) AcceptInvitation(ctx context.Context, userID uuid.UUID, token string) (*Member, error) {
+	invitation, err := s.queries.GetRestaurantInvitationByTokenHash(ctx, hashInvitationToken(token))
+	if err == pgx.ErrNoRows {
+		return nil, ErrInvitationInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	invite := invitation.RestaurantInvitation
+	if invite.AcceptedAt.Valid || invite.RevokedAt.Valid || !invite.ExpiresAt.Time.After(time.Now()) {
+		return nil, ErrInvitationInvalid
+	}
+
+	pgUserID := pgtype.UUID{Bytes: userID, Valid: true}
+	user, err := s.queries.GetUserByID(ctx, pgUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(user.Email, invite.Email) {
+		return nil, ErrInvitationEmailInvalid
+	}
+	if !slices.Contains(user.Roles, restaurantAccountRole) {
+		return nil, ErrNotRestaurantAccount
+	}
+
+	if _, err := s.queries.GetRestaurantMembershipByUser(ctx, pgUserID); err == nil {
+		return nil, ErrAlreadyMember
+	} else if err != pgx.ErrNoRows {
+		return nil, err
+	}
+
+	// The invitation is only used up if the membership is created with it.
+	var member db.RestaurantMember
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		accepted, err := queries.AcceptRestaurantInvitation(ctx, db.AcceptRestaurantInvitationParams{
+			ID:         invite.ID,
+			AcceptedBy: pgUserID,
+		})
+		if err != nil {
+			return err
+		}
+		if accepted == 0 {
+			return ErrInvitationInvalid
+		}
+
+		member, err = queries.CreateRestaurantMember(ctx, db.CreateRestaurantMemberParams{
+			RestaurantID: invite.RestaurantID,
+			UserID:       pgUserID,
+			Role:         invite.Role,
+		})
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return ErrAlreadyMember
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mapMember(member, user.Email), nil
+}
+
+// UpdateMemberRole changes a teammate's role.
+func (s *Service) UpdateMemberRole(ctx context.Context, userID, memberID uuid.UUID, role string) (*Member, error) {
+	if !ValidRole(role) {
+		return nil, ErrInvalidRole
+	}
+
+	membership, err := s.authorize(ctx, userID, PermissionManageMembers)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *Member
+	err = pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		owners, err := queries.LockRestaurantOwners(ctx, membership.RestaurantID)
+		if err != nil {
+			return err
+		}
+
+		target, err := teamMember(ctx, queries, membership.RestaurantID, memberID)
+		if err != nil {
+			return err
+		}
+		if target.RestaurantMember.Role == RoleOwner && role != RoleOwner && len(owners) <= 1 {
+			return ErrLastOwner
+		}
+
+		row, err := queries.UpdateRestaurantMemberRole(ctx, db.UpdateRestaurantMemberRoleParams{
+			ID:   target.RestaurantMember.ID,
+			Role: role,
+		})
+		if err != nil {
+			return err
+		}
+		updated = mapMember(row, target.UserEmail)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// RemoveMember takes a teammate's access away. Any member may remove
+// themselves to leave the team.
+func (s *Service) RemoveMember(ctx context.Context, userID, memberID uuid.UUID) error {
+	membership, err := s.membership(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	return pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		queries := s.queries.WithTx(tx)
+
+		owners, err := queries.LockRestaurantOwners(ctx, membership.RestaurantID)
+		if err != nil {
+			return err
+		}
+
+		target, err := teamMember(ctx, queries, membership.RestaurantID, memberID)
+		if err != nil {
+			return err
+		}
+
+		leaving := uuid.UUID(target.RestaurantMember.UserID.Bytes) == userID
+		if !leaving && !Allows(membership.Role, PermissionManageMembers) {
+			return ErrForbidden
+		}
+		if target.RestaurantMember.Role == RoleOwner && len(owners) <= 1 {
+			return ErrLastOwner
+		}
+
+		return queries.DeleteRestaurantMember(ctx, target.RestaurantMember.ID)
+	})
+}
+
+func (s *Service) membership(ctx context.Context, userID uuid.UUID) (*db.GetRestaurantMembershipByUserRow, error) {
+	row, err := s.queries.GetRestaurantMembershipByUser(ctx, pgtype.UUID{Bytes: userID, Valid: true})
+	if err == pgx.ErrNoRows {
+		return nil, ErrNotMember
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &row, nil
+}
+
+func (s *Service) authorize(ctx context.Context, userID uuid.UUID, permission Permission) (*db.GetRestaurantMembershipByUserRow, error) {
+	membership, err := s.membership(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !Allows(membership.Role, permission) {
+		return nil, ErrForbidden
+	}
+	return membership, nil
+}
+
+// teamMember loads a member, treating members of other restaurants as missing.
+// Callers that change owners read it after LockRestaurantOwners so the role
+// cannot change underneath them.
+func teamMember(ctx context.Context, queries *db.Queries, restaurantID pgtype.UUID, memberID uuid.UUID) (*db.GetRestaurantMemberByIDRow, error) {
+	row, err := queries.GetRestaurantMemberByID(ctx, pgtype.UUID{Bytes: memberID, Valid: true})
+	if err == pgx.ErrNoRows {
+		return nil, ErrMemberNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if row.RestaurantMember.RestaurantID != restaurantID {
+		return nil, ErrMemberNotFound
+	}
+	return &row, nil
+}
+
+// newInvitationToken returns the token to mail and the hash to store.
+func newInvitationToken() (string, string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	return token, hashInvitationToken(token), nil
+}
+
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(token)))
+	return hex.EncodeToString(sum[:])
+}
+
+func mapMember(row db.RestaurantMember, email string) *Member {
+	return &Member{
+		ID:           uuid.UUID(row.ID.Bytes),
+		RestaurantID: uuid.UUID(row.RestaurantID.Bytes),
+		UserID:       uuid.UUID(row.UserID.Bytes),
+		Email:        email,
+		Role:         row.Role,
+		CreatedAt:    row.CreatedAt.Time,
+	}
+}
+
+func mapInvitation(row db.RestaurantInvitation) *Invitation {
+	return &Invitation{
+		ID:        uuid.UUID(row.ID.Bytes),
+		Email:     row.Email,
+		Role:      row.Role,
+		ExpiresAt: row.ExpiresAt.Time,
+		CreatedAt: row.CreatedAt.Time,
+	}
+}